@@ -173,16 +173,18 @@ func genFrameworkDocs(repoRoot string, products []Product) {
 			})
 		}
 	}
-	// list resources are deliberately not enumerated from the registry
-	// (the framework v1.19 list.ListResource interface needs a companion
-	// managed resource that does not yet exist). The .md files placed
-	// under services/<product>/ are however parsed via the unified index
-	// so that, once the real list reference is wired up, the
-	// documentation pipeline keeps working with no changes.
-	for _, name := range allListNames(products) {
-		genFrameworkListPlaceholder(servicesRoot, name, productOf(products, name, fwList))
+	if pl, ok := prov.(frameworkprovider.ProviderWithListResources); ok {
+		for _, fac := range pl.ListResources(ctx) {
+			l := fac()
+			name := frameworkListName(ctx, primary, l)
+			genFrameworkDoc(servicesRoot, fwList, name, productOf(products, name, fwList), func(out *map[string]fwAttrSpec) string {
+				resp := frameworklist.ListResourceSchemaResponse{}
+				l.ListResourceConfigSchema(ctx, frameworklist.ListResourceSchemaRequest{}, &resp)
+				*out = flattenListSchema(resp.Schema)
+				return strings.TrimSpace(resp.Schema.Description)
+			})
+		}
 	}
-	_ = frameworklist.ListResource(nil) // keep the import alive for future use
 	if pa, ok := prov.(frameworkprovider.ProviderWithActions); ok {
 		for _, fac := range pa.Actions(ctx) {
 			a := fac()
@@ -298,67 +300,6 @@ func genFrameworkDoc(servicesRoot string, dtype fwDocType, name, product string,
 	message("[SUCC.]write doc to file success: %s", outPath)
 }
 
-// genFrameworkListPlaceholder renders a list resource doc strictly from
-// its colocated .md (no Go schema source available yet).
-func genFrameworkListPlaceholder(servicesRoot, name, product string) {
-	resName := strings.TrimPrefix(name, cloudPrefix)
-	mdPath := lookupFrameworkMd(servicesRoot, fwList, resName, product)
-	if mdPath == "" {
-		fail(fmt.Sprintf("framework list %q is missing its .md file under services/<product>/ (expected list_tc_[<product>_]%s.md)", name, resName))
-	}
-	message("[START]get description from file: %s\n", relMdPath(mdPath))
-	raw, err := os.ReadFile(mdPath)
-	if err != nil {
-		fail(fmt.Sprintf("read %s failed: %s", mdPath, err))
-	}
-	desc := strings.TrimSpace(string(raw))
-	if desc == "" {
-		fail(fmt.Sprintf("description empty: %s", mdPath))
-	}
-	example := ""
-	if i := strings.Index(desc, "\nExample Usage\n"); i != -1 {
-		example = formatHCL(desc[i+15:])
-		desc = strings.TrimSpace(desc[:i])
-	} else {
-		fail(fmt.Sprintf("example usage missing: %s", mdPath))
-	}
-	descShort := desc
-	if i := strings.Index(desc, "\n\n"); i != -1 {
-		descShort = strings.TrimSpace(desc[:i])
-	}
-
-	out := map[string]string{
-		"product":           product,
-		"name":              name,
-		"dtype":             string(fwList),
-		"resource":          resName,
-		"cloud_mark":        cloudMark,
-		"cloud_title":       cloudTitle,
-		"example":           example,
-		"description":       desc,
-		"description_short": descShort,
-		"arguments":         "",
-		"attributes":        "",
-		"timeouts":          "",
-		"import":            "",
-	}
-
-	outPath := filepath.Join(docRoot, fwList.outputDir(), fmt.Sprintf("%s.html.markdown", resName))
-	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
-		fail(fmt.Sprintf("mkdir %s failed: %s", filepath.Dir(outPath), err))
-	}
-	fd, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
-	if err != nil {
-		fail(fmt.Sprintf("open %s failed: %s", outPath, err))
-	}
-	defer fd.Close()
-	t := template.Must(template.New("fw_list").Parse(docTPL))
-	if err := t.Execute(fd, out); err != nil {
-		fail(fmt.Sprintf("write %s failed: %s", outPath, err))
-	}
-	message("[SUCC.]write doc to file success: %s", outPath)
-}
-
 // relMdPath converts an absolute markdown path under tencentcloud/services/
 // into a path relative to tencentcloud/ (e.g. services/ssm/ephemeral_tc_ssm_secret_version.md),
 // matching the format SDKv2's [START] log uses.
@@ -442,15 +383,6 @@ func productOf(prods []Product, name string, dtype fwDocType) string {
 	return "Provider Meta"
 }
 
-// allListNames flattens every list-resource entry in the parsed index.
-func allListNames(prods []Product) []string {
-	var out []string
-	for _, p := range prods {
-		out = append(out, p.Lists...)
-	}
-	return out
-}
-
 // frameworkResourceTypeName extracts the type name from a framework
 // Resource by calling Metadata.
 func frameworkResourceTypeName(ctx context.Context, primary frameworkProviderTypeNamer, r resource.Resource) string {
@@ -487,6 +419,14 @@ func frameworkEphemeralName(ctx context.Context, primary frameworkProviderTypeNa
 	return resp.TypeName
 }
 
+// frameworkListName extracts the type name of a list resource, which is the
+// type name of the managed resource it lists.
+func frameworkListName(ctx context.Context, primary frameworkProviderTypeNamer, l frameworklist.ListResource) string {
+	resp := resource.MetadataResponse{}
+	l.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: cloudMark}, &resp)
+	return resp.TypeName
+}
+
 // frameworkActionName extracts the type name of an action.
 func frameworkActionName(ctx context.Context, primary frameworkProviderTypeNamer, a frameworkaction.Action) string {
 	resp := frameworkaction.MetadataResponse{}
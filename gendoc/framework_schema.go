@@ -14,6 +14,7 @@ import (
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	ephschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -216,6 +217,18 @@ func flattenEphemeralSchema(s ephschema.Schema) map[string]fwAttrSpec {
 	return out
 }
 
+// flattenListSchema converts a list resource configuration schema.
+func flattenListSchema(s listschema.Schema) map[string]fwAttrSpec {
+	out := map[string]fwAttrSpec{}
+	for name, attr := range s.GetAttributes() {
+		out[name] = describeAttribute(name, attr)
+	}
+	for name, blk := range s.GetBlocks() {
+		out[name] = describeBlock(name, blk)
+	}
+	return out
+}
+
 // flattenActionSchema converts an action.Schema.
 func flattenActionSchema(s actionschema.Schema) map[string]fwAttrSpec {
 	out := map[string]fwAttrSpec{}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/framework"
 )
 
@@ -46,6 +47,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// the provider stops, summarize the API calls it made
+	if err := connectivity.FlushAPIMetrics(); err != nil {
+		log.Printf("[CRITICAL] flush API metrics failed: %v", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
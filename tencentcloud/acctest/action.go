@@ -0,0 +1,179 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+// ActionRequest is an API request received by an ActionServer.
+type ActionRequest struct {
+	Action string
+	Body   map[string]interface{}
+}
+
+// ActionServer is an API stand-in for the Invoke tests of actions. It
+// answers the API actions it is given responses for, in order, and keeps
+// returning the last response of an action once the others are used up, so
+// that polling a status until it changes is answered as configured.
+type ActionServer struct {
+	mu sync.Mutex
+
+	server    *httptest.Server
+	responses map[string][]string
+	requests  []ActionRequest
+}
+
+// NewActionServer starts an ActionServer answering the API actions of
+// responses, which is keyed by action, e.g. `StartInstances`. Any other
+// action fails the test t. The server is closed when t ends.
+func NewActionServer(t *testing.T, responses map[string][]string) *ActionServer {
+	t.Helper()
+
+	s := &ActionServer{responses: responses}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actionName := r.Header.Get("X-TC-Action")
+		request := ActionRequest{Action: actionName}
+		if body, err := io.ReadAll(r.Body); err == nil {
+			_ = json.Unmarshal(body, &request.Body)
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, request)
+		bodies := s.responses[actionName]
+		if len(bodies) > 1 {
+			s.responses[actionName] = bodies[1:]
+		}
+		s.mu.Unlock()
+
+		if len(bodies) == 0 {
+			t.Errorf("unexpected action %s", actionName)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(bodies[0]))
+	}))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// Requests returns the requests received so far.
+func (s *ActionServer) Requests() []ActionRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]ActionRequest(nil), s.requests...)
+}
+
+// Actions returns the API actions of the requests received so far.
+func (s *ActionServer) Actions() []string {
+	var actions []string
+	for _, request := range s.Requests() {
+		actions = append(actions, request.Action)
+	}
+	return actions
+}
+
+// Client returns a client calling the server for the products, e.g. `cvm`.
+func (s *ActionServer) Client(products ...string) *connectivity.TencentCloudClient {
+	endpoints := make(map[string]string)
+	for _, product := range products {
+		endpoints[product] = s.server.URL
+	}
+	return &connectivity.TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTP",
+		Domain:     "unused.test",
+		Endpoints:  endpoints,
+	}
+}
+
+// InvokeAction configures the action a with client, invokes it with the
+// attributes of config, and returns the messages of the progress events it
+// sent with the response.
+func InvokeAction(t *testing.T, a action.Action, client *connectivity.TencentCloudClient, config map[string]tftypes.Value) ([]string, *action.InvokeResponse) {
+	t.Helper()
+
+	// the states the action waits for are polled without the delays of the API
+	ctx := tccommon.WithPollInterval(t.Context(), time.Millisecond)
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{
+		ProviderData: &sharedmeta.ProviderMeta{Client: client},
+	}, &action.ConfigureResponse{})
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// the attributes missing from config are null
+	values := make(map[string]tftypes.Value)
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if v, ok := config[name]; ok {
+			values[name] = v
+		}
+	}
+
+	var progress []string
+	resp := &action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		progress = append(progress, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+	return progress, resp
+}
+
+// CheckActionSchema checks the type name of the action a, whether its
+// attributes are required, and that invoking it before the provider is
+// configured fails instead of panicking.
+func CheckActionSchema(t *testing.T, a action.Action, typeName string, required map[string]bool) {
+	t.Helper()
+
+	ctx := context.Background()
+	metadataResp := &action.MetadataResponse{}
+	a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "tencentcloud"}, metadataResp)
+	if metadataResp.TypeName != typeName {
+		t.Errorf("Metadata.TypeName = %q, want %q", metadataResp.TypeName, typeName)
+	}
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", schemaResp.Diagnostics)
+	}
+	for name, isRequired := range required {
+		attr, ok := schemaResp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing attribute: %s", name)
+			continue
+		}
+		if attr.IsRequired() != isRequired {
+			t.Errorf("attribute %s: IsRequired() = %t, want %t", name, attr.IsRequired(), isRequired)
+		}
+	}
+
+	invokeResp := &action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{}, invokeResp)
+	if !invokeResp.Diagnostics.HasError() {
+		t.Errorf("expected an error diagnostic when the provider is not configured")
+	}
+}
@@ -0,0 +1,61 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tcprovider "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest/fakecloud"
+)
+
+// AccFakeCloud starts a fakecloud.Server for the test t, and returns it with
+// a provider configured to call it. The provider is pointed at the server
+// through the environment variables of the credential, `region`,
+// `protocol`, `domain`, `cos_domain` and `proxy_url` arguments, so that the
+// providers of resource.UnitTest cases call it as well. The server is closed
+// and the environment restored when t ends.
+//
+// It lets CVM, VPC, subnet, security group, CLB and COS bucket resources be
+// planned and applied without a cloud account, as terraform does:
+//
+//	func TestTencentCloudVpcResource_fakeCloud(t *testing.T) {
+//		ctx := context.Background()
+//		_, provider := acctest.AccFakeCloud(t)
+//		vpc := provider.ResourcesMap["tencentcloud_vpc"]
+//		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+//			"name":       "fake",
+//			"cidr_block": "10.0.0.0/16",
+//		})
+//
+//		diff, err := vpc.Diff(ctx, nil, config, provider.Meta())
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		if _, diags := vpc.Apply(ctx, nil, diff, provider.Meta()); diags.HasError() {
+//			t.Fatal(diags)
+//		}
+//	}
+func AccFakeCloud(t *testing.T) (*fakecloud.Server, *schema.Provider) {
+	t.Helper()
+
+	server := fakecloud.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv(tcprovider.PROVIDER_SECRET_ID, server.SecretId)
+	t.Setenv(tcprovider.PROVIDER_SECRET_KEY, server.SecretKey)
+	t.Setenv(tcprovider.PROVIDER_SECURITY_TOKEN, "")
+	t.Setenv(tcprovider.PROVIDER_REGION, fakecloud.DefaultRegion)
+	t.Setenv(tcprovider.PROVIDER_PROTOCOL, "HTTP")
+	t.Setenv(tcprovider.PROVIDER_DOMAIN, server.Domain())
+	t.Setenv(tcprovider.PROVIDER_COS_DOMAIN, server.CosDomain())
+	t.Setenv(tcprovider.PROVIDER_PROXY_URL, server.URL())
+
+	provider := tcprovider.Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatalf("configure the provider of the fake cloud failed: %v", diags)
+	}
+	return server, provider
+}
@@ -0,0 +1,253 @@
+package fakecloud
+
+import (
+	"fmt"
+	"strconv"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+)
+
+const (
+	loadBalancerTypeOpen     = "OPEN"
+	loadBalancerTypeInternal = "INTERNAL"
+
+	// taskStatusSucceeded is the Status of DescribeTaskStatus for a finished
+	// task, every task of the server finishes at once.
+	taskStatusSucceeded = 0
+)
+
+func (s *Server) registerClb() {
+	s.handle("clb", "CreateLoadBalancer", s.createLoadBalancer)
+	s.handle("clb", "DescribeLoadBalancers", s.describeLoadBalancers)
+	s.handle("clb", "ModifyLoadBalancerAttributes", s.modifyLoadBalancerAttributes)
+	s.handle("clb", "DeleteLoadBalancer", s.deleteLoadBalancer)
+	s.handle("clb", "DescribeTaskStatus", s.describeTaskStatus)
+}
+
+func clbTags(tags []*clb.TagInfo) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[stringValue(t.TagKey)] = stringValue(t.TagValue)
+	}
+	return result
+}
+
+func (s *Server) clbTagInfos(key string) []*clb.TagInfo {
+	keys, values := s.tagPairs(key)
+	tags := make([]*clb.TagInfo, 0, len(keys))
+	for i := range keys {
+		tags = append(tags, &clb.TagInfo{TagKey: stringPtr(keys[i]), TagValue: stringPtr(values[i])})
+	}
+	return tags
+}
+
+func (s *Server) createLoadBalancer(r *request) (interface{}, error) {
+	var params clb.CreateLoadBalancerRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	loadBalancerType := stringValue(params.LoadBalancerType)
+	if loadBalancerType != loadBalancerTypeOpen && loadBalancerType != loadBalancerTypeInternal {
+		return nil, newError("InvalidParameterValue", "the LoadBalancerType must be %s or %s", loadBalancerTypeOpen, loadBalancerTypeInternal)
+	}
+	vpcId := stringValue(params.VpcId)
+	if vpcId != "" {
+		if _, ok := s.vpcs[vpcId]; !ok {
+			return nil, newError("InvalidParameter.VpcIdNotExist", "the VPC %s does not exist", vpcId)
+		}
+	}
+	if loadBalancerType == loadBalancerTypeInternal {
+		subnet, ok := s.subnets[stringValue(params.SubnetId)]
+		if !ok {
+			return nil, newError("InvalidParameter", "an %s CLB instance requires an existing subnet", loadBalancerTypeInternal)
+		}
+		if *subnet.VpcId != vpcId {
+			return nil, newError("InvalidParameter", "the subnet %s is not in the VPC %s", *subnet.SubnetId, vpcId)
+		}
+	}
+
+	forward := uint64(1)
+	if params.Forward != nil {
+		forward = uint64(*params.Forward)
+	}
+	projectId := uint64(int64Value(params.ProjectId))
+	addressIPVersion := params.AddressIPVersion
+	if addressIPVersion == nil {
+		addressIPVersion = stringPtr("ipv4")
+	}
+	count := uint64Value(params.Number)
+	if count == 0 {
+		count = 1
+	}
+
+	var ids []*string
+	for i := uint64(0); i < count; i++ {
+		id := s.newId("lb")
+		name := stringValue(params.LoadBalancerName)
+		if name == "" {
+			name = id
+		}
+
+		item := &clb.LoadBalancer{
+			LoadBalancerId:           &id,
+			LoadBalancerName:         &name,
+			LoadBalancerType:         &loadBalancerType,
+			Forward:                  &forward,
+			Domain:                   stringPtr(""),
+			Status:                   new(uint64),
+			CreateTime:               now(),
+			ProjectId:                &projectId,
+			VpcId:                    params.VpcId,
+			OpenBgp:                  new(uint64),
+			Snat:                     new(bool),
+			Isolation:                new(uint64),
+			SecureGroups:             []*string{},
+			AddressIPVersion:         addressIPVersion,
+			VipIsp:                   params.VipIsp,
+			ChargeType:               params.LBChargeType,
+			SnatPro:                  params.SnatPro,
+			SlaType:                  params.SlaType,
+			ClusterTag:               params.ClusterTag,
+			Egress:                   params.Egress,
+			LoadBalancerPassToTarget: params.LoadBalancerPassToTarget,
+			NetworkAttributes:        params.InternetAccessible,
+		}
+		*item.Status = 1
+		item.StatusTime = item.CreateTime
+		if item.ChargeType == nil {
+			item.ChargeType = stringPtr("POSTPAID_BY_HOUR")
+		}
+		if params.MasterZoneId != nil {
+			item.MasterZone = &clb.ZoneInfo{Zone: params.MasterZoneId}
+		}
+
+		if loadBalancerType == loadBalancerTypeInternal {
+			address, err := s.allocateAddress(*params.SubnetId, stringValue(params.Vip))
+			if err != nil {
+				return nil, err
+			}
+			item.SubnetId = params.SubnetId
+			item.LoadBalancerVips = []*string{&address}
+		} else {
+			// TEST-NET-2 of RFC 5737
+			item.LoadBalancerVips = []*string{stringPtr(fmt.Sprintf("198.51.100.%d", s.seq%254+1))}
+			item.LoadBalancerDomain = stringPtr(id + ".clb." + domain)
+		}
+
+		s.loadBalancers[id] = item
+		s.setTags(tagKey("clb", "clb", id), clbTags(params.Tags))
+		ids = append(ids, &id)
+	}
+
+	// the request ID is the ID of the task creating the instances
+	s.tasks[r.RequestId] = ids
+	return &clb.CreateLoadBalancerResponseParams{LoadBalancerIds: ids}, nil
+}
+
+func (s *Server) describeLoadBalancers(r *request) (interface{}, error) {
+	var params clb.DescribeLoadBalancersRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	var result []*clb.LoadBalancer
+	for _, id := range sortedKeys(s.loadBalancers) {
+		item := s.loadBalancers[id]
+		if len(params.LoadBalancerIds) > 0 && !containsString(params.LoadBalancerIds, id) {
+			continue
+		}
+		if params.LoadBalancerName != nil && *params.LoadBalancerName != *item.LoadBalancerName ||
+			params.LoadBalancerType != nil && *params.LoadBalancerType != *item.LoadBalancerType ||
+			params.VpcId != nil && *params.VpcId != stringValue(item.VpcId) ||
+			params.ProjectId != nil && uint64(*params.ProjectId) != *item.ProjectId ||
+			params.SecurityGroup != nil && !containsString(item.SecureGroups, *params.SecurityGroup) {
+			continue
+		}
+
+		key := tagKey("clb", "clb", id)
+		fields := map[string][]string{
+			"load-balancer-id":   {id},
+			"load-balancer-name": {*item.LoadBalancerName},
+			"vpc-id":             {stringValue(item.VpcId)},
+			"project-id":         {strconv.FormatUint(*item.ProjectId, 10)},
+		}
+		for _, vip := range item.LoadBalancerVips {
+			fields["load-balancer-vip"] = append(fields["load-balancer-vip"], *vip)
+		}
+		if !matchFilters(filters, fields, s.tags[key]) {
+			continue
+		}
+
+		item.Tags = s.clbTagInfos(key)
+		result = append(result, item)
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), int64Value(params.Offset), int64Value(params.Limit))
+	return &clb.DescribeLoadBalancersResponseParams{TotalCount: &total, LoadBalancerSet: result[start:end]}, nil
+}
+
+func (s *Server) modifyLoadBalancerAttributes(r *request) (interface{}, error) {
+	var params clb.ModifyLoadBalancerAttributesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	item, ok := s.loadBalancers[stringValue(params.LoadBalancerId)]
+	if !ok {
+		return nil, notFound("CLB instance", stringValue(params.LoadBalancerId))
+	}
+	if params.LoadBalancerName != nil {
+		item.LoadBalancerName = params.LoadBalancerName
+	}
+	if params.InternetChargeInfo != nil {
+		item.NetworkAttributes = params.InternetChargeInfo
+	}
+	if params.LoadBalancerPassToTarget != nil {
+		item.LoadBalancerPassToTarget = params.LoadBalancerPassToTarget
+	}
+	if params.SnatPro != nil {
+		item.SnatPro = params.SnatPro
+	}
+
+	s.tasks[r.RequestId] = []*string{item.LoadBalancerId}
+	return &clb.ModifyLoadBalancerAttributesResponseParams{}, nil
+}
+
+func (s *Server) deleteLoadBalancer(r *request) (interface{}, error) {
+	var params clb.DeleteLoadBalancerRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	for _, id := range params.LoadBalancerIds {
+		if _, ok := s.loadBalancers[stringValue(id)]; !ok {
+			return nil, notFound("CLB instance", stringValue(id))
+		}
+	}
+	for _, id := range params.LoadBalancerIds {
+		delete(s.loadBalancers, *id)
+		delete(s.tags, tagKey("clb", "clb", *id))
+	}
+
+	s.tasks[r.RequestId] = params.LoadBalancerIds
+	return &clb.DeleteLoadBalancerResponseParams{}, nil
+}
+
+func (s *Server) describeTaskStatus(r *request) (interface{}, error) {
+	var params clb.DescribeTaskStatusRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	taskId := stringValue(params.TaskId)
+	ids, ok := s.tasks[taskId]
+	if !ok {
+		return nil, newError("ResourceNotFound", "the task %s does not exist", taskId)
+	}
+
+	status := int64(taskStatusSucceeded)
+	return &clb.DescribeTaskStatusResponseParams{Status: &status, LoadBalancerIds: ids}, nil
+}
@@ -0,0 +1,345 @@
+package fakecloud
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"hash/crc64"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cosOwner is the owner of every bucket of the server.
+const cosOwner = "qcs::cam::uin/100000000001:uin/100000000001"
+
+// cosBucketName matches the name of a bucket, which ends with the APPID of
+// the owner, e.g. `examplebucket-1250000000`.
+var cosBucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*-[0-9]+$`)
+
+// cosNotFoundCodes are the error codes of a GET of a sub-resource which was
+// never PUT, the others are `NoSuch<Name>Configuration`.
+var cosNotFoundCodes = map[string]string{
+	"tagging": "NoSuchTagSet",
+	"policy":  "NoSuchBucketPolicy",
+	"cors":    "NoSuchCORSConfiguration",
+}
+
+// cosDefaultConfigs are the documents returned by a GET of a sub-resource
+// which was never PUT, instead of a NoSuch error.
+var cosDefaultConfigs = map[string]string{
+	"acl": `<AccessControlPolicy><Owner><ID>` + cosOwner + `</ID><DisplayName>` + cosOwner + `</DisplayName></Owner>` +
+		`<AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser">` +
+		`<ID>` + cosOwner + `</ID><DisplayName>` + cosOwner + `</DisplayName></Grantee><Permission>FULL_CONTROL</Permission></Grant>` +
+		`</AccessControlList></AccessControlPolicy>`,
+	"versioning": `<VersioningConfiguration></VersioningConfiguration>`,
+	"logging":    `<BucketLoggingStatus></BucketLoggingStatus>`,
+	"location":   `<LocationConstraint>` + DefaultRegion + `</LocationConstraint>`,
+}
+
+// bucket is a COS bucket, its sub-resources, such as `tagging` or `cors`,
+// are kept as the XML documents they were PUT with.
+type bucket struct {
+	Name    string
+	Region  string
+	Created time.Time
+	Configs map[string][]byte
+	Objects map[string]*object
+}
+
+type object struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+	CRC64       string
+	Modified    time.Time
+	Configs     map[string][]byte
+}
+
+type cosError struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string
+	Message   string
+	Resource  string
+	RequestId string
+}
+
+type cosBucketSummary struct {
+	Name         string
+	Location     string
+	CreationDate string
+}
+
+type cosListAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Owner   struct {
+		ID          string
+		DisplayName string
+	}
+	Buckets struct {
+		Bucket []cosBucketSummary
+	}
+}
+
+type cosObjectSummary struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+	StorageClass string
+}
+
+type cosListBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	Marker      string
+	MaxKeys     int
+	IsTruncated bool
+	Contents    []cosObjectSummary
+}
+
+// cosSubResource returns the sub-resource of a COS request, e.g. `tagging`
+// for `PUT /?tagging`, or an empty string.
+func cosSubResource(r *http.Request) string {
+	for key := range r.URL.Query() {
+		switch key {
+		case "prefix", "marker", "max-keys", "delimiter", "encoding-type", "versionId":
+			continue
+		}
+		if !strings.HasPrefix(key, "q-") && !strings.HasPrefix(key, "X-Amz-") && !strings.HasPrefix(key, "x-cos-") {
+			return key
+		}
+	}
+	return ""
+}
+
+func (s *Server) serveCos(w http.ResponseWriter, r *http.Request, bucketName string) {
+	requestId := s.newRequestId()
+	w.Header().Set("x-cos-request-id", requestId)
+
+	fail := func(status int, code, message string) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(status)
+		if r.Method != http.MethodHead {
+			_ = xml.NewEncoder(w).Encode(&cosError{Code: code, Message: message, Resource: r.URL.Path, RequestId: requestId})
+		}
+	}
+
+	// COS and S3 signatures are not checked
+	if r.Header.Get("Authorization") == "" && r.URL.Query().Get("q-signature") == "" && r.URL.Query().Get("X-Amz-Signature") == "" {
+		fail(http.StatusForbidden, "AccessDenied", "the request is not signed")
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if bucketName == "" && key != "" {
+		// path-style request
+		bucketName, key, _ = strings.Cut(key, "/")
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		fail(http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if bucketName == "" {
+		if r.Method != http.MethodGet {
+			fail(http.StatusMethodNotAllowed, "MethodNotAllowed", "only GET Service is supported")
+			return
+		}
+		s.listBuckets(w)
+		return
+	}
+
+	item, ok := s.buckets[bucketName]
+	if !ok && !(key == "" && r.Method == http.MethodPut && cosSubResource(r) == "") {
+		fail(http.StatusNotFound, "NoSuchBucket", "the bucket "+bucketName+" does not exist")
+		return
+	}
+
+	if key == "" {
+		s.serveBucket(w, r, bucketName, item, body, fail)
+	} else {
+		s.serveObject(w, r, item, key, body, fail)
+	}
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter) {
+	var result cosListAllMyBucketsResult
+	result.Owner.ID = cosOwner
+	result.Owner.DisplayName = cosOwner
+	for _, name := range sortedKeys(s.buckets) {
+		item := s.buckets[name]
+		result.Buckets.Bucket = append(result.Buckets.Bucket, cosBucketSummary{
+			Name:         item.Name,
+			Location:     item.Region,
+			CreationDate: item.Created.Format(time.RFC3339),
+		})
+	}
+	writeXML(w, &result)
+}
+
+func (s *Server) serveBucket(w http.ResponseWriter, r *http.Request, name string, item *bucket, body []byte, fail func(int, string, string)) {
+	subResource := cosSubResource(r)
+	if subResource != "" {
+		serveConfig(w, r, item.Configs, subResource, body, fail)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if item != nil {
+			fail(http.StatusConflict, "BucketAlreadyExists", "the bucket "+name+" already exists")
+			return
+		}
+		if !cosBucketName.MatchString(name) {
+			fail(http.StatusBadRequest, "InvalidBucketName", "the bucket name must be <name>-<APPID>")
+			return
+		}
+		s.buckets[name] = &bucket{
+			Name:    name,
+			Region:  DefaultRegion,
+			Created: time.Now().UTC(),
+			Configs: make(map[string][]byte),
+			Objects: make(map[string]*object),
+		}
+	case http.MethodHead:
+		w.Header().Set("x-cos-bucket-region", item.Region)
+	case http.MethodDelete:
+		if len(item.Objects) > 0 {
+			fail(http.StatusConflict, "BucketNotEmpty", "the bucket "+name+" is not empty")
+			return
+		}
+		delete(s.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		s.listObjects(w, r, item)
+	default:
+		fail(http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" is not supported")
+	}
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, item *bucket) {
+	query := r.URL.Query()
+	result := cosListBucketResult{
+		Name:    item.Name,
+		Prefix:  query.Get("prefix"),
+		Marker:  query.Get("marker"),
+		MaxKeys: 1000,
+	}
+	if maxKeys, err := strconv.Atoi(query.Get("max-keys")); err == nil && maxKeys > 0 && maxKeys < result.MaxKeys {
+		result.MaxKeys = maxKeys
+	}
+
+	keys := sortedKeys(item.Objects)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, result.Prefix) || key <= result.Marker {
+			continue
+		}
+		if len(result.Contents) == result.MaxKeys {
+			result.IsTruncated = true
+			break
+		}
+		o := item.Objects[key]
+		result.Contents = append(result.Contents, cosObjectSummary{
+			Key:          key,
+			LastModified: o.Modified.Format(time.RFC3339),
+			ETag:         o.ETag,
+			Size:         len(o.Data),
+			StorageClass: "STANDARD",
+		})
+	}
+	writeXML(w, &result)
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, item *bucket, key string, body []byte, fail func(int, string, string)) {
+	o, ok := item.Objects[key]
+	if !ok && r.Method != http.MethodPut {
+		fail(http.StatusNotFound, "NoSuchKey", "the object "+key+" does not exist")
+		return
+	}
+
+	if subResource := cosSubResource(r); subResource != "" {
+		if o == nil {
+			fail(http.StatusNotFound, "NoSuchKey", "the object "+key+" does not exist")
+			return
+		}
+		serveConfig(w, r, o.Configs, subResource, body, fail)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		sum := md5.Sum(body)
+		o = &object{
+			Data:        body,
+			ContentType: r.Header.Get("Content-Type"),
+			ETag:        `"` + hex.EncodeToString(sum[:]) + `"`,
+			CRC64:       strconv.FormatUint(crc64.Checksum(body, crc64.MakeTable(crc64.ECMA)), 10),
+			Modified:    time.Now().UTC(),
+			Configs:     make(map[string][]byte),
+		}
+		item.Objects[key] = o
+		w.Header().Set("ETag", o.ETag)
+		w.Header().Set("x-cos-hash-crc64ecma", o.CRC64)
+	case http.MethodGet, http.MethodHead:
+		if o.ContentType != "" {
+			w.Header().Set("Content-Type", o.ContentType)
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.Data)))
+		w.Header().Set("ETag", o.ETag)
+		w.Header().Set("x-cos-hash-crc64ecma", o.CRC64)
+		w.Header().Set("Last-Modified", o.Modified.Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(o.Data)
+		}
+	case http.MethodDelete:
+		delete(item.Objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fail(http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" is not supported")
+	}
+}
+
+// serveConfig serves a sub-resource of a bucket or an object from configs.
+func serveConfig(w http.ResponseWriter, r *http.Request, configs map[string][]byte, name string, body []byte, fail func(int, string, string)) {
+	switch r.Method {
+	case http.MethodPut:
+		configs[name] = body
+	case http.MethodGet:
+		if content, ok := configs[name]; ok {
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write(content)
+			return
+		}
+		if content, ok := cosDefaultConfigs[name]; ok {
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(content))
+			return
+		}
+		code, ok := cosNotFoundCodes[name]
+		if !ok {
+			code = "NoSuch" + strings.ToUpper(name[:1]) + name[1:] + "Configuration"
+		}
+		fail(http.StatusNotFound, code, "the "+name+" configuration does not exist")
+	case http.MethodDelete:
+		delete(configs, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fail(http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" ?"+name+" is not supported")
+	}
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = io.WriteString(w, xml.Header)
+	_ = xml.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,382 @@
+package fakecloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+const (
+	instanceStateRunning = "RUNNING"
+	instanceStateStopped = "STOPPED"
+)
+
+// instanceSizes maps the size of an instance type, e.g. `MEDIUM4` of
+// `S5.MEDIUM4`, to its CPU cores and memory in GB.
+var instanceSizes = map[string][2]int64{
+	"SMALL1":  {1, 1},
+	"SMALL2":  {1, 2},
+	"SMALL4":  {1, 4},
+	"MEDIUM2": {2, 2},
+	"MEDIUM4": {2, 4},
+	"MEDIUM8": {2, 8},
+	"LARGE8":  {4, 8},
+	"LARGE16": {4, 16},
+}
+
+func (s *Server) registerCvm() {
+	s.handle("cvm", "RunInstances", s.runInstances)
+	s.handle("cvm", "DescribeInstances", s.describeInstances)
+	s.handle("cvm", "DescribeInstancesStatus", s.describeInstancesStatus)
+	s.handle("cvm", "StartInstances", s.startInstances)
+	s.handle("cvm", "StopInstances", s.stopInstances)
+	s.handle("cvm", "RebootInstances", s.rebootInstances)
+	s.handle("cvm", "ModifyInstancesAttribute", s.modifyInstancesAttribute)
+	s.handle("cvm", "TerminateInstances", s.terminateInstances)
+}
+
+func (s *Server) cvmTags(key string) []*cvm.Tag {
+	keys, values := s.tagPairs(key)
+	tags := make([]*cvm.Tag, 0, len(keys))
+	for i := range keys {
+		tags = append(tags, &cvm.Tag{Key: stringPtr(keys[i]), Value: stringPtr(values[i])})
+	}
+	return tags
+}
+
+func (s *Server) runInstances(r *request) (interface{}, error) {
+	var params cvm.RunInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, newError("MissingParameter", "the parameter Placement.Zone is required")
+	}
+	if stringValue(params.ImageId) == "" {
+		return nil, newError("MissingParameter", "the parameter ImageId is required")
+	}
+	instanceType := stringValue(params.InstanceType)
+	if instanceType == "" {
+		instanceType = "S5.MEDIUM4"
+	}
+	_, size, _ := strings.Cut(instanceType, ".")
+	resources, ok := instanceSizes[size]
+	if !ok {
+		resources = [2]int64{2, 4}
+	}
+
+	network := &cvm.VirtualPrivateCloud{}
+	if params.VirtualPrivateCloud != nil {
+		network.VpcId = params.VirtualPrivateCloud.VpcId
+		network.SubnetId = params.VirtualPrivateCloud.SubnetId
+		subnet, ok := s.subnets[stringValue(network.SubnetId)]
+		if !ok {
+			return nil, newError("InvalidParameterValue.VpcIdNotExist", "the subnet %s does not exist", stringValue(network.SubnetId))
+		}
+		if *subnet.VpcId != stringValue(network.VpcId) {
+			return nil, newError("InvalidParameterValue.VpcIdNotExist", "the subnet %s is not in the VPC %s", *subnet.SubnetId, stringValue(network.VpcId))
+		}
+		if *subnet.Zone != *params.Placement.Zone {
+			return nil, newError("InvalidParameterValue.VpcIdZoneIdNotMatch", "the subnet %s is not in the zone %s", *subnet.SubnetId, *params.Placement.Zone)
+		}
+	}
+	for _, id := range params.SecurityGroupIds {
+		if _, ok := s.securityGroups[stringValue(id)]; !ok {
+			return nil, newError("InvalidSecurityGroupId.NotFound", "the security group %s does not exist", stringValue(id))
+		}
+	}
+
+	tags := make(map[string]string)
+	for _, spec := range params.TagSpecification {
+		if stringValue(spec.ResourceType) != "instance" {
+			continue
+		}
+		for _, t := range spec.Tags {
+			tags[stringValue(t.Key)] = stringValue(t.Value)
+		}
+	}
+
+	count := int64Value(params.InstanceCount)
+	if count == 0 {
+		count = 1
+	}
+
+	var ids []*string
+	for i := int64(0); i < count; i++ {
+		id := s.newId("ins")
+		item := &cvm.Instance{
+			Placement:          &cvm.Placement{Zone: params.Placement.Zone, ProjectId: params.Placement.ProjectId},
+			InstanceId:         &id,
+			InstanceType:       &instanceType,
+			CPU:                &resources[0],
+			Memory:             &resources[1],
+			RestrictState:      stringPtr("NORMAL"),
+			InstanceName:       params.InstanceName,
+			InstanceChargeType: params.InstanceChargeType,
+			SystemDisk:         params.SystemDisk,
+			DataDisks:          params.DataDisks,
+			InternetAccessible: params.InternetAccessible,
+			VirtualPrivateCloud: &cvm.VirtualPrivateCloud{
+				VpcId:        network.VpcId,
+				SubnetId:     network.SubnetId,
+				AsVpcGateway: new(bool),
+			},
+			ImageId:               params.ImageId,
+			RenewFlag:             stringPtr("NOTIFY_AND_MANUAL_RENEW"),
+			CreatedTime:           now(),
+			OsName:                params.ImageId,
+			SecurityGroupIds:      params.SecurityGroupIds,
+			InstanceState:         stringPtr(instanceStateRunning),
+			StopChargingMode:      stringPtr("NOT_APPLICABLE"),
+			Uuid:                  stringPtr(fmt.Sprintf("00000000-0000-0000-0000-%012x", s.seq)),
+			CamRoleName:           params.CamRoleName,
+			DisableApiTermination: params.DisableApiTermination,
+			DefaultLoginUser:      stringPtr("root"),
+			DefaultLoginPort:      new(int64),
+		}
+		if item.Placement.ProjectId == nil {
+			item.Placement.ProjectId = new(int64)
+		}
+		if item.InstanceName == nil {
+			item.InstanceName = stringPtr("Unnamed")
+		}
+		if item.InstanceChargeType == nil {
+			item.InstanceChargeType = stringPtr("POSTPAID_BY_HOUR")
+		}
+		*item.DefaultLoginPort = 22
+		if params.LoginSettings != nil {
+			// passwords are never returned
+			item.LoginSettings = &cvm.LoginSettings{KeyIds: params.LoginSettings.KeyIds}
+		}
+
+		if item.SystemDisk == nil {
+			item.SystemDisk = &cvm.SystemDisk{DiskType: stringPtr("CLOUD_PREMIUM"), DiskSize: new(int64)}
+			*item.SystemDisk.DiskSize = 50
+		}
+		item.SystemDisk.DiskId = stringPtr(s.newId("disk"))
+		for _, disk := range item.DataDisks {
+			disk.DiskId = stringPtr(s.newId("disk"))
+		}
+
+		if network.SubnetId != nil {
+			address := ""
+			if len(params.VirtualPrivateCloud.PrivateIpAddresses) > int(i) {
+				address = stringValue(params.VirtualPrivateCloud.PrivateIpAddresses[i])
+			}
+			address, err := s.allocateAddress(*network.SubnetId, address)
+			if err != nil {
+				return nil, err
+			}
+			item.PrivateIpAddresses = []*string{&address}
+		}
+		if accessible := params.InternetAccessible; accessible != nil &&
+			(accessible.PublicIpAssigned == nil && int64Value(accessible.InternetMaxBandwidthOut) > 0 ||
+				accessible.PublicIpAssigned != nil && *accessible.PublicIpAssigned) {
+			// TEST-NET-3 of RFC 5737
+			item.PublicIpAddresses = []*string{stringPtr(fmt.Sprintf("203.0.113.%d", s.seq%254+1))}
+		}
+
+		s.instances[id] = item
+		s.setTags(tagKey("cvm", "instance", id), tags)
+		ids = append(ids, &id)
+	}
+
+	return &cvm.RunInstancesResponseParams{InstanceIdSet: ids}, nil
+}
+
+// checkInstanceIds returns an error when an instance does not exist.
+func (s *Server) checkInstanceIds(ids []*string) error {
+	if len(ids) == 0 {
+		return newError("MissingParameter", "the parameter InstanceIds is required")
+	}
+	for _, id := range ids {
+		if _, ok := s.instances[stringValue(id)]; !ok {
+			return newError("InvalidInstanceId.NotFound", "the instance %s does not exist", stringValue(id))
+		}
+	}
+	return nil
+}
+
+func (s *Server) describeInstances(r *request) (interface{}, error) {
+	var params cvm.DescribeInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	for _, id := range params.InstanceIds {
+		if !strings.HasPrefix(stringValue(id), "ins-") {
+			return nil, newError("InvalidInstanceId.Malformed", "the instance ID %s is malformed", stringValue(id))
+		}
+	}
+
+	var result []*cvm.Instance
+	for _, id := range sortedKeys(s.instances) {
+		item := s.instances[id]
+		if len(params.InstanceIds) > 0 && !containsString(params.InstanceIds, id) {
+			continue
+		}
+
+		key := tagKey("cvm", "instance", id)
+		fields := map[string][]string{
+			"instance-id":          {id},
+			"instance-name":        {stringValue(item.InstanceName)},
+			"instance-type":        {stringValue(item.InstanceType)},
+			"instance-state":       {stringValue(item.InstanceState)},
+			"instance-charge-type": {stringValue(item.InstanceChargeType)},
+			"zone":                 {stringValue(item.Placement.Zone)},
+			"project-id":           {strconv.FormatInt(int64Value(item.Placement.ProjectId), 10)},
+			"vpc-id":               {stringValue(item.VirtualPrivateCloud.VpcId)},
+			"subnet-id":            {stringValue(item.VirtualPrivateCloud.SubnetId)},
+			"image-id":             {stringValue(item.ImageId)},
+		}
+		for _, address := range item.PrivateIpAddresses {
+			fields["private-ip-address"] = append(fields["private-ip-address"], *address)
+		}
+		for _, address := range item.PublicIpAddresses {
+			fields["public-ip-address"] = append(fields["public-ip-address"], *address)
+		}
+		for _, sg := range item.SecurityGroupIds {
+			fields["security-group-id"] = append(fields["security-group-id"], *sg)
+		}
+		if !matchFilters(filters, fields, s.tags[key]) {
+			continue
+		}
+
+		item.Tags = s.cvmTags(key)
+		result = append(result, item)
+	}
+
+	total := int64(len(result))
+	start, end := page(len(result), int64Value(params.Offset), int64Value(params.Limit))
+	return &cvm.DescribeInstancesResponseParams{TotalCount: &total, InstanceSet: result[start:end]}, nil
+}
+
+func (s *Server) describeInstancesStatus(r *request) (interface{}, error) {
+	var params cvm.DescribeInstancesStatusRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	var result []*cvm.InstanceStatus
+	for _, id := range sortedKeys(s.instances) {
+		if len(params.InstanceIds) > 0 && !containsString(params.InstanceIds, id) {
+			continue
+		}
+		result = append(result, &cvm.InstanceStatus{
+			InstanceId:    stringPtr(id),
+			InstanceState: s.instances[id].InstanceState,
+		})
+	}
+
+	total := int64(len(result))
+	start, end := page(len(result), int64Value(params.Offset), int64Value(params.Limit))
+	return &cvm.DescribeInstancesStatusResponseParams{TotalCount: &total, InstanceStatusSet: result[start:end]}, nil
+}
+
+// setInstancesState changes the state of instances, which are all in one of
+// the states from when it is not empty.
+func (s *Server) setInstancesState(ids []*string, state string, from ...string) error {
+	if err := s.checkInstanceIds(ids); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		item := s.instances[*id]
+		if len(from) > 0 && !containsAny([]string{*item.InstanceState}, from) {
+			return newError("InvalidInstanceState", "the instance %s is %s", *id, *item.InstanceState)
+		}
+	}
+	for _, id := range ids {
+		s.instances[*id].InstanceState = stringPtr(state)
+	}
+	return nil
+}
+
+func (s *Server) startInstances(r *request) (interface{}, error) {
+	var params cvm.StartInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if err := s.setInstancesState(params.InstanceIds, instanceStateRunning, instanceStateStopped); err != nil {
+		return nil, err
+	}
+	return &cvm.StartInstancesResponseParams{}, nil
+}
+
+func (s *Server) stopInstances(r *request) (interface{}, error) {
+	var params cvm.StopInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if err := s.setInstancesState(params.InstanceIds, instanceStateStopped, instanceStateRunning); err != nil {
+		return nil, err
+	}
+	return &cvm.StopInstancesResponseParams{}, nil
+}
+
+func (s *Server) rebootInstances(r *request) (interface{}, error) {
+	var params cvm.RebootInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if err := s.setInstancesState(params.InstanceIds, instanceStateRunning, instanceStateRunning); err != nil {
+		return nil, err
+	}
+	return &cvm.RebootInstancesResponseParams{}, nil
+}
+
+func (s *Server) modifyInstancesAttribute(r *request) (interface{}, error) {
+	var params cvm.ModifyInstancesAttributeRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if err := s.checkInstanceIds(params.InstanceIds); err != nil {
+		return nil, err
+	}
+	for _, id := range params.SecurityGroups {
+		if _, ok := s.securityGroups[stringValue(id)]; !ok {
+			return nil, newError("InvalidSecurityGroupId.NotFound", "the security group %s does not exist", stringValue(id))
+		}
+	}
+
+	for _, id := range params.InstanceIds {
+		item := s.instances[*id]
+		if params.InstanceName != nil {
+			item.InstanceName = params.InstanceName
+		}
+		if params.SecurityGroups != nil {
+			item.SecurityGroupIds = params.SecurityGroups
+		}
+		if params.CamRoleName != nil {
+			item.CamRoleName = params.CamRoleName
+		}
+		if params.DisableApiTermination != nil {
+			item.DisableApiTermination = params.DisableApiTermination
+		}
+	}
+	return &cvm.ModifyInstancesAttributeResponseParams{}, nil
+}
+
+func (s *Server) terminateInstances(r *request) (interface{}, error) {
+	var params cvm.TerminateInstancesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if err := s.checkInstanceIds(params.InstanceIds); err != nil {
+		return nil, err
+	}
+	for _, id := range params.InstanceIds {
+		if item := s.instances[*id]; item.DisableApiTermination != nil && *item.DisableApiTermination {
+			return nil, newError("OperationDenied", "the instance %s has termination protection enabled", *id)
+		}
+	}
+
+	for _, id := range params.InstanceIds {
+		delete(s.instances, *id)
+		delete(s.tags, tagKey("cvm", "instance", *id))
+	}
+	return &cvm.TerminateInstancesResponseParams{}, nil
+}
@@ -0,0 +1,503 @@
+// Package fakecloud is an in-process stand-in of the TencentCloud API for
+// provider tests which run without a cloud account.
+//
+// It speaks the TC3-HMAC-SHA256 JSON protocol of the API, and the XML
+// protocol of COS for buckets and objects. It keeps the state of CVM
+// instances, VPCs, subnets, security groups, CLB instances, COS buckets and
+// their tags in memory. Actions it does not implement fail with the
+// `InvalidAction` error code.
+//
+// API requests are routed by the service of the credential scope of their
+// signature, and COS requests by the bucket of their host or path, so the
+// server answers on any host. A client is pointed at it by the `endpoints`
+// of the services, e.g. `vpc = server.URL()`, or by sending every request
+// through the server as a proxy, which also serves the COS buckets on their
+// subdomains:
+//
+//	server := fakecloud.NewServer()
+//	defer server.Close()
+//
+//	client := &connectivity.TencentCloudClient{
+//		Credential: common.NewCredential(server.SecretId, server.SecretKey),
+//		Region:     fakecloud.DefaultRegion,
+//		Protocol:   "HTTP",
+//		Domain:     server.Domain(),
+//		CosDomain:  server.CosDomain(),
+//		Transport:  server.Transport(),
+//	}
+//
+// The provider of a terraform run is pointed at it the same way, by the
+// `proxy_url` argument, see acctest.AccFakeCloud.
+package fakecloud
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+const (
+	DefaultSecretId  = "AKIDfakecloud"
+	DefaultSecretKey = "fakecloud"
+	DefaultRegion    = "ap-guangzhou"
+
+	// domain is the root domain of the products of every Server, which does
+	// not resolve, see Server.Transport.
+	domain = "fakecloud.test"
+
+	timeLayout = "2006-01-02 15:04:05"
+)
+
+// Server is a stand-in of the TencentCloud API listening on a local port.
+// It is safe for concurrent use.
+type Server struct {
+	// SecretId and SecretKey are the only credential the server accepts.
+	SecretId  string
+	SecretKey string
+
+	server    *httptest.Server
+	transport *http.Transport
+	handlers  map[string]handler
+
+	mu             sync.Mutex
+	seq            int
+	tags           map[string]map[string]string
+	vpcs           map[string]*vpc.Vpc
+	routeTables    map[string]*vpc.RouteTable
+	subnets        map[string]*vpc.Subnet
+	securityGroups map[string]*vpc.SecurityGroup
+	instances      map[string]*cvm.Instance
+	loadBalancers  map[string]*clb.LoadBalancer
+	tasks          map[string][]*string
+	buckets        map[string]*bucket
+}
+
+// request is an API request passed to a handler.
+type request struct {
+	RequestId string
+	Region    string
+	Body      []byte
+}
+
+// decode decodes the request body into params, which is the RequestParams
+// struct of the action in the SDK.
+func (r *request) decode(params interface{}) error {
+	if err := json.Unmarshal(r.Body, params); err != nil {
+		return newError("InvalidParameter", "the request body is invalid: %v", err)
+	}
+	return nil
+}
+
+// handler serves an action, it returns the ResponseParams struct of the
+// action in the SDK. Handlers are called with Server.mu held.
+type handler func(r *request) (interface{}, error)
+
+// apiError is an error returned by the API.
+type apiError struct {
+	Code    string
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+}
+
+func newError(code, format string, args ...interface{}) error {
+	return &apiError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(kind, id string) error {
+	return newError("ResourceNotFound", "the %s %s does not exist", kind, id)
+}
+
+// NewServer starts a Server accepting DefaultSecretId and DefaultSecretKey.
+// Call Close to stop it.
+func NewServer() *Server {
+	s := &Server{
+		SecretId:       DefaultSecretId,
+		SecretKey:      DefaultSecretKey,
+		handlers:       make(map[string]handler),
+		tags:           make(map[string]map[string]string),
+		vpcs:           make(map[string]*vpc.Vpc),
+		routeTables:    make(map[string]*vpc.RouteTable),
+		subnets:        make(map[string]*vpc.Subnet),
+		securityGroups: make(map[string]*vpc.SecurityGroup),
+		instances:      make(map[string]*cvm.Instance),
+		loadBalancers:  make(map[string]*clb.LoadBalancer),
+		tasks:          make(map[string][]*string),
+		buckets:        make(map[string]*bucket),
+	}
+	s.registerTag()
+	s.registerVpc()
+	s.registerCvm()
+	s.registerClb()
+
+	s.server = httptest.NewServer(s)
+	addr := s.server.Listener.Addr().String()
+	s.transport = http.DefaultTransport.(*http.Transport).Clone()
+	s.transport.Proxy = nil
+	s.transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+	return s
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.transport.CloseIdleConnections()
+	s.server.Close()
+}
+
+// Transport returns the transport of the clients of the server, for
+// connectivity.TencentCloudClient.Transport. It sends every request to the
+// server, whatever its host.
+func (s *Server) Transport() http.RoundTripper {
+	return s.transport
+}
+
+// URL returns the base URL of the server, e.g. `http://127.0.0.1:8080`, for
+// the `endpoints` of the API services and the provider `proxy_url` setting.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Domain returns a root domain for the provider `domain` setting, whose
+// hosts are reached through Transport or the `proxy_url` URL.
+func (s *Server) Domain() string {
+	return domain
+}
+
+// CosDomain returns a COS endpoint for the provider `cos_domain` setting,
+// whose bucket hosts are reached through Transport or the `proxy_url` URL.
+func (s *Server) CosDomain() string {
+	return "http://cos." + domain
+}
+
+func (s *Server) handle(product, action string, h handler) {
+	s.handlers[product+"."+action] = h
+}
+
+// newId returns a new resource ID with prefix, e.g. `vpc-0000000a`.
+func (s *Server) newId(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s-%08x", prefix, s.seq)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-TC-Action") == "" && !strings.HasPrefix(r.Header.Get("Authorization"), "TC3-") {
+		s.serveCos(w, r, cosRequestBucket(r))
+		return
+	}
+	s.serveAPI(w, r)
+}
+
+// cosRequestBucket returns the bucket of a virtual-hosted COS request, which
+// is the first label of its host, e.g. `examplebucket-1250000000` of
+// `examplebucket-1250000000.cos.ap-guangzhou.myqcloud.com`. It is empty for
+// the service and path-style requests.
+func cosRequestBucket(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if label, _, found := strings.Cut(host, "."); found && cosBucketName.MatchString(label) {
+		return label
+	}
+	return ""
+}
+
+func (s *Server) serveAPI(w http.ResponseWriter, r *http.Request) {
+	req := &request{
+		RequestId: s.newRequestId(),
+		Region:    r.Header.Get("X-TC-Region"),
+	}
+
+	var err error
+	req.Body, err = io.ReadAll(r.Body)
+	if err != nil {
+		writeAPIResponse(w, req.RequestId, nil, newError("InvalidParameter", "read request body failed: %v", err))
+		return
+	}
+
+	product, err := s.verifySignature(r, req.Body)
+	if err != nil {
+		writeAPIResponse(w, req.RequestId, nil, err)
+		return
+	}
+
+	action := r.Header.Get("X-TC-Action")
+	h, ok := s.handlers[product+"."+action]
+	if !ok {
+		writeAPIResponse(w, req.RequestId, nil, newError("InvalidAction", "the action %s of %s is not implemented by fakecloud", action, product))
+		return
+	}
+
+	// the result points to the state, it is encoded before the next request
+	// changes it
+	s.mu.Lock()
+	var content []byte
+	result, err := h(req)
+	if err == nil {
+		content, err = json.Marshal(result)
+	}
+	s.mu.Unlock()
+
+	writeAPIResponse(w, req.RequestId, content, err)
+}
+
+func (s *Server) newRequestId() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	return fmt.Sprintf("fakecloud-%012x", s.seq)
+}
+
+// verifySignature checks the TC3-HMAC-SHA256 signature of an API request and
+// returns the service of its credential scope, which is the product the
+// request is sent to, see https://www.tencentcloud.com/document/api/213/33224.
+func (s *Server) verifySignature(r *http.Request, body []byte) (string, error) {
+	authorization := r.Header.Get("Authorization")
+	algorithm, rest, _ := strings.Cut(authorization, " ")
+	if algorithm != "TC3-HMAC-SHA256" {
+		return "", newError("AuthFailure.SignatureFailure", "only TC3-HMAC-SHA256 signatures are supported")
+	}
+
+	fields := make(map[string]string)
+	for _, field := range strings.Split(rest, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		fields[key] = value
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 4 || credential[3] != "tc3_request" {
+		return "", newError("AuthFailure.InvalidAuthorization", "the Credential of the Authorization header is invalid")
+	}
+	secretId, date, service := credential[0], credential[1], credential[2]
+	if secretId != s.SecretId {
+		return "", newError("AuthFailure.SecretIdNotFound", "the SecretId %s does not exist", secretId)
+	}
+
+	timestamp := r.Header.Get("X-TC-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Unix(seconds, 0).UTC().Format("2006-01-02") != date {
+		return "", newError("AuthFailure.InvalidAuthorization", "the X-TC-Timestamp %s does not match the Credential date %s", timestamp, date)
+	}
+
+	var canonicalHeaders strings.Builder
+	signedHeaders := fields["SignedHeaders"]
+	for _, name := range strings.Split(signedHeaders, ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + value + "\n")
+	}
+
+	payloadHash := sha256Hex(body)
+	if r.Header.Get("X-TC-Content-SHA256") == "UNSIGNED-PAYLOAD" {
+		payloadHash = sha256Hex([]byte("UNSIGNED-PAYLOAD"))
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		"/",
+		r.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		algorithm,
+		timestamp,
+		date + "/" + service + "/tc3_request",
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSha256([]byte("TC3"+s.SecretKey), date)
+	key = hmacSha256(key, service)
+	key = hmacSha256(key, "tc3_request")
+	signature := hex.EncodeToString(hmacSha256(key, stringToSign))
+	if !hmac.Equal([]byte(signature), []byte(fields["Signature"])) {
+		return "", newError("AuthFailure.SignatureFailure", "the request signature does not match")
+	}
+	return service, nil
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// writeAPIResponse writes the encoded ResponseParams result, or err when it is
+// not nil, in the `{"Response": {...}}` envelope of the API.
+func writeAPIResponse(w http.ResponseWriter, requestId string, result []byte, err error) {
+	response := map[string]interface{}{}
+	if err != nil {
+		apiErr, ok := err.(*apiError)
+		if !ok {
+			apiErr = &apiError{Code: "InternalError", Message: err.Error()}
+		}
+		response["Error"] = map[string]string{"Code": apiErr.Code, "Message": apiErr.Message}
+	} else if result != nil {
+		_ = json.Unmarshal(result, &response)
+	}
+	response["RequestId"] = requestId
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"Response": response})
+}
+
+// filter is the Filter struct shared by the products.
+type filter struct {
+	Name   string
+	Values []string
+}
+
+// decodeFilters returns the Filters of the request.
+func (r *request) decodeFilters() []filter {
+	var params struct {
+		Filters []filter
+	}
+	_ = json.Unmarshal(r.Body, &params)
+	return params.Filters
+}
+
+// matchFilters reports whether a resource matches every filter, fields are
+// the values of the resource for each filter name. A filter name missing in
+// fields never matches, except `tag-key` and `tag:<key>` which are matched
+// against tags.
+func matchFilters(filters []filter, fields map[string][]string, tags map[string]string) bool {
+	for _, f := range filters {
+		var values []string
+		switch {
+		case f.Name == "tag-key":
+			for key := range tags {
+				values = append(values, key)
+			}
+		case strings.HasPrefix(f.Name, "tag:"):
+			if value, ok := tags[strings.TrimPrefix(f.Name, "tag:")]; ok {
+				values = []string{value}
+			}
+		default:
+			values = fields[f.Name]
+		}
+
+		if !containsAny(values, f.Values) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(values, wanted []string) bool {
+	for _, value := range values {
+		for _, w := range wanted {
+			if value == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsString(values []*string, wanted string) bool {
+	for _, value := range values {
+		if value != nil && *value == wanted {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a resource map, which are the IDs in the
+// order of creation.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// page returns the bounds of the page of offset and limit in total items,
+// limit defaults to 20 as in the API.
+func page(total int, offset, limit int64) (start, end int) {
+	if limit <= 0 {
+		limit = 20
+	}
+	start = int(offset)
+	if start > total {
+		start = total
+	}
+	end = start + int(limit)
+	if end > total {
+		end = total
+	}
+	return
+}
+
+// parseInt parses the string Offset and Limit of the VPC API.
+func parseInt(value *string) int64 {
+	if value == nil {
+		return 0
+	}
+	v, _ := strconv.ParseInt(*value, 10, 64)
+	return v
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func uint64Value(value *uint64) uint64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func now() *string {
+	return stringPtr(time.Now().UTC().Format(timeLayout))
+}
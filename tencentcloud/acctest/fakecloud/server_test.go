@@ -0,0 +1,438 @@
+package fakecloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	cos "github.com/tencentyun/cos-go-sdk-v5"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+func newTestClient(t *testing.T) (*Server, *connectivity.TencentCloudClient) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	return server, newClient(server, common.NewCredential(server.SecretId, server.SecretKey))
+}
+
+func newClient(server *Server, credential *common.Credential) *connectivity.TencentCloudClient {
+	return &connectivity.TencentCloudClient{
+		Credential: credential,
+		Region:     DefaultRegion,
+		Protocol:   "HTTP",
+		Domain:     server.Domain(),
+		CosDomain:  server.CosDomain(),
+		Transport:  server.Transport(),
+	}
+}
+
+func assertErrorCode(t *testing.T, code string, err error) {
+	t.Helper()
+
+	sdkError, ok := err.(*sdkErrors.TencentCloudSDKError)
+	if assert.True(t, ok, "unexpected error %v", err) {
+		assert.Equal(t, code, sdkError.GetCode(), sdkError.GetMessage())
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server, client := newTestClient(t)
+
+	_, err := client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+
+	badKey := newClient(server, common.NewCredential(server.SecretId, "wrong"))
+	_, err = badKey.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assertErrorCode(t, "AuthFailure.SignatureFailure", err)
+
+	badId := newClient(server, common.NewCredential("AKIDwrong", server.SecretKey))
+	_, err = badId.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assertErrorCode(t, "AuthFailure.SecretIdNotFound", err)
+
+	_, err = client.UseVpcClient().DescribeVpcEndPoint(vpc.NewDescribeVpcEndPointRequest())
+	assertErrorCode(t, "InvalidAction", err)
+
+	// HmacSHA256 signatures are rejected
+	cpf := client.NewClientProfile(30)
+	cpf.SignMethod = "HmacSHA256"
+	hmacClient, _ := vpc.NewClient(client.Credential, DefaultRegion, cpf)
+	hmacClient.WithHttpTransport(&connectivity.LogRoundTripper{Transport: server.Transport()})
+	_, err = hmacClient.DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.Error(t, err)
+}
+
+func TestServerRouting(t *testing.T) {
+	server, client := newTestClient(t)
+
+	// the endpoint of a service is the server itself
+	endpointClient := &connectivity.TencentCloudClient{
+		Credential: client.Credential,
+		Region:     DefaultRegion,
+		Endpoints:  map[string]string{"vpc": server.URL(), "cvm": server.URL()},
+	}
+	_, err := endpointClient.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	_, err = endpointClient.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	assert.NoError(t, err)
+
+	// the server is the proxy of any host, including the COS buckets
+	transport, err := connectivity.NewTransport(connectivity.TransportConfig{ProxyUrl: server.URL()})
+	if !assert.NoError(t, err) {
+		return
+	}
+	proxyClient := &connectivity.TencentCloudClient{
+		Credential: client.Credential,
+		Region:     DefaultRegion,
+		Protocol:   "HTTP",
+		Domain:     "example.com",
+		CosDomain:  "http://cos.example.com",
+		Transport:  transport,
+	}
+	_, err = proxyClient.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	_, err = proxyClient.UseTencentCosClient("tf-proxy-1250000000").Bucket.Put(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestServerVpc(t *testing.T) {
+	_, client := newTestClient(t)
+	conn := client.UseVpcClient()
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = common.StringPtr("tf-test")
+	createVpc.CidrBlock = common.StringPtr("10.0.0.0/16")
+	createVpc.Tags = []*vpc.Tag{{Key: common.StringPtr("env"), Value: common.StringPtr("test")}}
+	vpcResponse, err := conn.CreateVpc(createVpc)
+	if !assert.NoError(t, err) {
+		return
+	}
+	vpcId := *vpcResponse.Response.Vpc.VpcId
+
+	createSubnet := vpc.NewCreateSubnetRequest()
+	createSubnet.VpcId = &vpcId
+	createSubnet.SubnetName = common.StringPtr("tf-test")
+	createSubnet.CidrBlock = common.StringPtr("10.0.1.0/24")
+	createSubnet.Zone = common.StringPtr("ap-guangzhou-3")
+	subnetResponse, err := conn.CreateSubnet(createSubnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	subnetId := *subnetResponse.Response.Subnet.SubnetId
+	assert.Equal(t, uint64(253), *subnetResponse.Response.Subnet.AvailableIpAddressCount)
+
+	createSubnet.CidrBlock = common.StringPtr("10.0.1.128/25")
+	_, err = conn.CreateSubnet(createSubnet)
+	assertErrorCode(t, "InvalidParameterValue.SubnetConflict", err)
+	createSubnet.CidrBlock = common.StringPtr("10.1.0.0/24")
+	_, err = conn.CreateSubnet(createSubnet)
+	assertErrorCode(t, "InvalidParameterValue.SubnetRange", err)
+
+	describeVpcs := vpc.NewDescribeVpcsRequest()
+	describeVpcs.Filters = []*vpc.Filter{{Name: common.StringPtr("tag:env"), Values: []*string{common.StringPtr("test")}}}
+	vpcs, err := conn.DescribeVpcs(describeVpcs)
+	if assert.NoError(t, err) && assert.Len(t, vpcs.Response.VpcSet, 1) {
+		assert.Equal(t, vpcId, *vpcs.Response.VpcSet[0].VpcId)
+		assert.Equal(t, "env", *vpcs.Response.VpcSet[0].TagSet[0].Key)
+	}
+
+	modifyTags := tag.NewModifyResourceTagsRequest()
+	modifyTags.Resource = common.StringPtr("qcs::vpc:ap-guangzhou:uin/:vpc/" + vpcId)
+	modifyTags.ReplaceTags = []*tag.Tag{{TagKey: common.StringPtr("owner"), TagValue: common.StringPtr("tf")}}
+	modifyTags.DeleteTags = []*tag.TagKeyObject{{TagKey: common.StringPtr("env")}}
+	_, err = client.UseTagClient().ModifyResourceTags(modifyTags)
+	assert.NoError(t, err)
+
+	describeTags := tag.NewDescribeResourceTagsByResourceIdsRequest()
+	describeTags.ServiceType = common.StringPtr("vpc")
+	describeTags.ResourcePrefix = common.StringPtr("vpc")
+	describeTags.ResourceRegion = common.StringPtr(DefaultRegion)
+	describeTags.ResourceIds = []*string{&vpcId}
+	tags, err := client.UseTagClient().DescribeResourceTagsByResourceIds(describeTags)
+	if assert.NoError(t, err) && assert.Len(t, tags.Response.Tags, 1) {
+		assert.Equal(t, "owner", *tags.Response.Tags[0].TagKey)
+		assert.Equal(t, "tf", *tags.Response.Tags[0].TagValue)
+	}
+
+	deleteVpc := vpc.NewDeleteVpcRequest()
+	deleteVpc.VpcId = &vpcId
+	_, err = conn.DeleteVpc(deleteVpc)
+	assertErrorCode(t, "ResourceInUse", err)
+
+	deleteSubnet := vpc.NewDeleteSubnetRequest()
+	deleteSubnet.SubnetId = &subnetId
+	_, err = conn.DeleteSubnet(deleteSubnet)
+	assert.NoError(t, err)
+	_, err = conn.DeleteVpc(deleteVpc)
+	assert.NoError(t, err)
+
+	describeVpcs = vpc.NewDescribeVpcsRequest()
+	describeVpcs.VpcIds = []*string{&vpcId}
+	vpcs, err = conn.DescribeVpcs(describeVpcs)
+	if assert.NoError(t, err) {
+		assert.Empty(t, vpcs.Response.VpcSet)
+	}
+}
+
+func TestServerCvm(t *testing.T) {
+	_, client := newTestClient(t)
+	vpcConn, cvmConn := client.UseVpcClient(), client.UseCvmClient()
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = common.StringPtr("tf-test")
+	createVpc.CidrBlock = common.StringPtr("10.0.0.0/16")
+	vpcResponse, err := vpcConn.CreateVpc(createVpc)
+	if !assert.NoError(t, err) {
+		return
+	}
+	createSubnet := vpc.NewCreateSubnetRequest()
+	createSubnet.VpcId = vpcResponse.Response.Vpc.VpcId
+	createSubnet.SubnetName = common.StringPtr("tf-test")
+	createSubnet.CidrBlock = common.StringPtr("10.0.1.0/24")
+	createSubnet.Zone = common.StringPtr("ap-guangzhou-3")
+	subnetResponse, err := vpcConn.CreateSubnet(createSubnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+	createSecurityGroup := vpc.NewCreateSecurityGroupRequest()
+	createSecurityGroup.GroupName = common.StringPtr("tf-test")
+	createSecurityGroup.GroupDescription = common.StringPtr("tf-test")
+	sgResponse, err := vpcConn.CreateSecurityGroup(createSecurityGroup)
+	if !assert.NoError(t, err) {
+		return
+	}
+	sgId := sgResponse.Response.SecurityGroup.SecurityGroupId
+
+	run := cvm.NewRunInstancesRequest()
+	run.Placement = &cvm.Placement{Zone: common.StringPtr("ap-guangzhou-3")}
+	run.ImageId = common.StringPtr("img-9qabwvbn")
+	run.InstanceType = common.StringPtr("S5.SMALL2")
+	run.InstanceName = common.StringPtr("tf-test")
+	run.InstanceCount = common.Int64Ptr(2)
+	run.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{
+		VpcId:    vpcResponse.Response.Vpc.VpcId,
+		SubnetId: subnetResponse.Response.Subnet.SubnetId,
+	}
+	run.SecurityGroupIds = []*string{sgId}
+	run.LoginSettings = &cvm.LoginSettings{Password: common.StringPtr("Password123")}
+	run.TagSpecification = []*cvm.TagSpecification{{
+		ResourceType: common.StringPtr("instance"),
+		Tags:         []*cvm.Tag{{Key: common.StringPtr("env"), Value: common.StringPtr("test")}},
+	}}
+	runResponse, err := cvmConn.RunInstances(run)
+	if !assert.NoError(t, err) || !assert.Len(t, runResponse.Response.InstanceIdSet, 2) {
+		return
+	}
+	ids := runResponse.Response.InstanceIdSet
+
+	describe := cvm.NewDescribeInstancesRequest()
+	describe.InstanceIds = ids[:1]
+	instances, err := cvmConn.DescribeInstances(describe)
+	if assert.NoError(t, err) && assert.Len(t, instances.Response.InstanceSet, 1) {
+		instance := instances.Response.InstanceSet[0]
+		assert.Equal(t, "RUNNING", *instance.InstanceState)
+		assert.Equal(t, int64(1), *instance.CPU)
+		assert.Equal(t, []*string{common.StringPtr("10.0.1.2")}, instance.PrivateIpAddresses)
+		assert.Nil(t, instance.LoginSettings.Password)
+		assert.Equal(t, "test", *instance.Tags[0].Value)
+	}
+
+	describe = cvm.NewDescribeInstancesRequest()
+	describe.Filters = []*cvm.Filter{{Name: common.StringPtr("private-ip-address"), Values: []*string{common.StringPtr("10.0.1.3")}}}
+	instances, err = cvmConn.DescribeInstances(describe)
+	if assert.NoError(t, err) && assert.Len(t, instances.Response.InstanceSet, 1) {
+		assert.Equal(t, *ids[1], *instances.Response.InstanceSet[0].InstanceId)
+	}
+
+	stop := cvm.NewStopInstancesRequest()
+	stop.InstanceIds = ids
+	_, err = cvmConn.StopInstances(stop)
+	assert.NoError(t, err)
+	_, err = cvmConn.StopInstances(stop)
+	assertErrorCode(t, "InvalidInstanceState", err)
+
+	status := cvm.NewDescribeInstancesStatusRequest()
+	status.InstanceIds = ids
+	statusResponse, err := cvmConn.DescribeInstancesStatus(status)
+	if assert.NoError(t, err) && assert.Len(t, statusResponse.Response.InstanceStatusSet, 2) {
+		assert.Equal(t, "STOPPED", *statusResponse.Response.InstanceStatusSet[0].InstanceState)
+	}
+
+	deleteSecurityGroup := vpc.NewDeleteSecurityGroupRequest()
+	deleteSecurityGroup.SecurityGroupId = sgId
+	_, err = vpcConn.DeleteSecurityGroup(deleteSecurityGroup)
+	assertErrorCode(t, "ResourceInUse", err)
+
+	terminate := cvm.NewTerminateInstancesRequest()
+	terminate.InstanceIds = ids
+	_, err = cvmConn.TerminateInstances(terminate)
+	assert.NoError(t, err)
+	_, err = cvmConn.TerminateInstances(terminate)
+	assertErrorCode(t, "InvalidInstanceId.NotFound", err)
+
+	_, err = vpcConn.DeleteSecurityGroup(deleteSecurityGroup)
+	assert.NoError(t, err)
+}
+
+func TestServerClb(t *testing.T) {
+	_, client := newTestClient(t)
+	vpcConn, clbConn := client.UseVpcClient(), client.UseClbClient()
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = common.StringPtr("tf-test")
+	createVpc.CidrBlock = common.StringPtr("10.0.0.0/16")
+	vpcResponse, err := vpcConn.CreateVpc(createVpc)
+	if !assert.NoError(t, err) {
+		return
+	}
+	createSubnet := vpc.NewCreateSubnetRequest()
+	createSubnet.VpcId = vpcResponse.Response.Vpc.VpcId
+	createSubnet.SubnetName = common.StringPtr("tf-test")
+	createSubnet.CidrBlock = common.StringPtr("10.0.1.0/24")
+	createSubnet.Zone = common.StringPtr("ap-guangzhou-3")
+	subnetResponse, err := vpcConn.CreateSubnet(createSubnet)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	create := clb.NewCreateLoadBalancerRequest()
+	create.LoadBalancerType = common.StringPtr("INTERNAL")
+	create.LoadBalancerName = common.StringPtr("tf-test")
+	create.VpcId = vpcResponse.Response.Vpc.VpcId
+	create.SubnetId = subnetResponse.Response.Subnet.SubnetId
+	create.Tags = []*clb.TagInfo{{TagKey: common.StringPtr("env"), TagValue: common.StringPtr("test")}}
+	createResponse, err := clbConn.CreateLoadBalancer(create)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the provider reads the ID of a new instance from the task status
+	task := clb.NewDescribeTaskStatusRequest()
+	task.TaskId = createResponse.Response.RequestId
+	taskResponse, err := clbConn.DescribeTaskStatus(task)
+	if !assert.NoError(t, err) || !assert.Len(t, taskResponse.Response.LoadBalancerIds, 1) {
+		return
+	}
+	assert.Equal(t, int64(0), *taskResponse.Response.Status)
+	id := taskResponse.Response.LoadBalancerIds[0]
+
+	modify := clb.NewModifyLoadBalancerAttributesRequest()
+	modify.LoadBalancerId = id
+	modify.LoadBalancerName = common.StringPtr("tf-test-renamed")
+	_, err = clbConn.ModifyLoadBalancerAttributes(modify)
+	assert.NoError(t, err)
+
+	describe := clb.NewDescribeLoadBalancersRequest()
+	describe.LoadBalancerIds = []*string{id}
+	describeResponse, err := clbConn.DescribeLoadBalancers(describe)
+	if assert.NoError(t, err) && assert.Len(t, describeResponse.Response.LoadBalancerSet, 1) {
+		lb := describeResponse.Response.LoadBalancerSet[0]
+		assert.Equal(t, "tf-test-renamed", *lb.LoadBalancerName)
+		assert.Equal(t, []*string{common.StringPtr("10.0.1.2")}, lb.LoadBalancerVips)
+		assert.Equal(t, "test", *lb.Tags[0].TagValue)
+	}
+
+	deleteSubnet := vpc.NewDeleteSubnetRequest()
+	deleteSubnet.SubnetId = subnetResponse.Response.Subnet.SubnetId
+	_, err = vpcConn.DeleteSubnet(deleteSubnet)
+	assertErrorCode(t, "ResourceInUse", err)
+
+	deleteLoadBalancer := clb.NewDeleteLoadBalancerRequest()
+	deleteLoadBalancer.LoadBalancerIds = []*string{id}
+	_, err = clbConn.DeleteLoadBalancer(deleteLoadBalancer)
+	assert.NoError(t, err)
+
+	describeResponse, err = clbConn.DescribeLoadBalancers(describe)
+	if assert.NoError(t, err) {
+		assert.Empty(t, describeResponse.Response.LoadBalancerSet)
+	}
+	_, err = vpcConn.DeleteSubnet(deleteSubnet)
+	assert.NoError(t, err)
+}
+
+func TestServerCos(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	bucket := "tf-test-1250000000"
+	conn := client.UseTencentCosClient(bucket)
+
+	_, err := conn.Bucket.Put(ctx, nil)
+	assert.NoError(t, err)
+	_, err = conn.Bucket.Put(ctx, nil)
+	if cosErr, ok := cos.IsCOSError(err); assert.True(t, ok) {
+		assert.Equal(t, "BucketAlreadyExists", cosErr.Code)
+	}
+
+	exist, err := conn.Bucket.IsExist(ctx)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	_, err = conn.Bucket.PutTagging(ctx, &cos.BucketPutTaggingOptions{
+		TagSet: []cos.BucketTaggingTag{{Key: "env", Value: "test"}},
+	})
+	assert.NoError(t, err)
+	tagging, _, err := conn.Bucket.GetTagging(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []cos.BucketTaggingTag{{Key: "env", Value: "test"}}, tagging.TagSet)
+	}
+	_, _, err = conn.Bucket.GetCORS(ctx)
+	if cosErr, ok := cos.IsCOSError(err); assert.True(t, ok) {
+		assert.Equal(t, http.StatusNotFound, cosErr.Response.StatusCode)
+		assert.Equal(t, "NoSuchCORSConfiguration", cosErr.Code)
+	}
+
+	_, err = conn.Object.Put(ctx, "dir/hello.txt", strings.NewReader("hello"), nil)
+	assert.NoError(t, err)
+	response, err := conn.Object.Get(ctx, "dir/hello.txt", nil)
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+		assert.Equal(t, "hello", string(body))
+	}
+
+	objects, _, err := conn.Bucket.Get(ctx, &cos.BucketGetOptions{Prefix: "dir/"})
+	if assert.NoError(t, err) && assert.Len(t, objects.Contents, 1) {
+		assert.Equal(t, "dir/hello.txt", objects.Contents[0].Key)
+		assert.Equal(t, int64(5), objects.Contents[0].Size)
+	}
+
+	_, err = conn.Bucket.Delete(ctx)
+	if cosErr, ok := cos.IsCOSError(err); assert.True(t, ok) {
+		assert.Equal(t, "BucketNotEmpty", cosErr.Code)
+	}
+
+	// the AWS S3 client of the provider shares the buckets
+	s3Conn := client.UseCosClient()
+	_, err = s3Conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	assert.NoError(t, err)
+	_, err = s3Conn.PutObject(&s3.PutObjectInput{Bucket: aws.String(bucket), Key: aws.String("s3.txt"), Body: bytes.NewReader([]byte("s3"))})
+	assert.NoError(t, err)
+	buckets, err := s3Conn.ListBuckets(&s3.ListBucketsInput{})
+	if assert.NoError(t, err) && assert.Len(t, buckets.Buckets, 1) {
+		assert.Equal(t, bucket, *buckets.Buckets[0].Name)
+	}
+
+	_, err = conn.Object.Delete(ctx, "dir/hello.txt")
+	assert.NoError(t, err)
+	_, err = conn.Object.Delete(ctx, "s3.txt")
+	assert.NoError(t, err)
+	_, err = conn.Bucket.Delete(ctx)
+	assert.NoError(t, err)
+
+	exist, err = conn.Bucket.IsExist(ctx)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+}
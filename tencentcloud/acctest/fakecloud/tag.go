@@ -0,0 +1,96 @@
+package fakecloud
+
+import (
+	"sort"
+	"strings"
+
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+)
+
+func (s *Server) registerTag() {
+	s.handle("tag", "ModifyResourceTags", s.modifyResourceTags)
+	s.handle("tag", "DescribeResourceTagsByResourceIds", s.describeResourceTagsByResourceIds)
+}
+
+// tagKey returns the key of the tags of a resource, e.g. `vpc:subnet/subnet-1`.
+func tagKey(serviceType, prefix, id string) string {
+	return serviceType + ":" + prefix + "/" + id
+}
+
+// setTags sets the tags of a resource passed to its Create action.
+func (s *Server) setTags(key string, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+	s.tags[key] = tags
+}
+
+// tagPairs returns the tags of a resource as sorted key and value pairs.
+func (s *Server) tagPairs(key string) (keys, values []string) {
+	tags := s.tags[key]
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		values = append(values, tags[k])
+	}
+	return
+}
+
+func (s *Server) modifyResourceTags(r *request) (interface{}, error) {
+	var params tag.ModifyResourceTagsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	// qcs::<service>:<region>:uin/<uin>:<prefix>/<id>
+	segments := strings.Split(stringValue(params.Resource), ":")
+	if len(segments) != 6 || segments[0] != "qcs" || !strings.Contains(segments[5], "/") {
+		return nil, newError("InvalidParameterValue.ResourceDescriptionError", "the resource %s is invalid", stringValue(params.Resource))
+	}
+	key := segments[2] + ":" + segments[5]
+
+	tags := s.tags[key]
+	if tags == nil {
+		tags = make(map[string]string)
+		s.tags[key] = tags
+	}
+	for _, t := range params.ReplaceTags {
+		tags[stringValue(t.TagKey)] = stringValue(t.TagValue)
+	}
+	for _, t := range params.DeleteTags {
+		delete(tags, stringValue(t.TagKey))
+	}
+
+	return &tag.ModifyResourceTagsResponseParams{}, nil
+}
+
+func (s *Server) describeResourceTagsByResourceIds(r *request) (interface{}, error) {
+	var params tag.DescribeResourceTagsByResourceIdsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	var result []*tag.TagResource
+	for _, id := range params.ResourceIds {
+		keys, values := s.tagPairs(tagKey(stringValue(params.ServiceType), stringValue(params.ResourcePrefix), stringValue(id)))
+		for i := range keys {
+			result = append(result, &tag.TagResource{
+				TagKey:      stringPtr(keys[i]),
+				TagValue:    stringPtr(values[i]),
+				ResourceId:  id,
+				ServiceType: params.ServiceType,
+			})
+		}
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), int64(uint64Value(params.Offset)), int64(uint64Value(params.Limit)))
+	return &tag.DescribeResourceTagsByResourceIdsResponseParams{
+		TotalCount: &total,
+		Offset:     params.Offset,
+		Limit:      params.Limit,
+		Tags:       result[start:end],
+	}, nil
+}
@@ -0,0 +1,567 @@
+package fakecloud
+
+import (
+	"net/netip"
+	"strconv"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func (s *Server) registerVpc() {
+	s.handle("vpc", "CreateVpc", s.createVpc)
+	s.handle("vpc", "DescribeVpcs", s.describeVpcs)
+	s.handle("vpc", "ModifyVpcAttribute", s.modifyVpcAttribute)
+	s.handle("vpc", "DeleteVpc", s.deleteVpc)
+	s.handle("vpc", "DescribeRouteTables", s.describeRouteTables)
+
+	s.handle("vpc", "CreateSubnet", s.createSubnet)
+	s.handle("vpc", "DescribeSubnets", s.describeSubnets)
+	s.handle("vpc", "ModifySubnetAttribute", s.modifySubnetAttribute)
+	s.handle("vpc", "DeleteSubnet", s.deleteSubnet)
+
+	s.handle("vpc", "CreateSecurityGroup", s.createSecurityGroup)
+	s.handle("vpc", "DescribeSecurityGroups", s.describeSecurityGroups)
+	s.handle("vpc", "ModifySecurityGroupAttribute", s.modifySecurityGroupAttribute)
+	s.handle("vpc", "DeleteSecurityGroup", s.deleteSecurityGroup)
+	s.handle("vpc", "DescribeSecurityGroupAssociationStatistics", s.describeSecurityGroupAssociationStatistics)
+}
+
+func vpcTags(tags []*vpc.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		result[stringValue(t.Key)] = stringValue(t.Value)
+	}
+	return result
+}
+
+func (s *Server) vpcTagSet(key string) []*vpc.Tag {
+	keys, values := s.tagPairs(key)
+	tags := make([]*vpc.Tag, 0, len(keys))
+	for i := range keys {
+		tags = append(tags, &vpc.Tag{Key: stringPtr(keys[i]), Value: stringPtr(values[i])})
+	}
+	return tags
+}
+
+func parseCidr(cidr *string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(stringValue(cidr))
+	if err != nil || !prefix.Addr().Is4() || prefix.Masked() != prefix {
+		return netip.Prefix{}, newError("InvalidParameterValue.Malformed", "the CIDR block %s is invalid", stringValue(cidr))
+	}
+	return prefix, nil
+}
+
+func (s *Server) createVpc(r *request) (interface{}, error) {
+	var params vpc.CreateVpcRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	prefix, err := parseCidr(params.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	if prefix.Bits() < 12 || prefix.Bits() > 28 {
+		return nil, newError("InvalidParameterValue.Range", "the CIDR block %s of a VPC must be between /12 and /28", prefix)
+	}
+
+	id := s.newId("vpc")
+	item := &vpc.Vpc{
+		VpcId:           &id,
+		VpcName:         params.VpcName,
+		CidrBlock:       stringPtr(prefix.String()),
+		IsDefault:       new(bool),
+		EnableMulticast: new(bool),
+		CreatedTime:     now(),
+		DnsServerSet:    params.DnsServers,
+		DomainName:      params.DomainName,
+	}
+	if stringValue(params.EnableMulticast) == "true" {
+		*item.EnableMulticast = true
+	}
+	if len(item.DnsServerSet) == 0 {
+		item.DnsServerSet = []*string{stringPtr("183.60.83.19"), stringPtr("183.60.82.98")}
+	}
+	s.vpcs[id] = item
+	s.setTags(tagKey("vpc", "vpc", id), vpcTags(params.Tags))
+
+	routeTableId := s.newId("rtb")
+	s.routeTables[routeTableId] = &vpc.RouteTable{
+		VpcId:          &id,
+		RouteTableId:   &routeTableId,
+		RouteTableName: stringPtr("default"),
+		Main:           boolPtr(true),
+		CreatedTime:    item.CreatedTime,
+		AssociationSet: []*vpc.RouteTableAssociation{},
+		RouteSet:       []*vpc.Route{},
+	}
+
+	item.TagSet = s.vpcTagSet(tagKey("vpc", "vpc", id))
+	return &vpc.CreateVpcResponseParams{Vpc: item}, nil
+}
+
+func (s *Server) describeVpcs(r *request) (interface{}, error) {
+	var params vpc.DescribeVpcsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	var result []*vpc.Vpc
+	for _, id := range sortedKeys(s.vpcs) {
+		item := s.vpcs[id]
+		if len(params.VpcIds) > 0 && !containsString(params.VpcIds, id) {
+			continue
+		}
+
+		key := tagKey("vpc", "vpc", id)
+		if !matchFilters(filters, map[string][]string{
+			"vpc-id":     {id},
+			"vpc-name":   {stringValue(item.VpcName)},
+			"cidr-block": {stringValue(item.CidrBlock)},
+			"is-default": {strconv.FormatBool(*item.IsDefault)},
+		}, s.tags[key]) {
+			continue
+		}
+
+		item.TagSet = s.vpcTagSet(key)
+		result = append(result, item)
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), parseInt(params.Offset), parseInt(params.Limit))
+	return &vpc.DescribeVpcsResponseParams{TotalCount: &total, VpcSet: result[start:end]}, nil
+}
+
+func (s *Server) modifyVpcAttribute(r *request) (interface{}, error) {
+	var params vpc.ModifyVpcAttributeRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	item, ok := s.vpcs[stringValue(params.VpcId)]
+	if !ok {
+		return nil, notFound("VPC", stringValue(params.VpcId))
+	}
+	if params.VpcName != nil {
+		item.VpcName = params.VpcName
+	}
+	if params.EnableMulticast != nil {
+		*item.EnableMulticast = *params.EnableMulticast == "true"
+	}
+	if params.DnsServers != nil {
+		item.DnsServerSet = params.DnsServers
+	}
+	if params.DomainName != nil {
+		item.DomainName = params.DomainName
+	}
+
+	return &vpc.ModifyVpcAttributeResponseParams{}, nil
+}
+
+func (s *Server) deleteVpc(r *request) (interface{}, error) {
+	var params vpc.DeleteVpcRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	id := stringValue(params.VpcId)
+	if _, ok := s.vpcs[id]; !ok {
+		return nil, notFound("VPC", id)
+	}
+	for _, subnet := range s.subnets {
+		if *subnet.VpcId == id {
+			return nil, newError("ResourceInUse", "the VPC %s still has the subnet %s", id, *subnet.SubnetId)
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		if stringValue(lb.VpcId) == id {
+			return nil, newError("ResourceInUse", "the VPC %s still has the CLB instance %s", id, *lb.LoadBalancerId)
+		}
+	}
+
+	for routeTableId, routeTable := range s.routeTables {
+		if *routeTable.VpcId == id {
+			delete(s.routeTables, routeTableId)
+		}
+	}
+	delete(s.vpcs, id)
+	delete(s.tags, tagKey("vpc", "vpc", id))
+	return &vpc.DeleteVpcResponseParams{}, nil
+}
+
+func (s *Server) describeRouteTables(r *request) (interface{}, error) {
+	var params vpc.DescribeRouteTablesRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	var result []*vpc.RouteTable
+	for _, id := range sortedKeys(s.routeTables) {
+		item := s.routeTables[id]
+		if len(params.RouteTableIds) > 0 && !containsString(params.RouteTableIds, id) {
+			continue
+		}
+
+		key := tagKey("vpc", "rtb", id)
+		if !matchFilters(filters, map[string][]string{
+			"route-table-id":   {id},
+			"route-table-name": {stringValue(item.RouteTableName)},
+			"vpc-id":           {stringValue(item.VpcId)},
+			"association.main": {strconv.FormatBool(*item.Main)},
+		}, s.tags[key]) {
+			continue
+		}
+
+		item.TagSet = s.vpcTagSet(key)
+		result = append(result, item)
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), parseInt(params.Offset), parseInt(params.Limit))
+	return &vpc.DescribeRouteTablesResponseParams{TotalCount: &total, RouteTableSet: result[start:end]}, nil
+}
+
+// mainRouteTable returns the ID of the default route table of a VPC.
+func (s *Server) mainRouteTable(vpcId string) *string {
+	for id, routeTable := range s.routeTables {
+		if *routeTable.VpcId == vpcId && *routeTable.Main {
+			return &id
+		}
+	}
+	return nil
+}
+
+func (s *Server) createSubnet(r *request) (interface{}, error) {
+	var params vpc.CreateSubnetRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	vpcId := stringValue(params.VpcId)
+	parent, ok := s.vpcs[vpcId]
+	if !ok {
+		return nil, notFound("VPC", vpcId)
+	}
+	if stringValue(params.Zone) == "" {
+		return nil, newError("MissingParameter", "the parameter Zone is required")
+	}
+
+	prefix, err := parseCidr(params.CidrBlock)
+	if err != nil {
+		return nil, err
+	}
+	vpcPrefix := netip.MustParsePrefix(*parent.CidrBlock)
+	if prefix.Bits() < vpcPrefix.Bits() || !vpcPrefix.Contains(prefix.Addr()) || prefix.Bits() > 29 {
+		return nil, newError("InvalidParameterValue.SubnetRange", "the CIDR block %s is not a valid subnet of the VPC %s (%s)", prefix, vpcId, vpcPrefix)
+	}
+	for _, subnet := range s.subnets {
+		if *subnet.VpcId == vpcId && netip.MustParsePrefix(*subnet.CidrBlock).Overlaps(prefix) {
+			return nil, newError("InvalidParameterValue.SubnetConflict", "the CIDR block %s overlaps the subnet %s (%s)", prefix, *subnet.SubnetId, *subnet.CidrBlock)
+		}
+	}
+
+	// the network, gateway and broadcast addresses are reserved
+	total := uint64(1)<<(32-prefix.Bits()) - 3
+	id := s.newId("subnet")
+	item := &vpc.Subnet{
+		VpcId:                   &vpcId,
+		SubnetId:                &id,
+		SubnetName:              params.SubnetName,
+		CidrBlock:               stringPtr(prefix.String()),
+		IsDefault:               new(bool),
+		EnableBroadcast:         new(bool),
+		Zone:                    params.Zone,
+		RouteTableId:            s.mainRouteTable(vpcId),
+		CreatedTime:             now(),
+		AvailableIpAddressCount: &total,
+		TotalIpAddressCount:     &total,
+		NetworkAclId:            stringPtr(""),
+		IsRemoteVpcSnat:         new(bool),
+		CdcId:                   params.CdcId,
+		IsCdcSubnet:             new(int64),
+	}
+	s.subnets[id] = item
+	s.setTags(tagKey("vpc", "subnet", id), vpcTags(params.Tags))
+
+	item.TagSet = s.vpcTagSet(tagKey("vpc", "subnet", id))
+	return &vpc.CreateSubnetResponseParams{Subnet: item}, nil
+}
+
+func (s *Server) describeSubnets(r *request) (interface{}, error) {
+	var params vpc.DescribeSubnetsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	var result []*vpc.Subnet
+	for _, id := range sortedKeys(s.subnets) {
+		item := s.subnets[id]
+		if len(params.SubnetIds) > 0 && !containsString(params.SubnetIds, id) {
+			continue
+		}
+
+		key := tagKey("vpc", "subnet", id)
+		if !matchFilters(filters, map[string][]string{
+			"subnet-id":      {id},
+			"subnet-name":    {stringValue(item.SubnetName)},
+			"vpc-id":         {stringValue(item.VpcId)},
+			"cidr-block":     {stringValue(item.CidrBlock)},
+			"zone":           {stringValue(item.Zone)},
+			"is-default":     {strconv.FormatBool(*item.IsDefault)},
+			"route-table-id": {stringValue(item.RouteTableId)},
+		}, s.tags[key]) {
+			continue
+		}
+
+		available := *item.TotalIpAddressCount - uint64(len(s.subnetAddresses(id)))
+		item.AvailableIpAddressCount = &available
+		item.TagSet = s.vpcTagSet(key)
+		result = append(result, item)
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), parseInt(params.Offset), parseInt(params.Limit))
+	return &vpc.DescribeSubnetsResponseParams{TotalCount: &total, SubnetSet: result[start:end]}, nil
+}
+
+func (s *Server) modifySubnetAttribute(r *request) (interface{}, error) {
+	var params vpc.ModifySubnetAttributeRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	item, ok := s.subnets[stringValue(params.SubnetId)]
+	if !ok {
+		return nil, notFound("subnet", stringValue(params.SubnetId))
+	}
+	if params.SubnetName != nil {
+		item.SubnetName = params.SubnetName
+	}
+	if params.EnableBroadcast != nil {
+		*item.EnableBroadcast = *params.EnableBroadcast == "true"
+	}
+
+	return &vpc.ModifySubnetAttributeResponseParams{}, nil
+}
+
+func (s *Server) deleteSubnet(r *request) (interface{}, error) {
+	var params vpc.DeleteSubnetRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	id := stringValue(params.SubnetId)
+	if _, ok := s.subnets[id]; !ok {
+		return nil, notFound("subnet", id)
+	}
+	if len(s.subnetAddresses(id)) > 0 {
+		return nil, newError("ResourceInUse", "the subnet %s still has instances", id)
+	}
+
+	delete(s.subnets, id)
+	delete(s.tags, tagKey("vpc", "subnet", id))
+	return &vpc.DeleteSubnetResponseParams{}, nil
+}
+
+// subnetAddresses returns the private IP addresses in use in a subnet.
+func (s *Server) subnetAddresses(subnetId string) map[string]bool {
+	addresses := make(map[string]bool)
+	for _, instance := range s.instances {
+		if stringValue(instance.VirtualPrivateCloud.SubnetId) != subnetId {
+			continue
+		}
+		for _, address := range instance.PrivateIpAddresses {
+			addresses[*address] = true
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		if stringValue(lb.SubnetId) != subnetId {
+			continue
+		}
+		for _, address := range lb.LoadBalancerVips {
+			addresses[*address] = true
+		}
+	}
+	return addresses
+}
+
+// allocateAddress returns a free private IP address of a subnet, or address
+// when it is not empty and free.
+func (s *Server) allocateAddress(subnetId, address string) (string, error) {
+	subnet, ok := s.subnets[subnetId]
+	if !ok {
+		return "", notFound("subnet", subnetId)
+	}
+
+	prefix := netip.MustParsePrefix(*subnet.CidrBlock)
+	used := s.subnetAddresses(subnetId)
+	if address != "" {
+		addr, err := netip.ParseAddr(address)
+		if err != nil || !prefix.Contains(addr) {
+			return "", newError("InvalidParameterValue", "the address %s is not in the subnet %s (%s)", address, subnetId, prefix)
+		}
+		if used[address] {
+			return "", newError("InvalidParameterValue.AddressIpInUse", "the address %s is in use", address)
+		}
+		return address, nil
+	}
+
+	// skip the network and gateway addresses
+	for addr := prefix.Addr().Next().Next(); prefix.Contains(addr); addr = addr.Next() {
+		if !used[addr.String()] && prefix.Contains(addr.Next()) {
+			return addr.String(), nil
+		}
+	}
+	return "", newError("ResourceInsufficient.SubnetIp", "the subnet %s has no free address", subnetId)
+}
+
+func (s *Server) createSecurityGroup(r *request) (interface{}, error) {
+	var params vpc.CreateSecurityGroupRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if stringValue(params.GroupName) == "" {
+		return nil, newError("MissingParameter", "the parameter GroupName is required")
+	}
+
+	projectId := params.ProjectId
+	if projectId == nil {
+		projectId = stringPtr("0")
+	}
+
+	id := s.newId("sg")
+	item := &vpc.SecurityGroup{
+		SecurityGroupId:   &id,
+		SecurityGroupName: params.GroupName,
+		SecurityGroupDesc: params.GroupDescription,
+		ProjectId:         projectId,
+		IsDefault:         new(bool),
+		CreatedTime:       now(),
+	}
+	item.UpdateTime = item.CreatedTime
+	s.securityGroups[id] = item
+	s.setTags(tagKey("cvm", "sg", id), vpcTags(params.Tags))
+
+	item.TagSet = s.vpcTagSet(tagKey("cvm", "sg", id))
+	return &vpc.CreateSecurityGroupResponseParams{SecurityGroup: item}, nil
+}
+
+func (s *Server) describeSecurityGroups(r *request) (interface{}, error) {
+	var params vpc.DescribeSecurityGroupsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	filters := r.decodeFilters()
+
+	var result []*vpc.SecurityGroup
+	for _, id := range sortedKeys(s.securityGroups) {
+		item := s.securityGroups[id]
+		if len(params.SecurityGroupIds) > 0 && !containsString(params.SecurityGroupIds, id) {
+			continue
+		}
+
+		key := tagKey("cvm", "sg", id)
+		if !matchFilters(filters, map[string][]string{
+			"security-group-id":   {id},
+			"security-group-name": {stringValue(item.SecurityGroupName)},
+			"project-id":          {stringValue(item.ProjectId)},
+		}, s.tags[key]) {
+			continue
+		}
+
+		item.TagSet = s.vpcTagSet(key)
+		result = append(result, item)
+	}
+
+	total := uint64(len(result))
+	start, end := page(len(result), parseInt(params.Offset), parseInt(params.Limit))
+	return &vpc.DescribeSecurityGroupsResponseParams{TotalCount: &total, SecurityGroupSet: result[start:end]}, nil
+}
+
+func (s *Server) modifySecurityGroupAttribute(r *request) (interface{}, error) {
+	var params vpc.ModifySecurityGroupAttributeRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	item, ok := s.securityGroups[stringValue(params.SecurityGroupId)]
+	if !ok {
+		return nil, notFound("security group", stringValue(params.SecurityGroupId))
+	}
+	if params.GroupName != nil {
+		item.SecurityGroupName = params.GroupName
+	}
+	if params.GroupDescription != nil {
+		item.SecurityGroupDesc = params.GroupDescription
+	}
+	item.UpdateTime = now()
+
+	return &vpc.ModifySecurityGroupAttributeResponseParams{}, nil
+}
+
+func (s *Server) deleteSecurityGroup(r *request) (interface{}, error) {
+	var params vpc.DeleteSecurityGroupRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	id := stringValue(params.SecurityGroupId)
+	if _, ok := s.securityGroups[id]; !ok {
+		return nil, notFound("security group", id)
+	}
+	if cvmCount, clbCount := s.securityGroupAssociations(id); cvmCount+clbCount > 0 {
+		return nil, newError("ResourceInUse", "the security group %s is still associated with %d instances", id, cvmCount+clbCount)
+	}
+
+	delete(s.securityGroups, id)
+	delete(s.tags, tagKey("cvm", "sg", id))
+	return &vpc.DeleteSecurityGroupResponseParams{}, nil
+}
+
+// securityGroupAssociations returns the number of CVM and CLB instances
+// associated with a security group.
+func (s *Server) securityGroupAssociations(id string) (cvmCount, clbCount uint64) {
+	for _, instance := range s.instances {
+		if containsString(instance.SecurityGroupIds, id) {
+			cvmCount++
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		if containsString(lb.SecureGroups, id) {
+			clbCount++
+		}
+	}
+	return
+}
+
+func (s *Server) describeSecurityGroupAssociationStatistics(r *request) (interface{}, error) {
+	var params vpc.DescribeSecurityGroupAssociationStatisticsRequestParams
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	var result []*vpc.SecurityGroupAssociationStatistics
+	for _, id := range params.SecurityGroupIds {
+		if _, ok := s.securityGroups[stringValue(id)]; !ok {
+			return nil, notFound("security group", stringValue(id))
+		}
+
+		cvmCount, clbCount := s.securityGroupAssociations(*id)
+		total := cvmCount + clbCount
+		result = append(result, &vpc.SecurityGroupAssociationStatistics{
+			SecurityGroupId:    id,
+			CVM:                &cvmCount,
+			CDB:                new(uint64),
+			ENI:                new(uint64),
+			SG:                 new(uint64),
+			CLB:                &clbCount,
+			InstanceStatistics: []*vpc.InstanceStatistic{},
+			TotalCount:         &total,
+		})
+	}
+
+	return &vpc.DescribeSecurityGroupAssociationStatisticsResponseParams{SecurityGroupAssociationStatisticsSet: result}, nil
+}
@@ -52,6 +52,7 @@ const (
 )
 
 func AccPreCheck(t *testing.T) {
+	AccPreCheckVCR(t)
 	if v := os.Getenv(tcprovider.PROVIDER_SECRET_ID); v == "" {
 		t.Fatalf("%v must be set for acceptance tests\n", tcprovider.PROVIDER_SECRET_ID)
 	}
@@ -97,6 +98,9 @@ func AccPreCheckCommon(t *testing.T, accountType string) {
 		log.Printf("[INFO] Testing: Using %s as test region", DefaultRegion)
 		os.Setenv(tcprovider.PROVIDER_REGION, DefaultRegion)
 	}
+	if AccPreCheckVCR(t) == connectivity.VCRModeReplay {
+		return
+	}
 	switch {
 	case accountType == ACCOUNT_TYPE_INTERNATIONAL:
 		secretId := os.Getenv(INTERNATIONAL_PROVIDER_SECRET_ID)
@@ -235,6 +239,7 @@ func SharedClientForRegion(region string) (interface{}, error) {
 	securityToken := os.Getenv(tcprovider.PROVIDER_SECURITY_TOKEN)
 	protocol := os.Getenv(tcprovider.PROVIDER_PROTOCOL)
 	domain := os.Getenv(tcprovider.PROVIDER_DOMAIN)
+	cosDomain := os.Getenv(tcprovider.PROVIDER_COS_DOMAIN)
 
 	client := &connectivity.TencentCloudClient{
 		Credential: common.NewTokenCredential(
@@ -242,9 +247,10 @@ func SharedClientForRegion(region string) (interface{}, error) {
 			secretKey,
 			securityToken,
 		),
-		Region:   region,
-		Protocol: protocol,
-		Domain:   domain,
+		Region:    region,
+		Protocol:  protocol,
+		Domain:    domain,
+		CosDomain: cosDomain,
 	}
 
 	var tcClient TencentCloudClient
@@ -0,0 +1,92 @@
+package acctest
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	tcprovider "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// vcrCassette is the cassette set by the user, every test uses its own
+// cassette under testdata/cassettes when it is empty.
+var vcrCassette = os.Getenv(connectivity.VCR_CASSETTE)
+
+// vcrTest is the test whose API calls are recorded or replayed. The
+// cassette is set in the environment of the process, which all the
+// providers read, so only one test at a time can use it.
+var vcrTest struct {
+	sync.Mutex
+	name string
+}
+
+// AccPreCheckVCR prepares the VCR mode of connectivity.LogRoundTripper for
+// the test t and returns the mode, which is empty when VCR is disabled. It is
+// called by AccPreCheck and AccPreCheckCommon.
+//
+// Record the API calls of a test with real credentials once, then replay
+// them without network access or credentials:
+//
+//	TF_ACC=1 TENCENTCLOUD_VCR_MODE=record go test ./tencentcloud/services/vpc -run TestAccTencentCloudVpcResource_basic
+//	TF_ACC=1 TENCENTCLOUD_VCR_MODE=replay go test ./tencentcloud/services/vpc -run TestAccTencentCloudVpcResource_basic
+//
+// Tests using VCR must not run in parallel, the test fails when another one
+// is running, run them with `-parallel 1`. Responses are recorded unredacted
+// to the raw cassette, see connectivity.VCRRawCassettePath, which is ignored
+// by git, and redacted to the cassette. The scrub mode writes the cassette of
+// the test again from its raw cassette and skips the test:
+//
+//	TF_ACC=1 TENCENTCLOUD_VCR_MODE=scrub go test ./tencentcloud/services/vpc -run TestAccTencentCloudVpcResource_basic
+func AccPreCheckVCR(t *testing.T) string {
+	mode := os.Getenv(connectivity.VCR_MODE)
+	if mode != connectivity.VCRModeRecord && mode != connectivity.VCRModeReplay && mode != connectivity.VCRModeScrub {
+		return ""
+	}
+
+	cassette := vcrCassette
+	if cassette == "" {
+		cassette = filepath.Join("testdata", "cassettes", t.Name()+".json")
+	}
+	if mode == connectivity.VCRModeScrub {
+		if err := connectivity.ScrubVCRCassette(cassette); err != nil {
+			t.Fatal(err)
+		}
+		t.Skipf("VCR cassette %s is scrubbed", cassette)
+	}
+
+	vcrTest.Lock()
+	running := vcrTest.name
+	if running == "" {
+		vcrTest.name = t.Name()
+	}
+	vcrTest.Unlock()
+	if running != "" && running != t.Name() {
+		t.Fatalf("VCR %s mode can not run %s in parallel with %s, run the tests with -parallel 1", mode, t.Name(), running)
+	}
+	if running == "" {
+		t.Cleanup(func() {
+			vcrTest.Lock()
+			defer vcrTest.Unlock()
+
+			vcrTest.name = ""
+			os.Unsetenv(connectivity.VCR_CASSETTE)
+		})
+	}
+
+	os.Setenv(connectivity.VCR_CASSETTE, cassette)
+	log.Printf("[INFO] Testing: VCR %s mode, using cassette %s", mode, cassette)
+
+	if mode == connectivity.VCRModeReplay {
+		// replayed requests are never sent, so any credential works
+		for _, key := range []string{tcprovider.PROVIDER_SECRET_ID, tcprovider.PROVIDER_SECRET_KEY} {
+			if v := os.Getenv(key); v == "" {
+				os.Setenv(key, "vcr-replay")
+			}
+		}
+	}
+
+	return mode
+}
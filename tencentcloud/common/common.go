@@ -13,6 +13,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -202,6 +203,18 @@ func InconsistentCheck(d *schema.ResourceData, meta interface{}) func() {
 
 // RetryError returns retry error
 func RetryError(err error, additionRetryableError ...string) *resource.RetryError {
+	return retryError(CallerProduct(1), err, additionRetryableError...)
+}
+
+// retryError returns retry error, the retryable error codes of product in
+// the retry policy of the provider configuration are retryable as well, see
+// IsRetryableByPolicy.
+func retryError(product string, err error, additionRetryableError ...string) *resource.RetryError {
+	if IsRetryableByPolicy(product, err) {
+		log.Printf("[CRITAL] Retryable policy error: %v", err)
+		return resource.RetryableError(err)
+	}
+
 	switch realErr := errors.Cause(err).(type) {
 	case *sdkErrors.TencentCloudSDKError, *sdkErrorsIntlEn.TencentCloudSDKError:
 		if IsExpectError(realErr, retryableErrorCode) {
@@ -234,7 +247,8 @@ func RetryError(err error, additionRetryableError ...string) *resource.RetryErro
 }
 
 // RetryWithContext retries the function `f` when the error it returns satisfies `predicate`.
-// `f` is retried until `timeout` expires.
+// `f` is retried until `timeout` expires, or as the retry policy carried by
+// `ctx` allows, see connectivity.TencentCloudClient.RetryPolicyContext.
 func RetryWithContext(
 	ctx context.Context,
 	timeout time.Duration,
@@ -242,12 +256,13 @@ func RetryWithContext(
 	additionRetryableError ...string) (interface{}, error) {
 	var output interface{}
 
-	retryErr := resource.Retry(timeout, func() *resource.RetryError {
+	product := CallerProduct(1)
+	retryErr := RetryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
 		output, err = f(ctx)
 
 		if err != nil {
-			return RetryError(err, additionRetryableError...)
+			return retryError(product, err, additionRetryableError...)
 		}
 		return nil
 
@@ -422,13 +437,110 @@ func CheckNil(object interface{}, fields map[string]string) (nilFields []string)
 // The format is `qcs:project_id:service_type:region:account:resource`.
 // For more information, go to https://cloud.tencent.com/document/product/598/10606.
 func BuildTagResourceName(serviceType, resourceType, region, id string) string {
-	switch serviceType {
-	case "cos":
-		return fmt.Sprintf("qcs::%s:%s:uid/:%s/%s", serviceType, region, resourceType, id)
+	return newQcsResourceName(serviceType, region, "", resourceType, id).String()
+}
 
-	default:
-		return fmt.Sprintf("qcs::%s:%s:uin/:%s/%s", serviceType, region, resourceType, id)
+var (
+	qcsServiceTypeRegexp  = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	qcsRegionRegexp       = regexp.MustCompile(`^([a-z]+(-[a-z0-9]+)+)?$`)
+	qcsAccountRegexp      = regexp.MustCompile(`^(uin|uid)/[0-9]*$`)
+	qcsResourceTypeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]*$`)
+)
+
+// QcsResourceName is the six-segment `qcs:project_id:service_type:region:account:resource`
+// name of a resource, where resource is `resource_type/resource_id`.
+type QcsResourceName struct {
+	ProjectId    string
+	ServiceType  string
+	Region       string
+	Account      string
+	ResourceType string
+	ResourceId   string
+}
+
+// NewQcsResourceName builds and validates the name of a resource. The account may be given
+// as a bare uin, in which case it is prefixed with `uid/` for cos and `uin/` otherwise.
+func NewQcsResourceName(serviceType, region, account, resourceType, resourceId string) (*QcsResourceName, error) {
+	name := newQcsResourceName(serviceType, region, account, resourceType, resourceId)
+	if err := name.Validate(); err != nil {
+		return nil, err
+	}
+
+	return name, nil
+}
+
+func newQcsResourceName(serviceType, region, account, resourceType, resourceId string) *QcsResourceName {
+	if !strings.Contains(account, "/") {
+		if serviceType == "cos" {
+			account = "uid/" + account
+		} else {
+			account = "uin/" + account
+		}
+	}
+
+	return &QcsResourceName{
+		ServiceType:  serviceType,
+		Region:       region,
+		Account:      account,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+	}
+}
+
+// ParseQcsResourceName parses a `qcs:project_id:service_type:region:account:resource` name.
+func ParseQcsResourceName(name string) (*QcsResourceName, error) {
+	segments := strings.SplitN(name, ":", 6)
+	if len(segments) != 6 || segments[0] != "qcs" {
+		return nil, fmt.Errorf("`%s` is not in the form `qcs:project_id:service_type:region:account:resource_type/resource_id`", name)
+	}
+
+	resourceType, resourceId, ok := strings.Cut(segments[5], "/")
+	if !ok {
+		return nil, fmt.Errorf("resource segment `%s` of `%s` is not in the form `resource_type/resource_id`", segments[5], name)
+	}
+
+	qcsName := &QcsResourceName{
+		ProjectId:    segments[1],
+		ServiceType:  segments[2],
+		Region:       segments[3],
+		Account:      segments[4],
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+	}
+	if err := qcsName.Validate(); err != nil {
+		return nil, err
+	}
+
+	return qcsName, nil
+}
+
+// Validate checks the service type, region, account and resource type segments.
+func (n *QcsResourceName) Validate() error {
+	if !qcsServiceTypeRegexp.MatchString(n.ServiceType) {
+		return fmt.Errorf("service type `%s` is invalid, it must start with a lowercase letter and contain only lowercase letters, digits, `_` or `-`", n.ServiceType)
+	}
+
+	if !qcsRegionRegexp.MatchString(n.Region) {
+		return fmt.Errorf("region `%s` is invalid, it must be empty or like `ap-guangzhou`", n.Region)
+	}
+
+	if !qcsAccountRegexp.MatchString(n.Account) {
+		return fmt.Errorf("account `%s` is invalid, it must be like `uin/100000000001` or `uid/1250000000`", n.Account)
+	}
+
+	if !qcsResourceTypeRegexp.MatchString(n.ResourceType) {
+		return fmt.Errorf("resource type `%s` is invalid, it must start with a letter and contain only letters, digits, `_`, `.` or `-`", n.ResourceType)
+	}
+
+	if n.ResourceId == "" {
+		return fmt.Errorf("resource id must not be empty")
 	}
+
+	return nil
+}
+
+func (n *QcsResourceName) String() string {
+	return fmt.Sprintf("qcs:%s:%s:%s:%s:%s/%s", n.ProjectId, n.ServiceType, n.Region, n.Account, n.ResourceType, n.ResourceId)
 }
 
 // IsContains returns whether value is within array
@@ -598,6 +710,27 @@ func BuildStateChangeConf(pending, target []string, timeout, delay time.Duration
 	}
 }
 
+type pollIntervalContextKey struct{}
+
+// WithPollInterval returns a copy of ctx whose state changes built by
+// BuildStateChangeConfContext are polled every interval, with no delay, e.g.
+// to run the unit tests of the actions against a stub API in milliseconds.
+func WithPollInterval(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, pollIntervalContextKey{}, interval)
+}
+
+// BuildStateChangeConfContext works like BuildStateChangeConf, but polls
+// every interval set by WithPollInterval on ctx, if any.
+func BuildStateChangeConfContext(ctx context.Context, pending, target []string, timeout, delay time.Duration, refresh resource.StateRefreshFunc) *resource.StateChangeConf {
+	conf := BuildStateChangeConf(pending, target, timeout, delay, refresh)
+	if interval, ok := ctx.Value(pollIntervalContextKey{}).(time.Duration); ok {
+		conf.Delay = 0
+		conf.MinTimeout = interval
+		conf.PollInterval = interval
+	}
+	return conf
+}
+
 func ShortRegionNameParse(shortRegion string) string {
 	regionMap := map[string]string{
 		"gz":      "ap-guangzhou",
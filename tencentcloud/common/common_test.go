@@ -377,3 +377,57 @@ func TestStringListDiffSuppressIgnoreOrder_SubElements(t *testing.T) {
 		})
 	}
 }
+
+func TestParseQcsResourceName(t *testing.T) {
+	name, err := ParseQcsResourceName("qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx")
+	assert.NoError(t, err)
+	assert.Equal(t, &QcsResourceName{
+		ServiceType:  "cvm",
+		Region:       "ap-guangzhou",
+		Account:      "uin/100000000001",
+		ResourceType: "instance",
+		ResourceId:   "ins-xxxxxx",
+	}, name)
+	assert.Equal(t, "qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx", name.String())
+
+	// the resource id may contain `/` and `:`
+	name, err = ParseQcsResourceName("qcs::cos:ap-guangzhou:uid/1250000000:prefix//1250000000/examplebucket/*")
+	assert.NoError(t, err)
+	assert.Equal(t, "prefix", name.ResourceType)
+	assert.Equal(t, "/1250000000/examplebucket/*", name.ResourceId)
+
+	for _, invalid := range []string{
+		"",
+		"qcs::cvm:ap-guangzhou:uin/100000000001",
+		"arn::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx",
+		"qcs::CVM:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx",
+		"qcs::cvm:Guangzhou:uin/100000000001:instance/ins-xxxxxx",
+		"qcs::cvm:ap-guangzhou:100000000001:instance/ins-xxxxxx",
+		"qcs::cvm:ap-guangzhou:uin/100000000001:instance",
+		"qcs::cvm:ap-guangzhou:uin/100000000001:1instance/ins-xxxxxx",
+		"qcs::cvm:ap-guangzhou:uin/100000000001:instance/",
+	} {
+		_, err := ParseQcsResourceName(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBuildTagResourceName(t *testing.T) {
+	assert.Equal(t, "qcs::cvm:ap-guangzhou:uin/:instance/ins-xxxxxx", BuildTagResourceName("cvm", "instance", "ap-guangzhou", "ins-xxxxxx"))
+	assert.Equal(t, "qcs::cos:ap-guangzhou:uid/:bucket/examplebucket-1250000000", BuildTagResourceName("cos", "bucket", "ap-guangzhou", "examplebucket-1250000000"))
+	// the name of a tag resource is not validated
+	assert.Equal(t, "qcs::cam::uin/:role/", BuildTagResourceName("cam", "role", "", ""))
+}
+
+func TestNewQcsResourceName(t *testing.T) {
+	name, err := NewQcsResourceName("cvm", "ap-guangzhou", "100000000001", "instance", "ins-xxxxxx")
+	assert.NoError(t, err)
+	assert.Equal(t, "qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx", name.String())
+
+	name, err = NewQcsResourceName("cos", "ap-guangzhou", "", "bucket", "examplebucket-1250000000")
+	assert.NoError(t, err)
+	assert.Equal(t, BuildTagResourceName("cos", "bucket", "ap-guangzhou", "examplebucket-1250000000"), name.String())
+
+	_, err = NewQcsResourceName("cvm", "ap-guangzhou", "uin/100000000001", "", "ins-xxxxxx")
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// regionIdRegexp matches the import IDs of the form `<region>:<id>`.
+var regionIdRegexp = regexp.MustCompile(`^([a-z]+(?:-[a-z0-9]+)+):(.+)$`)
+
+// RegionSchema returns the schema of the optional `region` argument, which
+// lets a resource live in another region than the one of the provider. The
+// CRUD functions of the resource call the APIs of the region through
+// ResourceMeta, and its importer is wrapped by ImportStateWithRegion.
+func RegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the resource, e.g. `ap-shanghai`. Default is the region of the provider. To import a resource of another region, prefix its ID with the region and a colon, e.g. `ap-shanghai:<id>`.",
+	}
+}
+
+// regionMeta is the ProviderMeta of a resource with a `region`.
+type regionMeta struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (me *regionMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return me.client
+}
+
+// ResourceMeta returns the meta of the resource d, whose client calls the
+// APIs in the `region` of d, or in the region of the provider if it is not
+// set. See RegionSchema.
+func ResourceMeta(d *schema.ResourceData, meta interface{}) interface{} {
+	region, ok := d.GetOk("region")
+	if !ok {
+		return meta
+	}
+
+	client := meta.(ProviderMeta).GetAPIV3Conn()
+	if region.(string) == client.Region {
+		return meta
+	}
+	return &regionMeta{client: client.WithRegion(region.(string))}
+}
+
+// ImportStateWithRegion wraps the importer of a resource with a `region`, so
+// that the ID `<region>:<id>` imports the resource `<id>` of `<region>`.
+func ImportStateWithRegion(importer schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if match := regionIdRegexp.FindStringSubmatch(d.Id()); match != nil {
+			if err := d.Set("region", match[1]); err != nil {
+				return nil, fmt.Errorf("set region of %s failed: %v", d.Id(), err)
+			}
+			d.SetId(match[2])
+		}
+
+		return importer(ctx, d, meta)
+	}
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+type testProviderMeta struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (me *testProviderMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return me.client
+}
+
+func TestResourceMeta(t *testing.T) {
+	meta := &testProviderMeta{client: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"region": RegionSchema()}}
+
+	d := resource.TestResourceData()
+	assert.Equal(t, meta, ResourceMeta(d, meta))
+
+	assert.NoError(t, d.Set("region", "ap-guangzhou"))
+	assert.Equal(t, meta, ResourceMeta(d, meta))
+
+	assert.NoError(t, d.Set("region", "ap-shanghai"))
+	client := ResourceMeta(d, meta).(ProviderMeta).GetAPIV3Conn()
+	assert.Equal(t, "ap-shanghai", client.Region)
+	assert.Equal(t, "ap-guangzhou", meta.client.Region)
+}
+
+func TestImportStateWithRegion(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"region": RegionSchema()}}
+	importer := ImportStateWithRegion(schema.ImportStatePassthroughContext)
+
+	for id, expected := range map[string][2]string{
+		"ins-abc":                 {"", "ins-abc"},
+		"ap-shanghai:ins-abc":     {"ap-shanghai", "ins-abc"},
+		"na-siliconvalley:lb-abc": {"na-siliconvalley", "lb-abc"},
+		"vpc-abc#subnet-abc":      {"", "vpc-abc#subnet-abc"},
+	} {
+		d := resource.TestResourceData()
+		d.SetId(id)
+		result, err := importer(context.Background(), d, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, result, 1) {
+			assert.Equal(t, expected[0], result[0].Get("region"), id)
+			assert.Equal(t, expected[1], result[0].Id(), id)
+		}
+	}
+}
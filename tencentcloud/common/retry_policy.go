@@ -0,0 +1,142 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"runtime"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pkg/errors"
+	sdkErrorsIntlEn "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/common/errors"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentyun/cos-go-sdk-v5"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// IsRetryableByPolicy returns whether err is one of the additional retryable
+// error codes of product in the retry policy of the client which made the
+// failed call, see connectivity.FailedCallRetryPolicy.
+func IsRetryableByPolicy(product string, err error) bool {
+	switch realErr := errors.Cause(err).(type) {
+	case *sdkErrors.TencentCloudSDKError:
+		return isRetryableByPolicy(connectivity.FailedCallRetryPolicy(realErr.RequestId), product, realErr)
+	case *sdkErrorsIntlEn.TencentCloudSDKError:
+		return isRetryableByPolicy(connectivity.FailedCallRetryPolicy(realErr.RequestId), product, realErr)
+	case *cos.ErrorResponse:
+		requestId := realErr.RequestID
+		if requestId == "" && realErr.Response != nil {
+			requestId = realErr.Response.Header.Get("X-Cos-Request-Id")
+		}
+		return isRetryableByPolicy(connectivity.FailedCallRetryPolicy(requestId), product, realErr)
+	}
+	return false
+}
+
+// isRetryableByPolicy returns whether err is one of the additional retryable
+// error codes of product in policy.
+func isRetryableByPolicy(policy *connectivity.RetryPolicy, product string, err error) bool {
+	if policy == nil {
+		return false
+	}
+
+	codes := policy.RetryableErrorCodes[product]
+	if len(codes) == 0 {
+		return false
+	}
+
+	switch realErr := errors.Cause(err).(type) {
+	case *sdkErrors.TencentCloudSDKError, *sdkErrorsIntlEn.TencentCloudSDKError:
+		return IsExpectError(realErr, codes)
+	case *cos.ErrorResponse:
+		return isCosExpectedError(realErr, codes)
+	}
+	return false
+}
+
+// RetryContext works like resource.RetryContext, and applies the retry policy
+// carried by ctx, see connectivity.TencentCloudClient.RetryPolicyContext: the
+// wait between attempts is an exponential backoff with jitter and the number
+// of attempts is limited.
+func RetryContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc) error {
+	policy := connectivity.RetryPolicyFromContext(ctx)
+	if policy == nil {
+		if ctx == nil {
+			return resource.Retry(timeout, f)
+		}
+		return resource.RetryContext(ctx, timeout, f)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	deadline := time.Now().Add(timeout)
+	for attempt := 1; ; attempt++ {
+		retryErr := f()
+		if retryErr == nil {
+			return nil
+		}
+		if !retryErr.Retryable {
+			return retryErr.Err
+		}
+
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, retryErr.Err)
+		}
+
+		backoff := policy.Backoff(attempt)
+		if time.Now().Add(backoff).After(deadline) {
+			return &resource.TimeoutError{LastError: retryErr.Err, Timeout: timeout}
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &resource.TimeoutError{LastError: retryErr.Err, Timeout: timeout}
+		case <-timer.C:
+		}
+	}
+}
+
+// packageProducts are the service packages named differently from the
+// service of the API endpoint they call.
+var packageProducts = map[string]string{
+	"ccn":        "vpc",
+	"cdh":        "cvm",
+	"ci":         "cos",
+	"crs":        "redis",
+	"css":        "live",
+	"dayuv2":     "antiddos",
+	"dcg":        "vpc",
+	"fl":         "vpc",
+	"pls":        "vpc",
+	"postgresql": "postgres",
+	"project":    "tag",
+	"tcmg":       "monitor",
+	"tcmq":       "tdmq",
+	"tco":        "organization",
+	"tmp":        "monitor",
+	"tpulsar":    "tdmq",
+	"trabbit":    "tdmq",
+}
+
+// CallerProduct returns the product of the function skip frames above the
+// caller of CallerProduct, which is the service of the API endpoint called
+// by the service package it belongs to, e.g. `cvm` for
+// tencentcloud/services/cvm and `postgres` for tencentcloud/services/postgresql.
+func CallerProduct(skip int) string {
+	_, file, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	product := path.Base(path.Dir(file))
+	if service, ok := packageProducts[product]; ok {
+		return service
+	}
+	return product
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// sendFailedCall sends an API call failing with requestId through a client
+// with policy, as the client of a provider configuration does.
+func sendFailedCall(t *testing.T, policy *connectivity.RetryPolicy, requestId string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Response":{"RequestId":"` + requestId + `","Error":{"Code":"FailedOperation.TaskConflict","Message":"conflict"}}}`))
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+	assert.NoError(t, err)
+	response, err := (&connectivity.TencentCloudClient{RetryPolicy: policy}).NewLogRoundTripper("cvm").RoundTrip(request)
+	assert.NoError(t, err)
+	_ = response.Body.Close()
+}
+
+func TestIsRetryableByPolicy(t *testing.T) {
+	err := sdkErrors.NewTencentCloudSDKError("FailedOperation.TaskConflict", "conflict", "")
+	assert.False(t, isRetryableByPolicy(nil, "cvm", err))
+
+	policy := &connectivity.RetryPolicy{RetryableErrorCodes: map[string][]string{"cvm": {"FailedOperation"}}}
+	assert.True(t, isRetryableByPolicy(policy, "cvm", err))
+	assert.False(t, isRetryableByPolicy(policy, "vpc", err))
+	assert.False(t, isRetryableByPolicy(policy, "cvm", errors.New("FailedOperation")))
+}
+
+func TestRetryErrorWithPolicy(t *testing.T) {
+	policy := &connectivity.RetryPolicy{RetryableErrorCodes: map[string][]string{"common": {"FailedOperation.TaskConflict"}}}
+	sendFailedCall(t, policy, "req-with-policy")
+	sendFailedCall(t, nil, "req-without-policy")
+
+	// only the errors of the calls made by the client with the policy are
+	// retried with its error codes
+	err := sdkErrors.NewTencentCloudSDKError("FailedOperation.TaskConflict", "conflict", "req-with-policy")
+	assert.True(t, RetryError(err).Retryable)
+	assert.False(t, retryError("cvm", err).Retryable)
+
+	err = sdkErrors.NewTencentCloudSDKError("FailedOperation.TaskConflict", "conflict", "req-without-policy")
+	assert.False(t, RetryError(err).Retryable)
+}
+
+func TestRetryContextWithPolicy(t *testing.T) {
+	client := &connectivity.TencentCloudClient{
+		RetryPolicy: &connectivity.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+	ctx := client.RetryPolicyContext(context.Background())
+
+	calls := 0
+	target := errors.New("transient")
+	err := RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		calls++
+		return resource.RetryableError(target)
+	})
+	assert.ErrorIs(t, err, target)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		calls++
+		if calls < 2 {
+			return resource.RetryableError(target)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	client.RetryPolicy = &connectivity.RetryPolicy{BaseBackoff: time.Second}
+	err = RetryContext(client.RetryPolicyContext(context.Background()), 100*time.Millisecond, func() *resource.RetryError {
+		return resource.RetryableError(target)
+	})
+	var timeoutErr *resource.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+
+	// a context without a policy keeps the default retries
+	calls = 0
+	err = RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		calls++
+		if calls < 4 {
+			return resource.RetryableError(target)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestCallerProduct(t *testing.T) {
+	assert.Equal(t, "common", CallerProduct(0))
+}
@@ -196,6 +196,9 @@ func ValidatePortRange(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// MysqlPasswordSymbols are the symbols accepted by ValidateMysqlPassword.
+const MysqlPasswordSymbols = "_+-&=!@#$%^*()"
+
 func ValidateMysqlPassword(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 64 || len(value) < 8 {
@@ -203,7 +206,7 @@ func ValidateMysqlPassword(v interface{}, k string) (ws []string, errors []error
 		errors = append(errors, fmt.Errorf("the length of %s must be 8-64: %s", k, value))
 	}
 	var match = make(map[string]bool)
-	if strings.ContainsAny(value, "_+-&=!@#$%^*()") {
+	if strings.ContainsAny(value, MysqlPasswordSymbols) {
 		match["alien"] = true
 	}
 	for i := 0; i < len(value); i++ {
@@ -370,6 +373,14 @@ func ValidateTime(layout string) schema.SchemaValidateFunc {
 	}
 }
 
+func ValidateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if d, err := time.ParseDuration(value); err != nil || d < 0 {
+		errors = append(errors, fmt.Errorf("%s must be a non-negative duration such as `1s` or `500ms`, got: %s", k, value))
+	}
+	return
+}
+
 func ValidateYaml(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if err := yaml.Unmarshal([]byte(value), make(map[interface{}]interface{})); err != nil {
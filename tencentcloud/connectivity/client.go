@@ -2,7 +2,6 @@ package connectivity
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -123,6 +122,8 @@ import (
 	wedatav20250806 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wedata/v20250806"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wss/v20180426"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 //internal version: replace import begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -140,134 +141,36 @@ type TencentCloudClient struct {
 	Protocol   string
 	Domain     string
 	CosDomain  string
-
-	cosConn              *s3.S3
-	tencentCosConn       *cos.Client
-	mysqlConn            *cdb.Client
-	redisConn            *redis.Client
-	asConn               *as.Client
-	vpcConn              *vpc.Client
-	cbsConn              *cbs.Client
-	cvmv20170312Conn     *cvmv20170312.Client
-	cvmIntlConn          *cvmintl.Client
-	clbConn              *clb.Client
-	clbIntlConn          *clbintl.Client
-	dayuConn             *dayu.Client
-	dcConn               *dc.Client
-	tagConn              *tag.Client
-	mongodbConn          *mongodb.Client
-	tkev20180525Conn     *tkev20180525.Client
-	tdmqConn             *tdmq.Client
-	tcrConn              *tcr.Client
-	camConn              *cam.Client
-	stsConn              *sts.Client
-	gaapConn             *gaap.Client
-	sslConn              *ssl.Client
-	cfsConn              *cfs.Client
-	scfConn              *scf.Client
-	tcaplusConn          *tcaplusdb.Client
-	cdnConn              *cdn.Client
-	monitorConn          *monitor.Client
-	monitorv20230616Conn *monitorv20230616.Client
-	esConn               *es.Client
-	sqlserverConn        *sqlserver.Client
-	postgreConn          *postgre.Client
-	ckafkaConn           *ckafka.Client
-	auditConn            *audit.Client
-	cynosConn            *cynosdb.Client
-	vodConn              *vod.Client
-	apiGatewayConn       *apigateway.Client
-	sslCertificateConn   *sslCertificate.Client
-	kmsConn              *kms.Client
-	ssmConn              *ssm.Client
-	apiConn              *api.Client
-	emrConn              *emr.Client
-	clsConn              *cls.Client
-	dnsPodConn           *dnspod.Client
-	dnsPodIntlConn       *dnspodintl.Client
-	privateDnsConn       *privatedns.Client
-	antiddosConn         *antiddos.Client
-	domainConn           *domain.Client
-	lighthouseConn       *lighthouse.Client
-	temConn              *tem.Client
-	teoConn              *teo.Client
-	tcmConn              *tcm.Client
-	cssConn              *css.Client
-	sesConn              *ses.Client
-	dcdbConn             *dcdb.Client
-	smsConn              *sms.Client
-	catConn              *cat.Client
-	mariadbConn          *mariadb.Client
-	rumConn              *rum.Client
-	ptsConn              *pts.Client
-	tatConn              *tat.Client
-	organizationConn     *organization.Client
-	tdcpgConn            *tdcpg.Client
-	dbbrainConn          *dbbrain.Client
-	dtsConn              *dts.Client
-	ciConn               *cos.Client
-	cosBatchConn         *cos.Client
-	tsfConn              *tsf.Client
-	mpsConn              *mps.Client
-	cwpConn              *cwp.Client
-	chdfsConn            *chdfs.Client
-	configv20220802Conn  *configv20220802.Client
-	mdlConn              *mdl.Client
-	apmConn              *apm.Client
-	ciamConn             *ciam.Client
-	tseConn              *tse.Client
-	cdwchConn            *cdwch.Client
-	ebConn               *eb.Client
-	dlcConn              *dlc.Client
-	wedataConn           *wedata.Client
-	wedatav20250806Conn  *wedatav20250806.Client
-	wafConn              *waf.Client
-	cfwConn              *cfw.Client
-	oceanusConn          *oceanus.Client
-	dasbConn             *dasb.Client
-	trocketConn          *trocket.Client
-	biConn               *bi.Client
-	cdwpgConn            *cdwpg.Client
-	csipConn             *csip.Client
-	regionConn           *region.Client
+	// Endpoints are the endpoints of the services keyed by the names in
+	// EndpointServices, overriding Domain and CosDomain, e.g.
+	// `cvm.internal.tencentcloudapi.com` for `cvm`.
+	Endpoints map[string]string
+	// Transport sends all the API and COS requests, see NewTransport. The
+	// default transport is used when it is nil.
+	Transport http.RoundTripper
+	// DefaultTags are merged into the `tags` of every taggable resource.
+	DefaultTags map[string]string
+	// IgnoreTags are tag keys managed outside of terraform.
+	IgnoreTags *IgnoreTagsConfig
+	// RateLimiter limits the API requests, see ratelimit.Limiter. The
+	// requests are not limited when it is nil.
+	RateLimiter *ratelimit.Limiter
+	// RetryPolicy is the retry policy of the provider configuration, see
+	// RetryPolicyContext and FailedCallRetryPolicy. The default retries
+	// apply when it is nil.
+	RetryPolicy *RetryPolicy
+
+	// RefreshingCredential, if set, is the credential of the clients
+	// instead of Credential, see SetRefreshingCredential.
+	RefreshingCredential *RefreshingCredential
+	// ResourceType is the resource type the API calls are counted for in the
+	// API metrics, see WithResourceType.
+	ResourceType string
+
+	// clients caches the clients of the services, see cachedClient.
+	clients *clientCache
 	//internal version: replace client begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 	//internal version: replace client end, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
-	tkev20220501Conn  *tkev20220501.Client
-	cdcConn           *cdc.Client
-	cdwdorisConn      *cdwdoris.Client
-	controlcenterConn *controlcenter.Client
-	thpcConn          *thpc.Client
-	//omit nil client
-	omitNilConn                 *common.Client
-	emrv20190103Conn            *emr.Client
-	teov20220901Conn            *teo.Client
-	sslv20191205Conn            *sslCertificate.Client
-	postgresv20170312Conn       *postgre.Client
-	cfwv20190904Conn            *cfw.Client
-	ccnv20170312Conn            *vpc.Client
-	tcssv20201101Conn           *tcss.Client
-	cloudauditv20190319Conn     *audit.Client
-	privatednsv20201028Conn     *privatedns.Client
-	privatednsIntlv20201028Conn *privatednsIntl.Client
-	wafv20180125Conn            *waf.Client
-	camv20190116Conn            *cam.Client
-	clsv20201016Conn            *cls.Client
-	postgresqlv20170312Conn     *postgre.Client
-	monitor20180724Conn         *monitor.Client
-	cdcv20201214Conn            *cdc.Client
-	mqttv20240516Conn           *mqtt.Client
-	cdwpgv20201230Conn          *cdwpg.Client
-	gwlbv20240906Conn           *gwlb.Client
-	billingv20180709Conn        *billing.Client
-	igtmv20231024Conn           *igtmv20231024.Client
-	ga2v20250115Conn            *ga2v20250115.Client
-	gsv20191118Conn             *gsv20191118.Client
-	keewidbv20220308Conn        *keewidbv20220308.Client
-	vdbv20230616Conn            *vdbv20230616.Client
-	bhv20230418Conn             *bhv20230418.Client
-	dbdcv20201029Conn           *dbdcv20201029.Client
-	vcubev20220410Conn          *vcubev20220410.Client
-	advisorv20200721Conn        *advisorv20200721.Client
 }
 
 // NewClientProfile returns a new ClientProfile
@@ -288,6 +191,21 @@ func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfi
 	return cpf
 }
 
+// NewServiceClientProfile returns a new ClientProfile sending the requests of
+// service to its endpoint in Endpoints, if any
+func (me *TencentCloudClient) NewServiceClientProfile(service string, timeout int) *profile.ClientProfile {
+	cpf := me.NewClientProfile(timeout)
+	if endpoint := me.Endpoints[service]; endpoint != "" {
+		scheme, host := splitEndpoint(endpoint)
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = scheme
+		}
+		cpf.HttpProfile.Endpoint = host
+	}
+
+	return cpf
+}
+
 // NewClientIntlProfile returns a new ClientProfile
 func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.ClientProfile {
 	cpf := intlProfile.NewClientProfile()
@@ -306,6 +224,97 @@ func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.Cli
 	return cpf
 }
 
+// NewServiceClientIntlProfile returns a new intl ClientProfile sending the
+// requests of service to its endpoint in Endpoints, if any
+func (me *TencentCloudClient) NewServiceClientIntlProfile(service string, timeout int) *intlProfile.ClientProfile {
+	cpf := me.NewClientIntlProfile(timeout)
+	if endpoint := me.Endpoints[service]; endpoint != "" {
+		scheme, host := splitEndpoint(endpoint)
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = scheme
+		}
+		cpf.HttpProfile.Endpoint = host
+	}
+
+	return cpf
+}
+
+// GetCredential returns the credential the clients sign the requests with,
+// which is RefreshingCredential if set, or else Credential.
+func (me *TencentCloudClient) GetCredential() common.CredentialIface {
+	if me.RefreshingCredential != nil {
+		return me.RefreshingCredential
+	}
+	return me.Credential
+}
+
+// SetCredential makes credential the credential of the clients of me.
+func (me *TencentCloudClient) SetCredential(credential *common.Credential) {
+	me.Credential = credential
+	me.RefreshingCredential = nil
+}
+
+// SetRefreshingCredential makes credential the credential of the clients
+// of me, which rotate to the new credential whenever it is refreshed.
+// Credential is set to its current credential, for the callers reading the
+// keys once.
+func (me *TencentCloudClient) SetRefreshingCredential(credential *RefreshingCredential) {
+	me.Credential = credential.current()
+	me.RefreshingCredential = credential
+}
+
+// WithRegion returns a copy of me calling the APIs in region, which shares
+// the client cache of me.
+func (me *TencentCloudClient) WithRegion(region string) *TencentCloudClient {
+	me.clientCache()
+	client := *me
+	client.Region = region
+	return &client
+}
+
+// WithResourceType returns a copy of me whose API calls are counted for
+// resourceType in the API metrics, which shares the client cache of me.
+func (me *TencentCloudClient) WithResourceType(resourceType string) *TencentCloudClient {
+	me.clientCache()
+	client := *me
+	client.ResourceType = resourceType
+	return &client
+}
+
+// NewLogRoundTripper returns a LogRoundTripper sending the requests of product
+// through Transport, limited by RateLimiter and counted for ResourceType
+func (me *TencentCloudClient) NewLogRoundTripper(product string) *LogRoundTripper {
+	return &LogRoundTripper{
+		Product:      product,
+		Transport:    me.Transport,
+		RateLimiter:  me.RateLimiter,
+		RetryPolicy:  me.RetryPolicy,
+		ResourceType: me.ResourceType,
+	}
+}
+
+// httpTransport returns the transport of the COS clients, which records the
+// failed calls when RetryPolicy is set
+func (me *TencentCloudClient) httpTransport() http.RoundTripper {
+	transport := me.Transport
+	if transport == nil {
+		transport = defaultTransport
+	}
+	if me.RetryPolicy != nil {
+		return &cosRetryPolicyTransport{Policy: me.RetryPolicy, Transport: transport}
+	}
+	return transport
+}
+
+// cosDomain returns the COS domain, the `cos` endpoint takes precedence over
+// CosDomain
+func (me *TencentCloudClient) cosDomain() string {
+	if endpoint := me.Endpoints["cos"]; endpoint != "" {
+		return endpoint
+	}
+	return me.CosDomain
+}
+
 func (me *TencentCloudClient) UseCosClientNew(cdcId ...string) *s3.S3 {
 	if cdcId[0] == "" {
 		return me.UseCosClient()
@@ -316,55 +325,59 @@ func (me *TencentCloudClient) UseCosClientNew(cdcId ...string) *s3.S3 {
 
 // UseCosClient returns cos client for service
 func (me *TencentCloudClient) UseCosClient() *s3.S3 {
-	if me.cosConn != nil {
-		return me.cosConn
-	}
-
-	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
-		if service == endpoints.S3ServiceID {
-			cosUrl := fmt.Sprintf("https://cos.%s.myqcloud.com", region)
-			if me.CosDomain != "" {
-				cosUrl = me.CosDomain
+	return cachedClient(me, newClientKey("cos", "s3", me.Region, nil), func(*LogRoundTripper) *s3.S3 {
+		resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			if service == endpoints.S3ServiceID {
+				cosUrl := fmt.Sprintf("https://cos.%s.myqcloud.com", region)
+				if cosDomain := me.cosDomain(); cosDomain != "" {
+					cosUrl = cosDomain
+				}
+				return endpoints.ResolvedEndpoint{
+					URL:           cosUrl,
+					SigningRegion: region,
+				}, nil
 			}
-			return endpoints.ResolvedEndpoint{
-				URL:           cosUrl,
-				SigningRegion: region,
-			}, nil
+			return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 		}
-		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
-	}
 
-	creds := credentials.NewStaticCredentials(me.Credential.SecretId, me.Credential.SecretKey, me.Credential.Token)
-	sess := session.Must(session.NewSession(&aws.Config{
-		Credentials:      creds,
-		Region:           aws.String(me.Region),
-		EndpointResolver: endpoints.ResolverFunc(resolver),
-	}))
+		creds := credentials.NewCredentials(&s3CredentialProvider{credential: me.GetCredential()})
+		sess := session.Must(session.NewSession(&aws.Config{
+			Credentials:      creds,
+			Region:           aws.String(me.Region),
+			EndpointResolver: endpoints.ResolverFunc(resolver),
+			HTTPClient:       &http.Client{Transport: me.httpTransport()},
+		}))
 
-	return s3.New(sess)
+		return s3.New(sess)
+	})
 }
 
 // UseCosClient returns cos client for service with CDC
 func (me *TencentCloudClient) UseCosCdcClient(cdcId string) *s3.S3 {
-	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
-		if service == endpoints.S3ServiceID {
-			endpointUrl := fmt.Sprintf("https://%s.cos-cdc.%s.myqcloud.com", cdcId, region)
-			return endpoints.ResolvedEndpoint{
-				URL:           endpointUrl,
-				SigningRegion: region,
-			}, nil
+	key := newClientKey("cos", "s3", me.Region, nil)
+	key.endpoint = cdcId
+	return cachedClient(me, key, func(*LogRoundTripper) *s3.S3 {
+		resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			if service == endpoints.S3ServiceID {
+				endpointUrl := fmt.Sprintf("https://%s.cos-cdc.%s.myqcloud.com", cdcId, region)
+				return endpoints.ResolvedEndpoint{
+					URL:           endpointUrl,
+					SigningRegion: region,
+				}, nil
+			}
+			return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 		}
-		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
-	}
 
-	creds := credentials.NewStaticCredentials(me.Credential.SecretId, me.Credential.SecretKey, me.Credential.Token)
-	sess := session.Must(session.NewSession(&aws.Config{
-		Credentials:      creds,
-		Region:           aws.String(me.Region),
-		EndpointResolver: endpoints.ResolverFunc(resolver),
-	}))
+		creds := credentials.NewCredentials(&s3CredentialProvider{credential: me.GetCredential()})
+		sess := session.Must(session.NewSession(&aws.Config{
+			Credentials:      creds,
+			Region:           aws.String(me.Region),
+			EndpointResolver: endpoints.ResolverFunc(resolver),
+			HTTPClient:       &http.Client{Transport: me.httpTransport()},
+		}))
 
-	return s3.New(sess)
+		return s3.New(sess)
+	})
 }
 
 func (me *TencentCloudClient) UseTencentCosClientNew(bucket string, cdcId ...string) *cos.Client {
@@ -378,8 +391,8 @@ func (me *TencentCloudClient) UseTencentCosClientNew(bucket string, cdcId ...str
 // UseTencentCosClient tencent cloud own client for service instead of aws
 func (me *TencentCloudClient) UseTencentCosClient(bucket string, clientTimeout ...time.Duration) *cos.Client {
 	cosUrl := fmt.Sprintf("https://%s.cos.%s.myqcloud.com", bucket, me.Region)
-	if me.CosDomain != "" {
-		parsedURL, _ := url.Parse(me.CosDomain)
+	if cosDomain := me.cosDomain(); cosDomain != "" {
+		parsedURL, _ := url.Parse(cosDomain)
 		parsedURL.Host = bucket + "." + parsedURL.Host
 		cosUrl = parsedURL.String()
 	}
@@ -389,1471 +402,972 @@ func (me *TencentCloudClient) UseTencentCosClient(bucket string, clientTimeout .
 		tmpTimeout = clientTimeout[0]
 	}
 
-	u, _ := url.Parse(cosUrl)
-
-	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL == u {
-		return me.tencentCosConn
-	}
-
-	baseUrl := &cos.BaseURL{
-		BucketURL: u,
-	}
-
-	me.tencentCosConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: tmpTimeout,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+	return me.useCosClient(cosUrl, tmpTimeout, func(u *url.URL) *cos.BaseURL {
+		return &cos.BaseURL{BucketURL: u}
 	})
-
-	return me.tencentCosConn
 }
 
 // UseTencentCosClient tencent cloud own client for service instead of aws with CDC
 func (me *TencentCloudClient) UseTencentCosCdcClient(bucket string, cdcId string) *cos.Client {
-	var u *url.URL
-	u, _ = url.Parse(fmt.Sprintf("https://%s.%s.cos-cdc.%s.myqcloud.com", bucket, cdcId, me.Region))
-
-	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL == u {
-		return me.tencentCosConn
-	}
-
-	baseUrl := &cos.BaseURL{
-		BucketURL: u,
-	}
-
-	me.tencentCosConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+	cosUrl := fmt.Sprintf("https://%s.%s.cos-cdc.%s.myqcloud.com", bucket, cdcId, me.Region)
+	return me.useCosClient(cosUrl, 100*time.Second, func(u *url.URL) *cos.BaseURL {
+		return &cos.BaseURL{BucketURL: u}
 	})
+}
 
-	return me.tencentCosConn
+// useCosClient returns the COS client of baseUrl(cosUrl), which is cached by
+// cosUrl and timeout
+func (me *TencentCloudClient) useCosClient(cosUrl string, timeout time.Duration, baseUrl func(u *url.URL) *cos.BaseURL) *cos.Client {
+	key := newClientKey("cos", "xml", me.Region, nil)
+	key.endpoint = cosUrl
+	key.timeout = timeout
+	return cachedClient(me, key, func(*LogRoundTripper) *cos.Client {
+		u, _ := url.Parse(cosUrl)
+		return cos.NewClient(baseUrl(u), &http.Client{
+			Timeout: timeout,
+			Transport: &cos.CredentialTransport{
+				Credential: me.GetCredential(),
+				Transport:  me.httpTransport(),
+			},
+		})
+	})
 }
 
 // UseMysqlClient returns mysql(cdb) client for service
 func (me *TencentCloudClient) UseMysqlClient(iacExtInfo ...IacExtInfo) *cdb.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	// if me.mysqlConn != nil {
-	// 	me.mysqlConn.WithHttpTransport(&logRoundTripper)
-	// 	return me.mysqlConn
-	// }
-
-	cpf := me.NewClientProfile(300)
-	me.mysqlConn, _ = cdb.NewClient(me.Credential, me.Region, cpf)
-	me.mysqlConn.WithHttpTransport(&logRoundTripper)
-
-	return me.mysqlConn
+	return cachedClient(me, newClientKey("cdb", cdb.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cdb.Client {
+		cpf := me.NewServiceClientProfile("cdb", 300)
+		client, _ := cdb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseMysqlClientRegion(region string, iacExtInfo ...IacExtInfo) *cdb.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
+	if region == "" {
+		region = me.Region
 	}
 
-	cpf := me.NewClientProfile(300)
-	if region != "" {
-		me.mysqlConn, _ = cdb.NewClient(me.Credential, region, cpf)
-	} else {
-		me.mysqlConn, _ = cdb.NewClient(me.Credential, me.Region, cpf)
-	}
-	me.mysqlConn.WithHttpTransport(&logRoundTripper)
-
-	return me.mysqlConn
+	return cachedClient(me, newClientKey("cdb", cdb.APIVersion, region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cdb.Client {
+		cpf := me.NewServiceClientProfile("cdb", 300)
+		client, _ := cdb.NewClient(me.GetCredential(), region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseRedisClient returns redis client for service
 func (me *TencentCloudClient) UseRedisClient() *redis.Client {
-	if me.redisConn != nil {
-		return me.redisConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.redisConn, _ = redis.NewClient(me.Credential, me.Region, cpf)
-	me.redisConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.redisConn
+	return cachedClient(me, newClientKey("redis", redis.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *redis.Client {
+		cpf := me.NewServiceClientProfile("redis", 300)
+		client, _ := redis.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseAsClient returns as client for service
 func (me *TencentCloudClient) UseAsClient() *as.Client {
-	if me.asConn != nil {
-		return me.asConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.asConn, _ = as.NewClient(me.Credential, me.Region, cpf)
-	me.asConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.asConn
+	return cachedClient(me, newClientKey("as", as.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *as.Client {
+		cpf := me.NewServiceClientProfile("as", 300)
+		client, _ := as.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseVpcClient returns vpc client for service
 func (me *TencentCloudClient) UseVpcClient(iacExtInfo ...IacExtInfo) *vpc.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.vpcConn != nil {
-		me.vpcConn.WithHttpTransport(&logRoundTripper)
-		return me.vpcConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.vpcConn, _ = vpc.NewClient(me.Credential, me.Region, cpf)
-	me.vpcConn.WithHttpTransport(&logRoundTripper)
-
-	return me.vpcConn
+	return cachedClient(me, newClientKey("vpc", vpc.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *vpc.Client {
+		cpf := me.NewServiceClientProfile("vpc", 300)
+		client, _ := vpc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
+// UseOmitNilClient returns the common client of module, which sends the
+// requests built with tchttp.NewCommonRequest, leaving out the nil fields
 func (me *TencentCloudClient) UseOmitNilClient(module string) *common.Client {
-	secretId := me.Credential.SecretId
-	secretKey := me.Credential.SecretKey
-	token := me.Credential.Token
-	region := me.Region
-	var credential common.CredentialIface
-	if token != "" {
-		credential = common.NewTokenCredential(secretId, secretKey, token)
-	} else {
-		credential = common.NewCredential(secretId, secretKey)
-	}
-
-	cpf := profile.NewClientProfile()
-	cpf.HttpProfile.Endpoint = fmt.Sprintf("%s.tencentcloudapi.com", module)
-	cpf.HttpProfile.ReqMethod = "POST"
-	me.omitNilConn = common.NewCommonClient(credential, region, cpf).WithLogger(log.Default())
-
-	return me.omitNilConn
+	return cachedClient(me, newClientKey(module, "", me.Region, nil), func(logRoundTripper *LogRoundTripper) *common.Client {
+		cpf := me.NewServiceClientProfile(module, 300)
+		client := common.NewCommonClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCbsClient returns cbs client for service
 func (me *TencentCloudClient) UseCbsClient(iacExtInfo ...IacExtInfo) *cbs.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.cbsConn != nil {
-		me.cbsConn.WithHttpTransport(&logRoundTripper)
-		return me.cbsConn
-	}
-
-	var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
-	me.cbsConn, _ = cbs.NewClient(me.Credential, me.Region, cpf)
-	me.cbsConn.WithHttpTransport(&logRoundTripper)
-
-	return me.cbsConn
+	return cachedClient(me, newClientKey("cbs", cbs.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cbs.Client {
+		var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
+		cpf := me.NewServiceClientProfile("cbs", reqTimeout)
+		client, _ := cbs.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDcClient returns dc client for service
 func (me *TencentCloudClient) UseDcClient() *dc.Client {
-	if me.dcConn != nil {
-		return me.dcConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dcConn, _ = dc.NewClient(me.Credential, me.Region, cpf)
-	me.dcConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dcConn
+	return cachedClient(me, newClientKey("dc", dc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dc.Client {
+		cpf := me.NewServiceClientProfile("dc", 300)
+		client, _ := dc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMongodbClient returns mongodb client for service
 func (me *TencentCloudClient) UseMongodbClient(iacExtInfo ...IacExtInfo) *mongodb.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.mongodbConn != nil {
-		me.mongodbConn.WithHttpTransport(&logRoundTripper)
-		return me.mongodbConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.mongodbConn, _ = mongodb.NewClient(me.Credential, me.Region, cpf)
-	me.mongodbConn.WithHttpTransport(&logRoundTripper)
-
-	return me.mongodbConn
+	return cachedClient(me, newClientKey("mongodb", mongodb.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *mongodb.Client {
+		cpf := me.NewServiceClientProfile("mongodb", 300)
+		client, _ := mongodb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseClbClient returns clb client for service
 func (me *TencentCloudClient) UseClbClient(iacExtInfo ...IacExtInfo) *clb.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.clbConn != nil {
-		me.clbConn.WithHttpTransport(&logRoundTripper)
-		return me.clbConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.clbConn, _ = clb.NewClient(me.Credential, me.Region, cpf)
-	me.clbConn.WithHttpTransport(&logRoundTripper)
-
-	return me.clbConn
+	return cachedClient(me, newClientKey("clb", clb.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *clb.Client {
+		cpf := me.NewServiceClientProfile("clb", 300)
+		client, _ := clb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseClbClient returns clb Intl client for service
 func (me *TencentCloudClient) UseClbIntlClient(iacExtInfo ...IacExtInfo) *clbintl.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.clbIntlConn != nil {
-		me.clbIntlConn.WithHttpTransport(&logRoundTripper)
-		return me.clbIntlConn
-	}
-
-	cpf := me.NewClientIntlProfile(300)
-	me.clbIntlConn, _ = clbintl.NewClient(me.Credential, me.Region, cpf)
-	me.clbIntlConn.WithHttpTransport(&logRoundTripper)
-
-	return me.clbIntlConn
+	return cachedClient(me, newClientKey("clb", clbintl.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *clbintl.Client {
+		cpf := me.NewServiceClientIntlProfile("clb", 300)
+		client, _ := clbintl.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCvmClient returns cvm client for service
 func (me *TencentCloudClient) UseCvmClient(iacExtInfo ...IacExtInfo) *cvmv20170312.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.cvmv20170312Conn != nil {
-		me.cvmv20170312Conn.WithHttpTransport(&logRoundTripper)
-		return me.cvmv20170312Conn
-	}
-
-	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
-	me.cvmv20170312Conn, _ = cvmv20170312.NewClient(me.Credential, me.Region, cpf)
-	me.cvmv20170312Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.cvmv20170312Conn
+	return cachedClient(me, newClientKey("cvm", cvmv20170312.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cvmv20170312.Client {
+		var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
+		cpf := me.NewServiceClientProfile("cvm", reqTimeout)
+		client, _ := cvmv20170312.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCvmIntlClient returns cvm intl client for service
 func (me *TencentCloudClient) UseCvmIntlClient(iacExtInfo ...IacExtInfo) *cvmintl.Client {
-	if me.cvmIntlConn != nil {
-		return me.cvmIntlConn
-	}
-
-	cpf := me.NewClientIntlProfile(300)
-	me.cvmIntlConn, _ = cvmintl.NewClient(me.Credential, me.Region, cpf)
-	me.cvmIntlConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cvmIntlConn
+	return cachedClient(me, newClientKey("cvm", cvmintl.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cvmintl.Client {
+		cpf := me.NewServiceClientIntlProfile("cvm", 300)
+		client, _ := cvmintl.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCvmV20170312Client returns cvm client for service
 func (me *TencentCloudClient) UseCvmV20170312Client(iacExtInfo ...IacExtInfo) *cvmv20170312.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.cvmv20170312Conn != nil {
-		me.cvmv20170312Conn.WithHttpTransport(&logRoundTripper)
-		return me.cvmv20170312Conn
-	}
-
-	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
-	me.cvmv20170312Conn, _ = cvmv20170312.NewClient(me.Credential, me.Region, cpf)
-	me.cvmv20170312Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.cvmv20170312Conn
+	return cachedClient(me, newClientKey("cvm", cvmv20170312.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cvmv20170312.Client {
+		var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
+		cpf := me.NewServiceClientProfile("cvm", reqTimeout)
+		client, _ := cvmv20170312.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTagClient returns tag client for service
 func (me *TencentCloudClient) UseTagClient() *tag.Client {
-	if me.tagConn != nil {
-		return me.tagConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tagConn, _ = tag.NewClient(me.Credential, me.Region, cpf)
-	me.tagConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tagConn
+	return cachedClient(me, newClientKey("tag", tag.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tag.Client {
+		cpf := me.NewServiceClientProfile("tag", 300)
+		client, _ := tag.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTkeClient returns tke client for service
 func (me *TencentCloudClient) UseTkeClient(iacExtInfo ...IacExtInfo) *tkev20180525.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tkev20180525Conn != nil {
-		me.tkev20180525Conn.WithHttpTransport(&logRoundTripper)
-		return me.tkev20180525Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.tkev20180525Conn, _ = tkev20180525.NewClient(me.Credential, me.Region, cpf)
-	me.tkev20180525Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.tkev20180525Conn
+	return cachedClient(me, newClientKey("tke", tkev20180525.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tkev20180525.Client {
+		cpf := me.NewServiceClientProfile("tke", 300)
+		client, _ := tkev20180525.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTkeV20180525Client returns tke client for service
 func (me *TencentCloudClient) UseTkeV20180525Client(iacExtInfo ...IacExtInfo) *tkev20180525.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tkev20180525Conn != nil {
-		me.tkev20180525Conn.WithHttpTransport(&logRoundTripper)
-		return me.tkev20180525Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.tkev20180525Conn, _ = tkev20180525.NewClient(me.Credential, me.Region, cpf)
-	me.tkev20180525Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.tkev20180525Conn
+	return cachedClient(me, newClientKey("tke", tkev20180525.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tkev20180525.Client {
+		cpf := me.NewServiceClientProfile("tke", 300)
+		client, _ := tkev20180525.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTdmqClient returns Tdmq client for service
 func (me *TencentCloudClient) UseTdmqClient(iacExtInfo ...IacExtInfo) *tdmq.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tdmqConn != nil {
-		me.tdmqConn.WithHttpTransport(&logRoundTripper)
-		return me.tdmqConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tdmqConn, _ = tdmq.NewClient(me.Credential, me.Region, cpf)
-	me.tdmqConn.WithHttpTransport(&logRoundTripper)
-
-	return me.tdmqConn
+	return cachedClient(me, newClientKey("tdmq", tdmq.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tdmq.Client {
+		cpf := me.NewServiceClientProfile("tdmq", 300)
+		client, _ := tdmq.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseGaapClient returns gaap client for service
 func (me *TencentCloudClient) UseGaapClient(iacExtInfo ...IacExtInfo) *gaap.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.gaapConn != nil {
-		me.gaapConn.WithHttpTransport(&logRoundTripper)
-		return me.gaapConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.gaapConn, _ = gaap.NewClient(me.Credential, me.Region, cpf)
-	me.gaapConn.WithHttpTransport(&logRoundTripper)
-
-	return me.gaapConn
+	return cachedClient(me, newClientKey("gaap", gaap.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *gaap.Client {
+		cpf := me.NewServiceClientProfile("gaap", 300)
+		client, _ := gaap.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSslClient returns ssl client for service
 func (me *TencentCloudClient) UseSslClient() *ssl.Client {
-	if me.sslConn != nil {
-		return me.sslConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.sslConn, _ = ssl.NewClient(me.Credential, me.Region, cpf)
-	me.sslConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.sslConn
+	return cachedClient(me, newClientKey("wss", ssl.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *ssl.Client {
+		cpf := me.NewServiceClientProfile("wss", 300)
+		client, _ := ssl.NewClient(me.Credential, me.Region, cpf)
+		client.WithCredential(me.GetCredential())
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCamClient returns cam client for service
 func (me *TencentCloudClient) UseCamClient() *cam.Client {
-	if me.camConn != nil {
-		return me.camConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.camConn, _ = cam.NewClient(me.Credential, me.Region, cpf)
-	me.camConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.camConn
+	return cachedClient(me, newClientKey("cam", cam.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cam.Client {
+		cpf := me.NewServiceClientProfile("cam", 300)
+		client, _ := cam.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseStsClient returns sts client for service
 func (me *TencentCloudClient) UseStsClient(stsExtInfo ...StsExtInfo) *sts.Client {
-	/*
-		me.Credential will changed, don't cache it
-		if me.stsConn != nil {
-			return me.stsConn
-		}
-	*/
-
-	var logRoundTripper LogRoundTripper
+	// me.Credential will changed and the Authorization differs between
+	// calls, don't cache it
+	logRoundTripper := me.NewLogRoundTripper("sts")
 	if len(stsExtInfo) != 0 {
 		logRoundTripper.Authorization = stsExtInfo[0].Authorization
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.stsConn, _ = sts.NewClient(me.Credential, me.Region, cpf)
-	me.stsConn.WithHttpTransport(&logRoundTripper)
+	cpf := me.NewServiceClientProfile("sts", 300)
+	client, _ := sts.NewClient(me.GetCredential(), me.Region, cpf)
+	client.WithHttpTransport(logRoundTripper)
 
-	return me.stsConn
+	return client
 }
 
 // UseCfsClient returns cfs client for service
 func (me *TencentCloudClient) UseCfsClient() *cfs.Client {
-	if me.cfsConn != nil {
-		return me.cfsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cfsConn, _ = cfs.NewClient(me.Credential, me.Region, cpf)
-	me.cfsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cfsConn
+	return cachedClient(me, newClientKey("cfs", cfs.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cfs.Client {
+		cpf := me.NewServiceClientProfile("cfs", 300)
+		client, _ := cfs.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseScfClient returns scf client for service
 func (me *TencentCloudClient) UseScfClient(iacExtInfo ...IacExtInfo) *scf.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.scfConn != nil {
-		me.scfConn.WithHttpTransport(&logRoundTripper)
-		return me.scfConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.scfConn, _ = scf.NewClient(me.Credential, me.Region, cpf)
-	me.scfConn.WithHttpTransport(&logRoundTripper)
-
-	return me.scfConn
+	return cachedClient(me, newClientKey("scf", scf.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *scf.Client {
+		cpf := me.NewServiceClientProfile("scf", 300)
+		client, _ := scf.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTcaplusClient returns tcaplush client for service
 func (me *TencentCloudClient) UseTcaplusClient() *tcaplusdb.Client {
-	if me.tcaplusConn != nil {
-		return me.tcaplusConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tcaplusConn, _ = tcaplusdb.NewClient(me.Credential, me.Region, cpf)
-	me.tcaplusConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tcaplusConn
+	return cachedClient(me, newClientKey("tcaplusdb", tcaplusdb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tcaplusdb.Client {
+		cpf := me.NewServiceClientProfile("tcaplusdb", 300)
+		client, _ := tcaplusdb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDayuClient returns dayu client for service
 func (me *TencentCloudClient) UseDayuClient() *dayu.Client {
-	if me.dayuConn != nil {
-		return me.dayuConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dayuConn, _ = dayu.NewClient(me.Credential, me.Region, cpf)
-	me.dayuConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dayuConn
+	return cachedClient(me, newClientKey("dayu", dayu.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dayu.Client {
+		cpf := me.NewServiceClientProfile("dayu", 300)
+		client, _ := dayu.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdnClient returns cdn client for service
 func (me *TencentCloudClient) UseCdnClient(iacExtInfo ...IacExtInfo) *cdn.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.cdnConn != nil {
-		me.cdnConn.WithHttpTransport(&logRoundTripper)
-		return me.cdnConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cdnConn, _ = cdn.NewClient(me.Credential, me.Region, cpf)
-	me.cdnConn.WithHttpTransport(&logRoundTripper)
-
-	return me.cdnConn
+	return cachedClient(me, newClientKey("cdn", cdn.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cdn.Client {
+		cpf := me.NewServiceClientProfile("cdn", 300)
+		client, _ := cdn.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMonitorClient returns monitor client for service
 func (me *TencentCloudClient) UseMonitorClient() *monitor.Client {
-	if me.monitorConn != nil {
-		return me.monitorConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.monitorConn, _ = monitor.NewClient(me.Credential, me.Region, cpf)
-	me.monitorConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.monitorConn
+	return cachedClient(me, newClientKey("monitor", monitor.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *monitor.Client {
+		cpf := me.NewServiceClientProfile("monitor", 300)
+		client, _ := monitor.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMonitorV20230616Client returns monitor v20230616 client for service
 func (me *TencentCloudClient) UseMonitorV20230616Client() *monitorv20230616.Client {
-	if me.monitorv20230616Conn != nil {
-		return me.monitorv20230616Conn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.monitorv20230616Conn, _ = monitorv20230616.NewClient(me.Credential, me.Region, cpf)
-	me.monitorv20230616Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.monitorv20230616Conn
+	return cachedClient(me, newClientKey("monitor", monitorv20230616.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *monitorv20230616.Client {
+		cpf := me.NewServiceClientProfile("monitor", 300)
+		client, _ := monitorv20230616.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseMonitorClientRegion(region string) *monitor.Client {
-	cpf := me.NewClientProfile(300)
-	monitorConn, _ := monitor.NewClient(me.Credential, region, cpf)
-	monitorConn.WithHttpTransport(&LogRoundTripper{})
-
-	return monitorConn
+	return cachedClient(me, newClientKey("monitor", monitor.APIVersion, region, nil), func(logRoundTripper *LogRoundTripper) *monitor.Client {
+		cpf := me.NewServiceClientProfile("monitor", 300)
+		client, _ := monitor.NewClient(me.GetCredential(), region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseEsClient returns es client for service
 func (me *TencentCloudClient) UseEsClient(iacExtInfo ...IacExtInfo) *es.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.esConn != nil {
-		me.esConn.WithHttpTransport(&logRoundTripper)
-		return me.esConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.esConn, _ = es.NewClient(me.Credential, me.Region, cpf)
-	me.esConn.WithHttpTransport(&logRoundTripper)
-
-	return me.esConn
+	return cachedClient(me, newClientKey("es", es.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *es.Client {
+		cpf := me.NewServiceClientProfile("es", 300)
+		client, _ := es.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePostgresqlClient returns postgresql client for service
 func (me *TencentCloudClient) UsePostgresqlClient(iacExtInfo ...IacExtInfo) *postgre.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.postgreConn != nil {
-		me.postgreConn.WithHttpTransport(&logRoundTripper)
-		return me.postgreConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.postgreConn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgreConn.WithHttpTransport(&logRoundTripper)
-
-	return me.postgreConn
+	return cachedClient(me, newClientKey("postgres", postgre.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *postgre.Client {
+		cpf := me.NewServiceClientProfile("postgres", 300)
+		client, _ := postgre.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSqlserverClient returns sqlserver client for service
 func (me *TencentCloudClient) UseSqlserverClient(iacExtInfo ...IacExtInfo) *sqlserver.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.sqlserverConn != nil {
-		me.sqlserverConn.WithHttpTransport(&logRoundTripper)
-		return me.sqlserverConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.sqlserverConn, _ = sqlserver.NewClient(me.Credential, me.Region, cpf)
-	me.sqlserverConn.WithHttpTransport(&logRoundTripper)
-
-	return me.sqlserverConn
+	return cachedClient(me, newClientKey("sqlserver", sqlserver.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *sqlserver.Client {
+		cpf := me.NewServiceClientProfile("sqlserver", 300)
+		client, _ := sqlserver.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCkafkaClient returns ckafka client for service
 func (me *TencentCloudClient) UseCkafkaClient(iacExtInfo ...IacExtInfo) *ckafka.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.ckafkaConn != nil {
-		me.ckafkaConn.WithHttpTransport(&logRoundTripper)
-		return me.ckafkaConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.ckafkaConn, _ = ckafka.NewClient(me.Credential, me.Region, cpf)
-	me.ckafkaConn.WithHttpTransport(&logRoundTripper)
-
-	return me.ckafkaConn
+	return cachedClient(me, newClientKey("ckafka", ckafka.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *ckafka.Client {
+		cpf := me.NewServiceClientProfile("ckafka", 300)
+		client, _ := ckafka.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseAuditClient returns audit client for service
 func (me *TencentCloudClient) UseAuditClient() *audit.Client {
-	if me.auditConn != nil {
-		return me.auditConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.auditConn, _ = audit.NewClient(me.Credential, me.Region, cpf)
-	me.auditConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.auditConn
+	return cachedClient(me, newClientKey("cloudaudit", audit.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *audit.Client {
+		cpf := me.NewServiceClientProfile("cloudaudit", 300)
+		client, _ := audit.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCynosdbClient returns cynosdb client for service
 func (me *TencentCloudClient) UseCynosdbClient() *cynosdb.Client {
-	if me.cynosConn != nil {
-		return me.cynosConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cynosConn, _ = cynosdb.NewClient(me.Credential, me.Region, cpf)
-	me.cynosConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cynosConn
+	return cachedClient(me, newClientKey("cynosdb", cynosdb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cynosdb.Client {
+		cpf := me.NewServiceClientProfile("cynosdb", 300)
+		client, _ := cynosdb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseVodClient returns vod client for service
 func (me *TencentCloudClient) UseVodClient() *vod.Client {
-	if me.vodConn != nil {
-		return me.vodConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.vodConn, _ = vod.NewClient(me.Credential, me.Region, cpf)
-	me.vodConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.vodConn
+	return cachedClient(me, newClientKey("vod", vod.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *vod.Client {
+		cpf := me.NewServiceClientProfile("vod", 300)
+		client, _ := vod.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseAPIGatewayClient returns apigateway client for service
 func (me *TencentCloudClient) UseAPIGatewayClient() *apigateway.Client {
-	if me.apiGatewayConn != nil {
-		return me.apiGatewayConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.apiGatewayConn, _ = apigateway.NewClient(me.Credential, me.Region, cpf)
-	me.apiGatewayConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.apiGatewayConn
+	return cachedClient(me, newClientKey("apigateway", apigateway.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *apigateway.Client {
+		cpf := me.NewServiceClientProfile("apigateway", 300)
+		client, _ := apigateway.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTCRClient returns apigateway client for service
 func (me *TencentCloudClient) UseTCRClient(iacExtInfo ...IacExtInfo) *tcr.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tcrConn != nil {
-		me.tcrConn.WithHttpTransport(&logRoundTripper)
-		return me.tcrConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tcrConn, _ = tcr.NewClient(me.Credential, me.Region, cpf)
-	me.tcrConn.WithHttpTransport(&logRoundTripper)
-
-	return me.tcrConn
+	return cachedClient(me, newClientKey("tcr", tcr.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tcr.Client {
+		cpf := me.NewServiceClientProfile("tcr", 300)
+		client, _ := tcr.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSSLCertificateClient returns SSL Certificate client for service
 func (me *TencentCloudClient) UseSSLCertificateClient() *sslCertificate.Client {
-	if me.sslCertificateConn != nil {
-		return me.sslCertificateConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.sslCertificateConn, _ = sslCertificate.NewClient(me.Credential, me.Region, cpf)
-	me.sslCertificateConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.sslCertificateConn
+	return cachedClient(me, newClientKey("ssl", sslCertificate.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *sslCertificate.Client {
+		cpf := me.NewServiceClientProfile("ssl", 300)
+		client, _ := sslCertificate.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseKmsClient returns KMS client for service
 func (me *TencentCloudClient) UseKmsClient() *kms.Client {
-	if me.kmsConn != nil {
-		return me.kmsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.kmsConn, _ = kms.NewClient(me.Credential, me.Region, cpf)
-	me.kmsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.kmsConn
+	return cachedClient(me, newClientKey("kms", kms.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *kms.Client {
+		cpf := me.NewServiceClientProfile("kms", 300)
+		client, _ := kms.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSsmClient returns SSM client for service
 func (me *TencentCloudClient) UseSsmClient() *ssm.Client {
-	if me.ssmConn != nil {
-		return me.ssmConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.ssmConn, _ = ssm.NewClient(me.Credential, me.Region, cpf)
-	me.ssmConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ssmConn
+	return cachedClient(me, newClientKey("ssm", ssm.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *ssm.Client {
+		cpf := me.NewServiceClientProfile("ssm", 300)
+		client, _ := ssm.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseApiClient return API client for service
 func (me *TencentCloudClient) UseApiClient() *api.Client {
-	if me.apiConn != nil {
-		return me.apiConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.apiConn, _ = api.NewClient(me.Credential, me.Region, cpf)
-	me.apiConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.apiConn
+	return cachedClient(me, newClientKey("api", api.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *api.Client {
+		cpf := me.NewServiceClientProfile("api", 300)
+		client, _ := api.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseEmrClient return EMR client for service
 func (me *TencentCloudClient) UseEmrClient() *emr.Client {
-	if me.emrConn != nil {
-		return me.emrConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.emrConn, _ = emr.NewClient(me.Credential, me.Region, cpf)
-	me.emrConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.emrConn
+	return cachedClient(me, newClientKey("emr", emr.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *emr.Client {
+		cpf := me.NewServiceClientProfile("emr", 300)
+		client, _ := emr.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseClsClient return CLS client for service
 func (me *TencentCloudClient) UseClsClient(iacExtInfo ...IacExtInfo) *cls.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.clsConn != nil {
-		me.clsConn.WithHttpTransport(&logRoundTripper)
-		return me.clsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.clsConn, _ = cls.NewClient(me.Credential, me.Region, cpf)
-	me.clsConn.WithHttpTransport(&logRoundTripper)
-
-	return me.clsConn
+	return cachedClient(me, newClientKey("cls", cls.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cls.Client {
+		cpf := me.NewServiceClientProfile("cls", 300)
+		client, _ := cls.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseLighthouseClient return Lighthouse client for service
 func (me *TencentCloudClient) UseLighthouseClient(iacExtInfo ...IacExtInfo) *lighthouse.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.lighthouseConn != nil {
-		me.lighthouseConn.WithHttpTransport(&logRoundTripper)
-		return me.lighthouseConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.lighthouseConn, _ = lighthouse.NewClient(me.Credential, me.Region, cpf)
-	me.lighthouseConn.WithHttpTransport(&logRoundTripper)
-
-	return me.lighthouseConn
+	return cachedClient(me, newClientKey("lighthouse", lighthouse.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *lighthouse.Client {
+		cpf := me.NewServiceClientProfile("lighthouse", 300)
+		client, _ := lighthouse.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDnsPodClient return DnsPod client for service
 func (me *TencentCloudClient) UseDnsPodClient() *dnspod.Client {
-	if me.dnsPodConn != nil {
-		return me.dnsPodConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.dnsPodConn, _ = dnspod.NewClient(me.Credential, me.Region, cpf)
-	me.dnsPodConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dnsPodConn
+	return cachedClient(me, newClientKey("dnspod", dnspod.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dnspod.Client {
+		cpf := me.NewServiceClientProfile("dnspod", 300)
+		client, _ := dnspod.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDnsPodClient return DnsPod intl client for service
 func (me *TencentCloudClient) UseDnsPodIntlClient() *dnspodintl.Client {
-	if me.dnsPodIntlConn != nil {
-		return me.dnsPodIntlConn
-	}
-	cpf := me.NewClientIntlProfile(300)
-	me.dnsPodIntlConn, _ = dnspodintl.NewClient(me.Credential, me.Region, cpf)
-	me.dnsPodIntlConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dnsPodIntlConn
+	return cachedClient(me, newClientKey("dnspod", dnspodintl.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dnspodintl.Client {
+		cpf := me.NewServiceClientIntlProfile("dnspod", 300)
+		client, _ := dnspodintl.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePrivateDnsClient return PrivateDns client for service
 func (me *TencentCloudClient) UsePrivateDnsClient(iacExtInfo ...IacExtInfo) *privatedns.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.privateDnsConn != nil {
-		me.privateDnsConn.WithHttpTransport(&logRoundTripper)
-		return me.privateDnsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.privateDnsConn, _ = privatedns.NewClient(me.Credential, me.Region, cpf)
-	me.privateDnsConn.WithHttpTransport(&logRoundTripper)
-
-	return me.privateDnsConn
+	return cachedClient(me, newClientKey("privatedns", privatedns.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *privatedns.Client {
+		cpf := me.NewServiceClientProfile("privatedns", 300)
+		client, _ := privatedns.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDomainClient return Domain client for service
 func (me *TencentCloudClient) UseDomainClient() *domain.Client {
-	if me.domainConn != nil {
-		return me.domainConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.domainConn, _ = domain.NewClient(me.Credential, me.Region, cpf)
-	me.domainConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.domainConn
+	return cachedClient(me, newClientKey("domain", domain.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *domain.Client {
+		cpf := me.NewServiceClientProfile("domain", 300)
+		client, _ := domain.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseAntiddosClient returns antiddos client for service
 func (me *TencentCloudClient) UseAntiddosClient() *antiddos.Client {
-	if me.antiddosConn != nil {
-		return me.antiddosConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.antiddosConn, _ = antiddos.NewClient(me.Credential, me.Region, cpf)
-	me.antiddosConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.antiddosConn
+	return cachedClient(me, newClientKey("antiddos", antiddos.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *antiddos.Client {
+		cpf := me.NewServiceClientProfile("antiddos", 300)
+		client, _ := antiddos.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTemClient returns tem client for service
 func (me *TencentCloudClient) UseTemClient() *tem.Client {
-	if me.temConn != nil {
-		return me.temConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.temConn, _ = tem.NewClient(me.Credential, me.Region, cpf)
-	me.temConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.temConn
+	return cachedClient(me, newClientKey("tem", tem.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tem.Client {
+		cpf := me.NewServiceClientProfile("tem", 300)
+		client, _ := tem.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTeoClient returns teo client for service
 func (me *TencentCloudClient) UseTeoClient(iacExtInfo ...IacExtInfo) *teo.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.teoConn != nil {
-		me.teoConn.WithHttpTransport(&logRoundTripper)
-		return me.teoConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.teoConn, _ = teo.NewClient(me.Credential, me.Region, cpf)
-	me.teoConn.WithHttpTransport(&logRoundTripper)
-
-	return me.teoConn
+	return cachedClient(me, newClientKey("teo", teo.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *teo.Client {
+		cpf := me.NewServiceClientProfile("teo", 300)
+		client, _ := teo.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTcmClient returns Tcm client for service
 func (me *TencentCloudClient) UseTcmClient() *tcm.Client {
-	if me.tcmConn != nil {
-		return me.tcmConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tcmConn, _ = tcm.NewClient(me.Credential, me.Region, cpf)
-	me.tcmConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tcmConn
+	return cachedClient(me, newClientKey("tcm", tcm.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tcm.Client {
+		cpf := me.NewServiceClientProfile("tcm", 300)
+		client, _ := tcm.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCssClient returns css client for service
 func (me *TencentCloudClient) UseCssClient() *css.Client {
-	if me.cssConn != nil {
-		return me.cssConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cssConn, _ = css.NewClient(me.Credential, me.Region, cpf)
-	me.cssConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cssConn
+	return cachedClient(me, newClientKey("live", css.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *css.Client {
+		cpf := me.NewServiceClientProfile("live", 300)
+		client, _ := css.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSesClient returns Ses client for service
 func (me *TencentCloudClient) UseSesClient() *ses.Client {
-	if me.sesConn != nil {
-		return me.sesConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.sesConn, _ = ses.NewClient(me.Credential, me.Region, cpf)
-	me.sesConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.sesConn
+	return cachedClient(me, newClientKey("ses", ses.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *ses.Client {
+		cpf := me.NewServiceClientProfile("ses", 300)
+		client, _ := ses.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDcdbClient returns dcdb client for service
 func (me *TencentCloudClient) UseDcdbClient() *dcdb.Client {
-	if me.dcdbConn != nil {
-		return me.dcdbConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dcdbConn, _ = dcdb.NewClient(me.Credential, me.Region, cpf)
-	me.dcdbConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dcdbConn
+	return cachedClient(me, newClientKey("dcdb", dcdb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dcdb.Client {
+		cpf := me.NewServiceClientProfile("dcdb", 300)
+		client, _ := dcdb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSmsClient returns Sms client for service
 func (me *TencentCloudClient) UseSmsClient() *sms.Client {
-	if me.smsConn != nil {
-		return me.smsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.smsConn, _ = sms.NewClient(me.Credential, me.Region, cpf)
-	me.smsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.smsConn
+	return cachedClient(me, newClientKey("sms", sms.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *sms.Client {
+		cpf := me.NewServiceClientProfile("sms", 300)
+		client, _ := sms.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCatClient returns Cat client for service
 func (me *TencentCloudClient) UseCatClient() *cat.Client {
-	if me.catConn != nil {
-		return me.catConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.catConn, _ = cat.NewClient(me.Credential, me.Region, cpf)
-	me.catConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.catConn
+	return cachedClient(me, newClientKey("cat", cat.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cat.Client {
+		cpf := me.NewServiceClientProfile("cat", 300)
+		client, _ := cat.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMariadbClient returns mariadb client for service
 func (me *TencentCloudClient) UseMariadbClient(iacExtInfo ...IacExtInfo) *mariadb.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.mariadbConn != nil {
-		me.mariadbConn.WithHttpTransport(&logRoundTripper)
-		return me.mariadbConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.mariadbConn, _ = mariadb.NewClient(me.Credential, me.Region, cpf)
-	me.mariadbConn.WithHttpTransport(&logRoundTripper)
-
-	return me.mariadbConn
+	return cachedClient(me, newClientKey("mariadb", mariadb.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *mariadb.Client {
+		cpf := me.NewServiceClientProfile("mariadb", 300)
+		client, _ := mariadb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePtsClient returns pts client for service
-func (me *TencentCloudClient) UsePtsClient() *pts.Client {
-	if me.ptsConn != nil {
-		return me.ptsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.ptsConn, _ = pts.NewClient(me.Credential, me.Region, cpf)
-	me.ptsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ptsConn
+func (me *TencentCloudClient) UsePtsClient() *pts.Client {
+	return cachedClient(me, newClientKey("pts", pts.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *pts.Client {
+		cpf := me.NewServiceClientProfile("pts", 300)
+		client, _ := pts.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTatClient returns tat client for service
 func (me *TencentCloudClient) UseTatClient() *tat.Client {
-	if me.tatConn != nil {
-		return me.tatConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tatConn, _ = tat.NewClient(me.Credential, me.Region, cpf)
-	me.tatConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tatConn
+	return cachedClient(me, newClientKey("tat", tat.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tat.Client {
+		cpf := me.NewServiceClientProfile("tat", 300)
+		client, _ := tat.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseOrganizationClient returns organization client for service
 func (me *TencentCloudClient) UseOrganizationClient() *organization.Client {
-	if me.organizationConn != nil {
-		return me.organizationConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.organizationConn, _ = organization.NewClient(me.Credential, me.Region, cpf)
-	me.organizationConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.organizationConn
+	return cachedClient(me, newClientKey("organization", organization.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *organization.Client {
+		cpf := me.NewServiceClientProfile("organization", 300)
+		client, _ := organization.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTdcpgClient returns tdcpg client for service
 func (me *TencentCloudClient) UseTdcpgClient(iacExtInfo ...IacExtInfo) *tdcpg.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tdcpgConn != nil {
-		me.tdcpgConn.WithHttpTransport(&logRoundTripper)
-		return me.tdcpgConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tdcpgConn, _ = tdcpg.NewClient(me.Credential, me.Region, cpf)
-	me.tdcpgConn.WithHttpTransport(&logRoundTripper)
-
-	return me.tdcpgConn
+	return cachedClient(me, newClientKey("tdcpg", tdcpg.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tdcpg.Client {
+		cpf := me.NewServiceClientProfile("tdcpg", 300)
+		client, _ := tdcpg.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDbbrainClient returns dbbrain client for service
 func (me *TencentCloudClient) UseDbbrainClient() *dbbrain.Client {
-	if me.dbbrainConn != nil {
-		return me.dbbrainConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dbbrainConn, _ = dbbrain.NewClient(me.Credential, me.Region, cpf)
-	me.dbbrainConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dbbrainConn
+	return cachedClient(me, newClientKey("dbbrain", dbbrain.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dbbrain.Client {
+		cpf := me.NewServiceClientProfile("dbbrain", 300)
+		client, _ := dbbrain.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseRumClient returns rum client for service
 func (me *TencentCloudClient) UseRumClient() *rum.Client {
-	if me.rumConn != nil {
-		return me.rumConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.rumConn, _ = rum.NewClient(me.Credential, me.Region, cpf)
-	me.rumConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.rumConn
+	return cachedClient(me, newClientKey("rum", rum.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *rum.Client {
+		cpf := me.NewServiceClientProfile("rum", 300)
+		client, _ := rum.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDtsClient returns dts client for service
 func (me *TencentCloudClient) UseDtsClient() *dts.Client {
-	if me.dtsConn != nil {
-		return me.dtsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dtsConn, _ = dts.NewClient(me.Credential, me.Region, cpf)
-	me.dtsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dtsConn
+	return cachedClient(me, newClientKey("dts", dts.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dts.Client {
+		cpf := me.NewServiceClientProfile("dts", 300)
+		client, _ := dts.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCosBatchClient returns ci client for service
 func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 	cosUrl := fmt.Sprintf("https://%s.cos-control.%s.myqcloud.com", uin, me.Region)
-	if me.CosDomain != "" {
-		cosUrl = me.CosDomain
-	}
-
-	u, _ := url.Parse(cosUrl)
-
-	if me.cosBatchConn != nil && me.cosBatchConn.BaseURL.BatchURL == u {
-		return me.cosBatchConn
-	}
-
-	baseUrl := &cos.BaseURL{
-		BatchURL: u,
+	if cosDomain := me.cosDomain(); cosDomain != "" {
+		cosUrl = cosDomain
 	}
 
-	me.cosBatchConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+	return me.useCosClient(cosUrl, 100*time.Second, func(u *url.URL) *cos.BaseURL {
+		return &cos.BaseURL{BatchURL: u}
 	})
-
-	return me.cosBatchConn
 }
 
 // UseCiClient returns ci client for service
 func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.ci.%s.myqcloud.com", bucket, me.Region))
-
-	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
-		return me.ciConn
-	}
-
-	baseUrl := &cos.BaseURL{
-		CIURL: u,
-	}
-
-	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+	cosUrl := fmt.Sprintf("https://%s.ci.%s.myqcloud.com", bucket, me.Region)
+	return me.useCosClient(cosUrl, 100*time.Second, func(u *url.URL) *cos.BaseURL {
+		return &cos.BaseURL{CIURL: u}
 	})
-
-	return me.ciConn
 }
 
 // UsePicClient returns pic client for service
 func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.pic.%s.myqcloud.com", bucket, me.Region))
-
-	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
-		return me.ciConn
-	}
-
-	baseUrl := &cos.BaseURL{
-		CIURL: u,
-	}
-
-	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+	cosUrl := fmt.Sprintf("https://%s.pic.%s.myqcloud.com", bucket, me.Region)
+	return me.useCosClient(cosUrl, 100*time.Second, func(u *url.URL) *cos.BaseURL {
+		return &cos.BaseURL{CIURL: u}
 	})
-
-	return me.ciConn
 }
 
 // UseTsfClient returns tsf client for service
 func (me *TencentCloudClient) UseTsfClient() *tsf.Client {
-	if me.tsfConn != nil {
-		return me.tsfConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tsfConn, _ = tsf.NewClient(me.Credential, me.Region, cpf)
-	me.tsfConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tsfConn
+	return cachedClient(me, newClientKey("tsf", tsf.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tsf.Client {
+		cpf := me.NewServiceClientProfile("tsf", 300)
+		client, _ := tsf.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMpsClient returns mps client for service
 func (me *TencentCloudClient) UseMpsClient() *mps.Client {
-	if me.mpsConn != nil {
-		return me.mpsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.mpsConn, _ = mps.NewClient(me.Credential, me.Region, cpf)
-	me.mpsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.mpsConn
+	return cachedClient(me, newClientKey("mps", mps.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *mps.Client {
+		cpf := me.NewServiceClientProfile("mps", 300)
+		client, _ := mps.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCwpClient returns tke client for service
 func (me *TencentCloudClient) UseCwpClient() *cwp.Client {
-	if me.cwpConn != nil {
-		return me.cwpConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cwpConn, _ = cwp.NewClient(me.Credential, me.Region, cpf)
-	me.cwpConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cwpConn
+	return cachedClient(me, newClientKey("cwp", cwp.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cwp.Client {
+		cpf := me.NewServiceClientProfile("cwp", 300)
+		client, _ := cwp.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseChdfsClient returns chdfs client for service
 func (me *TencentCloudClient) UseChdfsClient() *chdfs.Client {
-	if me.chdfsConn != nil {
-		return me.chdfsConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.chdfsConn, _ = chdfs.NewClient(me.Credential, me.Region, cpf)
-	me.chdfsConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.chdfsConn
+	return cachedClient(me, newClientKey("chdfs", chdfs.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *chdfs.Client {
+		cpf := me.NewServiceClientProfile("chdfs", 300)
+		client, _ := chdfs.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMdlClient returns mdl client for service
 func (me *TencentCloudClient) UseMdlClient() *mdl.Client {
-	if me.mdlConn != nil {
-		return me.mdlConn
-	}
-
-	cpf := me.NewClientIntlProfile(300)
-	me.mdlConn, _ = mdl.NewClient(me.Credential, me.Region, cpf)
-	me.mdlConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.mdlConn
+	return cachedClient(me, newClientKey("mdl", mdl.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *mdl.Client {
+		cpf := me.NewServiceClientIntlProfile("mdl", 300)
+		client, _ := mdl.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseApmClient returns apm client for service
 func (me *TencentCloudClient) UseApmClient() *apm.Client {
-	if me.apmConn != nil {
-		return me.apmConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.apmConn, _ = apm.NewClient(me.Credential, me.Region, cpf)
-	me.apmConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.apmConn
+	return cachedClient(me, newClientKey("apm", apm.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *apm.Client {
+		cpf := me.NewServiceClientProfile("apm", 300)
+		client, _ := apm.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCiamClient returns ciam client for service
 func (me *TencentCloudClient) UseCiamClient() *ciam.Client {
-	if me.ciamConn != nil {
-		return me.ciamConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.ciamConn, _ = ciam.NewClient(me.Credential, me.Region, cpf)
-	me.ciamConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ciamConn
+	return cachedClient(me, newClientKey("ciam", ciam.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *ciam.Client {
+		cpf := me.NewServiceClientProfile("ciam", 300)
+		client, _ := ciam.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTseClient returns tse client for service
 func (me *TencentCloudClient) UseTseClient(iacExtInfo ...IacExtInfo) *tse.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tseConn != nil {
-		me.tseConn.WithHttpTransport(&logRoundTripper)
-		return me.tseConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tseConn, _ = tse.NewClient(me.Credential, me.Region, cpf)
-	me.tseConn.WithHttpTransport(&logRoundTripper)
-
-	return me.tseConn
+	return cachedClient(me, newClientKey("tse", tse.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tse.Client {
+		cpf := me.NewServiceClientProfile("tse", 300)
+		client, _ := tse.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdwchClient returns cdwch client for service
 func (me *TencentCloudClient) UseCdwchClient() *cdwch.Client {
-	if me.cdwchConn != nil {
-		return me.cdwchConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cdwchConn, _ = cdwch.NewClient(me.Credential, me.Region, cpf)
-	me.cdwchConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdwchConn
+	return cachedClient(me, newClientKey("cdwch", cdwch.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdwch.Client {
+		cpf := me.NewServiceClientProfile("cdwch", 300)
+		client, _ := cdwch.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseEbClient returns eb client for service
 func (me *TencentCloudClient) UseEbClient() *eb.Client {
-	if me.ebConn != nil {
-		return me.ebConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.ebConn, _ = eb.NewClient(me.Credential, me.Region, cpf)
-	me.ebConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ebConn
+	return cachedClient(me, newClientKey("eb", eb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *eb.Client {
+		cpf := me.NewServiceClientProfile("eb", 300)
+		client, _ := eb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDlcClient returns eb client for service
 func (me *TencentCloudClient) UseDlcClient() *dlc.Client {
-	if me.dlcConn != nil {
-		return me.dlcConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dlcConn, _ = dlc.NewClient(me.Credential, me.Region, cpf)
-	me.dlcConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dlcConn
+	return cachedClient(me, newClientKey("dlc", dlc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dlc.Client {
+		cpf := me.NewServiceClientProfile("dlc", 300)
+		client, _ := dlc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseWedataClient returns eb client for service
 func (me *TencentCloudClient) UseWedataClient() *wedata.Client {
-	if me.wedataConn != nil {
-		return me.wedataConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.wedataConn, _ = wedata.NewClient(me.Credential, me.Region, cpf)
-	me.wedataConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.wedataConn
+	return cachedClient(me, newClientKey("wedata", wedata.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *wedata.Client {
+		cpf := me.NewServiceClientProfile("wedata", 300)
+		client, _ := wedata.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseWedataV20250806Client return WEDATA client for service
 func (me *TencentCloudClient) UseWedataV20250806Client() *wedatav20250806.Client {
-	if me.wedatav20250806Conn != nil {
-		return me.wedatav20250806Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.wedatav20250806Conn, _ = wedatav20250806.NewClient(me.Credential, me.Region, cpf)
-	me.wedatav20250806Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.wedatav20250806Conn
+	return cachedClient(me, newClientKey("wedata", wedatav20250806.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *wedatav20250806.Client {
+		cpf := me.NewServiceClientProfile("wedata", 300)
+		client, _ := wedatav20250806.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseWafClient(iacExtInfo ...IacExtInfo) *waf.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.wafConn != nil {
-		me.wafConn.WithHttpTransport(&logRoundTripper)
-		return me.wafConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.wafConn, _ = waf.NewClient(me.Credential, me.Region, cpf)
-	me.wafConn.WithHttpTransport(&logRoundTripper)
-
-	return me.wafConn
+	return cachedClient(me, newClientKey("waf", waf.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *waf.Client {
+		cpf := me.NewServiceClientProfile("waf", 300)
+		client, _ := waf.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseCfwClient(iacExtInfo ...IacExtInfo) *cfw.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.cfwConn != nil {
-		me.cfwConn.WithHttpTransport(&logRoundTripper)
-		return me.cfwConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cfwConn, _ = cfw.NewClient(me.Credential, me.Region, cpf)
-	me.cfwConn.WithHttpTransport(&logRoundTripper)
-
-	return me.cfwConn
+	return cachedClient(me, newClientKey("cfw", cfw.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *cfw.Client {
+		cpf := me.NewServiceClientProfile("cfw", 300)
+		client, _ := cfw.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseOceanusClient() *oceanus.Client {
-	if me.oceanusConn != nil {
-		return me.oceanusConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.oceanusConn, _ = oceanus.NewClient(me.Credential, me.Region, cpf)
-	me.oceanusConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.oceanusConn
+	return cachedClient(me, newClientKey("oceanus", oceanus.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *oceanus.Client {
+		cpf := me.NewServiceClientProfile("oceanus", 300)
+		client, _ := oceanus.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 func (me *TencentCloudClient) UseDasbClient() *dasb.Client {
-	if me.dasbConn != nil {
-		return me.dasbConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.dasbConn, _ = dasb.NewClient(me.Credential, me.Region, cpf)
-	me.dasbConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dasbConn
+	return cachedClient(me, newClientKey("dasb", dasb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dasb.Client {
+		cpf := me.NewServiceClientProfile("dasb", 300)
+		client, _ := dasb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseBhV20230418Client return BH client for service
 func (me *TencentCloudClient) UseBhV20230418Client() *bhv20230418.Client {
-	if me.bhv20230418Conn != nil {
-		return me.bhv20230418Conn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.bhv20230418Conn, _ = bhv20230418.NewClient(me.Credential, me.Region, cpf)
-	me.bhv20230418Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.bhv20230418Conn
+	return cachedClient(me, newClientKey("bh", bhv20230418.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *bhv20230418.Client {
+		cpf := me.NewServiceClientProfile("bh", 300)
+		client, _ := bhv20230418.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTrocketClient returns trocket client for service
 func (me *TencentCloudClient) UseTrocketClient() *trocket.Client {
-	if me.trocketConn != nil {
-		return me.trocketConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.trocketConn, _ = trocket.NewClient(me.Credential, me.Region, cpf)
-	me.trocketConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.trocketConn
+	return cachedClient(me, newClientKey("trocket", trocket.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *trocket.Client {
+		cpf := me.NewServiceClientProfile("trocket", 300)
+		client, _ := trocket.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseBiClient returns bi client for service
 func (me *TencentCloudClient) UseBiClient() *bi.Client {
-	if me.biConn != nil {
-		return me.biConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.biConn, _ = bi.NewClient(me.Credential, me.Region, cpf)
-	me.biConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.biConn
+	return cachedClient(me, newClientKey("bi", bi.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *bi.Client {
+		cpf := me.NewServiceClientProfile("bi", 300)
+		client, _ := bi.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdwpgClient returns cdwpg client for service
 func (me *TencentCloudClient) UseCdwpgClient() *cdwpg.Client {
-	if me.cdwpgConn != nil {
-		return me.cdwpgConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cdwpgConn, _ = cdwpg.NewClient(me.Credential, me.Region, cpf)
-	me.cdwpgConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdwpgConn
+	return cachedClient(me, newClientKey("cdwpg", cdwpg.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdwpg.Client {
+		cpf := me.NewServiceClientProfile("cdwpg", 300)
+		client, _ := cdwpg.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCsipClient returns csip client for service
 func (me *TencentCloudClient) UseCsipClient() *csip.Client {
-	if me.csipConn != nil {
-		return me.csipConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.csipConn, _ = csip.NewClient(me.Credential, me.Region, cpf)
-	me.csipConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.csipConn
+	return cachedClient(me, newClientKey("csip", csip.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *csip.Client {
+		cpf := me.NewServiceClientProfile("csip", 300)
+		client, _ := csip.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseRegionClient returns region client for service
 func (me *TencentCloudClient) UseRegionClient() *region.Client {
-	if me.regionConn != nil {
-		return me.regionConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.regionConn, _ = region.NewClient(me.Credential, me.Region, cpf)
-	me.regionConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.regionConn
+	return cachedClient(me, newClientKey("region", region.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *region.Client {
+		cpf := me.NewServiceClientProfile("region", 300)
+		client, _ := region.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 //internal version: replace useClient begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -1873,436 +1387,350 @@ func getEnvDefault(key string, defVal int) int {
 
 // UseTke2Client returns tke client for service
 func (me *TencentCloudClient) UseTke2Client(iacExtInfo ...IacExtInfo) *tkev20220501.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tkev20220501Conn != nil {
-		me.tkev20220501Conn.WithHttpTransport(&logRoundTripper)
-		return me.tkev20220501Conn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tkev20220501Conn, _ = tkev20220501.NewClient(me.Credential, me.Region, cpf)
-	me.tkev20220501Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.tkev20220501Conn
+	return cachedClient(me, newClientKey("tke", tkev20220501.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tkev20220501.Client {
+		cpf := me.NewServiceClientProfile("tke", 300)
+		client, _ := tkev20220501.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTkeV20220501Client returns tke client for service
 func (me *TencentCloudClient) UseTkeV20220501Client(iacExtInfo ...IacExtInfo) *tkev20220501.Client {
-	var logRoundTripper LogRoundTripper
-	if len(iacExtInfo) != 0 {
-		logRoundTripper.InstanceId = iacExtInfo[0].InstanceId
-	}
-
-	if me.tkev20220501Conn != nil {
-		me.tkev20220501Conn.WithHttpTransport(&logRoundTripper)
-		return me.tkev20220501Conn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.tkev20220501Conn, _ = tkev20220501.NewClient(me.Credential, me.Region, cpf)
-	me.tkev20220501Conn.WithHttpTransport(&logRoundTripper)
-
-	return me.tkev20220501Conn
+	return cachedClient(me, newClientKey("tke", tkev20220501.APIVersion, me.Region, iacExtInfo), func(logRoundTripper *LogRoundTripper) *tkev20220501.Client {
+		cpf := me.NewServiceClientProfile("tke", 300)
+		client, _ := tkev20220501.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdcClient returns tem client for service
 func (me *TencentCloudClient) UseCdcClient() *cdc.Client {
-	if me.cdcConn != nil {
-		return me.cdcConn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.cdcConn, _ = cdc.NewClient(me.Credential, me.Region, cpf)
-	me.cdcConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdcConn
+	return cachedClient(me, newClientKey("cdc", cdc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdc.Client {
+		cpf := me.NewServiceClientProfile("cdc", 300)
+		client, _ := cdc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdwdoris return CDWDORIS client for service
 func (me *TencentCloudClient) UseCdwdorisV20211228Client() *cdwdoris.Client {
-	if me.cdwdorisConn != nil {
-		return me.cdwdorisConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.cdwdorisConn, _ = cdwdoris.NewClient(me.Credential, me.Region, cpf)
-	me.cdwdorisConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdwdorisConn
+	return cachedClient(me, newClientKey("cdwdoris", cdwdoris.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdwdoris.Client {
+		cpf := me.NewServiceClientProfile("cdwdoris", 300)
+		client, _ := cdwdoris.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseControlcenter return CONTROLCENTER client for service
 func (me *TencentCloudClient) UseControlcenterV20230110Client() *controlcenter.Client {
-	if me.controlcenterConn != nil {
-		return me.controlcenterConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.controlcenterConn, _ = controlcenter.NewClient(me.Credential, me.Region, cpf)
-	me.controlcenterConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.controlcenterConn
+	return cachedClient(me, newClientKey("controlcenter", controlcenter.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *controlcenter.Client {
+		cpf := me.NewServiceClientProfile("controlcenter", 300)
+		client, _ := controlcenter.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseThpcClient return THPC client for service
 func (me *TencentCloudClient) UseThpcV20230321Client() *thpc.Client {
-	if me.thpcConn != nil {
-		return me.thpcConn
-	}
-	cpf := me.NewClientProfile(300)
-	me.thpcConn, _ = thpc.NewClient(me.Credential, me.Region, cpf)
-	me.thpcConn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.thpcConn
+	return cachedClient(me, newClientKey("thpc", thpc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *thpc.Client {
+		cpf := me.NewServiceClientProfile("thpc", 300)
+		client, _ := thpc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseEmrV20190103Client return EMR client for service
 func (me *TencentCloudClient) UseEmrV20190103Client() *emr.Client {
-	if me.emrv20190103Conn != nil {
-		return me.emrv20190103Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.emrv20190103Conn, _ = emr.NewClient(me.Credential, me.Region, cpf)
-	me.emrv20190103Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.emrv20190103Conn
+	return cachedClient(me, newClientKey("emr", emr.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *emr.Client {
+		cpf := me.NewServiceClientProfile("emr", 300)
+		client, _ := emr.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTeoV20220901Client return TEO client for service
 func (me *TencentCloudClient) UseTeoV20220901Client() *teo.Client {
-	if me.teov20220901Conn != nil {
-		return me.teov20220901Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.teov20220901Conn, _ = teo.NewClient(me.Credential, me.Region, cpf)
-	me.teov20220901Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.teov20220901Conn
+	return cachedClient(me, newClientKey("teo", teo.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *teo.Client {
+		cpf := me.NewServiceClientProfile("teo", 300)
+		client, _ := teo.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseSslV20191205Client return SSL client for service
 func (me *TencentCloudClient) UseSslV20191205Client() *sslCertificate.Client {
-	if me.sslv20191205Conn != nil {
-		return me.sslv20191205Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.sslv20191205Conn, _ = sslCertificate.NewClient(me.Credential, me.Region, cpf)
-	me.sslv20191205Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.sslv20191205Conn
+	return cachedClient(me, newClientKey("ssl", sslCertificate.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *sslCertificate.Client {
+		cpf := me.NewServiceClientProfile("ssl", 300)
+		client, _ := sslCertificate.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePostgresV20170312Client return POSTGRES client for service
 func (me *TencentCloudClient) UsePostgresV20170312Client() *postgre.Client {
-	if me.postgresv20170312Conn != nil {
-		return me.postgresv20170312Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.postgresv20170312Conn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgresv20170312Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.postgresv20170312Conn
+	return cachedClient(me, newClientKey("postgres", postgre.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *postgre.Client {
+		cpf := me.NewServiceClientProfile("postgres", 300)
+		client, _ := postgre.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCfwV20190904Client return CFW client for service
 func (me *TencentCloudClient) UseCfwV20190904Client() *cfw.Client {
-	if me.cfwv20190904Conn != nil {
-		return me.cfwv20190904Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.cfwv20190904Conn, _ = cfw.NewClient(me.Credential, me.Region, cpf)
-	me.cfwv20190904Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cfwv20190904Conn
+	return cachedClient(me, newClientKey("cfw", cfw.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cfw.Client {
+		cpf := me.NewServiceClientProfile("cfw", 300)
+		client, _ := cfw.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCcnV20170312Client return CCN client for service
 func (me *TencentCloudClient) UseCcnV20170312Client() *vpc.Client {
-	if me.ccnv20170312Conn != nil {
-		return me.ccnv20170312Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.ccnv20170312Conn, _ = vpc.NewClient(me.Credential, me.Region, cpf)
-	me.ccnv20170312Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ccnv20170312Conn
+	return cachedClient(me, newClientKey("vpc", vpc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *vpc.Client {
+		cpf := me.NewServiceClientProfile("vpc", 300)
+		client, _ := vpc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseTcssV20201101Client return TCSS client for service
 func (me *TencentCloudClient) UseTcssV20201101Client() *tcss.Client {
-	if me.tcssv20201101Conn != nil {
-		return me.tcssv20201101Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.tcssv20201101Conn, _ = tcss.NewClient(me.Credential, me.Region, cpf)
-	me.tcssv20201101Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.tcssv20201101Conn
+	return cachedClient(me, newClientKey("tcss", tcss.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *tcss.Client {
+		cpf := me.NewServiceClientProfile("tcss", 300)
+		client, _ := tcss.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCloudauditV20190319Client return CLOUDAUDIT client for service
 func (me *TencentCloudClient) UseCloudauditV20190319Client() *audit.Client {
-	if me.cloudauditv20190319Conn != nil {
-		return me.cloudauditv20190319Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.cloudauditv20190319Conn, _ = audit.NewClient(me.Credential, me.Region, cpf)
-	me.cloudauditv20190319Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cloudauditv20190319Conn
+	return cachedClient(me, newClientKey("cloudaudit", audit.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *audit.Client {
+		cpf := me.NewServiceClientProfile("cloudaudit", 300)
+		client, _ := audit.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePrivatednsV20201028Client return PRIVATEDNS client for service
 func (me *TencentCloudClient) UsePrivatednsV20201028Client() *privatedns.Client {
-	if me.privatednsv20201028Conn != nil {
-		return me.privatednsv20201028Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.privatednsv20201028Conn, _ = privatedns.NewClient(me.Credential, me.Region, cpf)
-	me.privatednsv20201028Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.privatednsv20201028Conn
+	return cachedClient(me, newClientKey("privatedns", privatedns.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *privatedns.Client {
+		cpf := me.NewServiceClientProfile("privatedns", 300)
+		client, _ := privatedns.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePrivatednsV20201028Client return PRIVATEDNS Intl client for service
 func (me *TencentCloudClient) UsePrivatednsIntlV20201028Client() *privatednsIntl.Client {
-	if me.privatednsIntlv20201028Conn != nil {
-		return me.privatednsIntlv20201028Conn
-	}
-	cpf := me.NewClientIntlProfile(300)
-	me.privatednsIntlv20201028Conn, _ = privatednsIntl.NewClient(me.Credential, me.Region, cpf)
-	me.privatednsIntlv20201028Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.privatednsIntlv20201028Conn
+	return cachedClient(me, newClientKey("privatedns", privatednsIntl.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *privatednsIntl.Client {
+		cpf := me.NewServiceClientIntlProfile("privatedns", 300)
+		client, _ := privatednsIntl.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseWafV20180125Client return WAF client for service
 func (me *TencentCloudClient) UseWafV20180125Client() *waf.Client {
-	if me.wafv20180125Conn != nil {
-		return me.wafv20180125Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.wafv20180125Conn, _ = waf.NewClient(me.Credential, me.Region, cpf)
-	me.wafv20180125Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.wafv20180125Conn
+	return cachedClient(me, newClientKey("waf", waf.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *waf.Client {
+		cpf := me.NewServiceClientProfile("waf", 300)
+		client, _ := waf.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCamV20190116Client return CAM client for service
 func (me *TencentCloudClient) UseCamV20190116Client() *cam.Client {
-	if me.camv20190116Conn != nil {
-		return me.camv20190116Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.camv20190116Conn, _ = cam.NewClient(me.Credential, me.Region, cpf)
-	me.camv20190116Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.camv20190116Conn
+	return cachedClient(me, newClientKey("cam", cam.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cam.Client {
+		cpf := me.NewServiceClientProfile("cam", 300)
+		client, _ := cam.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseClsV20201016Client return CLS client for service
 func (me *TencentCloudClient) UseClsV20201016Client() *cls.Client {
-	if me.clsv20201016Conn != nil {
-		return me.clsv20201016Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.clsv20201016Conn, _ = cls.NewClient(me.Credential, me.Region, cpf)
-	me.clsv20201016Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.clsv20201016Conn
+	return cachedClient(me, newClientKey("cls", cls.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cls.Client {
+		cpf := me.NewServiceClientProfile("cls", 300)
+		client, _ := cls.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UsePostgresqlV20170312Client return POSTGRESQL client for service
 func (me *TencentCloudClient) UsePostgresqlV20170312Client() *postgre.Client {
-	if me.postgresqlv20170312Conn != nil {
-		return me.postgresqlv20170312Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.postgresqlv20170312Conn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgresqlv20170312Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.postgresqlv20170312Conn
+	return cachedClient(me, newClientKey("postgres", postgre.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *postgre.Client {
+		cpf := me.NewServiceClientProfile("postgres", 300)
+		client, _ := postgre.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMonitorV20180724Client returns MONITOR client for service
 func (me *TencentCloudClient) UseMonitorV20180724Client() *monitor.Client {
-	if me.monitor20180724Conn != nil {
-		return me.monitor20180724Conn
-	}
-
-	cpf := me.NewClientProfile(300)
-	me.monitor20180724Conn, _ = monitor.NewClient(me.Credential, me.Region, cpf)
-	me.monitor20180724Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.monitor20180724Conn
+	return cachedClient(me, newClientKey("monitor", monitor.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *monitor.Client {
+		cpf := me.NewServiceClientProfile("monitor", 300)
+		client, _ := monitor.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdcV20201214Client return CDC client for service
 func (me *TencentCloudClient) UseCdcV20201214Client() *cdc.Client {
-	if me.cdcv20201214Conn != nil {
-		return me.cdcv20201214Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.cdcv20201214Conn, _ = cdc.NewClient(me.Credential, me.Region, cpf)
-	me.cdcv20201214Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdcv20201214Conn
+	return cachedClient(me, newClientKey("cdc", cdc.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdc.Client {
+		cpf := me.NewServiceClientProfile("cdc", 300)
+		client, _ := cdc.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseMqttV20240516Client return MQTT client for service
 func (me *TencentCloudClient) UseMqttV20240516Client() *mqtt.Client {
-	if me.mqttv20240516Conn != nil {
-		return me.mqttv20240516Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.mqttv20240516Conn, _ = mqtt.NewClient(me.Credential, me.Region, cpf)
-	me.mqttv20240516Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.mqttv20240516Conn
+	return cachedClient(me, newClientKey("mqtt", mqtt.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *mqtt.Client {
+		cpf := me.NewServiceClientProfile("mqtt", 300)
+		client, _ := mqtt.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseCdwpgV20201230Client return CDWPG client for service
 func (me *TencentCloudClient) UseCdwpgV20201230Client() *cdwpg.Client {
-	if me.cdwpgv20201230Conn != nil {
-		return me.cdwpgv20201230Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.cdwpgv20201230Conn, _ = cdwpg.NewClient(me.Credential, me.Region, cpf)
-	me.cdwpgv20201230Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.cdwpgv20201230Conn
+	return cachedClient(me, newClientKey("cdwpg", cdwpg.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *cdwpg.Client {
+		cpf := me.NewServiceClientProfile("cdwpg", 300)
+		client, _ := cdwpg.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseGwlbV20240906Client return GWLB client for service
 func (me *TencentCloudClient) UseGwlbV20240906Client() *gwlb.Client {
-	if me.gwlbv20240906Conn != nil {
-		return me.gwlbv20240906Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.gwlbv20240906Conn, _ = gwlb.NewClient(me.Credential, me.Region, cpf)
-	me.gwlbv20240906Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.gwlbv20240906Conn
+	return cachedClient(me, newClientKey("gwlb", gwlb.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *gwlb.Client {
+		cpf := me.NewServiceClientProfile("gwlb", 300)
+		client, _ := gwlb.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseBillingV20180709Client return BILLING client for service
 func (me *TencentCloudClient) UseBillingV20180709Client() *billing.Client {
-	if me.billingv20180709Conn != nil {
-		return me.billingv20180709Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.billingv20180709Conn, _ = billing.NewClient(me.Credential, me.Region, cpf)
-	me.billingv20180709Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.billingv20180709Conn
+	return cachedClient(me, newClientKey("billing", billing.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *billing.Client {
+		cpf := me.NewServiceClientProfile("billing", 300)
+		client, _ := billing.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseIgtmV20231024Client return IGTM client for service
 func (me *TencentCloudClient) UseIgtmV20231024Client() *igtmv20231024.Client {
-	if me.igtmv20231024Conn != nil {
-		return me.igtmv20231024Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.igtmv20231024Conn, _ = igtmv20231024.NewClient(me.Credential, me.Region, cpf)
-	me.igtmv20231024Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.igtmv20231024Conn
+	return cachedClient(me, newClientKey("igtm", igtmv20231024.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *igtmv20231024.Client {
+		cpf := me.NewServiceClientProfile("igtm", 300)
+		client, _ := igtmv20231024.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseGa2V20250115Client return GA2 (Global Accelerator 2) client for service
 func (me *TencentCloudClient) UseGa2V20250115Client() *ga2v20250115.Client {
-	if me.ga2v20250115Conn != nil {
-		return me.ga2v20250115Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.ga2v20250115Conn, _ = ga2v20250115.NewClient(me.Credential, me.Region, cpf)
-	me.ga2v20250115Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.ga2v20250115Conn
+	return cachedClient(me, newClientKey("ga2", ga2v20250115.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *ga2v20250115.Client {
+		cpf := me.NewServiceClientProfile("ga2", 300)
+		client, _ := ga2v20250115.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseConfigV20220802Client return Config client for service
 func (me *TencentCloudClient) UseConfigV20220802Client() *configv20220802.Client {
-	if me.configv20220802Conn != nil {
-		return me.configv20220802Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.configv20220802Conn, _ = configv20220802.NewClient(me.Credential, me.Region, cpf)
-	me.configv20220802Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.configv20220802Conn
+	return cachedClient(me, newClientKey("config", configv20220802.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *configv20220802.Client {
+		cpf := me.NewServiceClientProfile("config", 300)
+		client, _ := configv20220802.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseVcubeV20220410Client return VCUBE client for service
 func (me *TencentCloudClient) UseVcubeV20220410Client() *vcubev20220410.Client {
-	if me.vcubev20220410Conn != nil {
-		return me.vcubev20220410Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.vcubev20220410Conn, _ = vcubev20220410.NewClient(me.Credential, me.Region, cpf)
-	me.vcubev20220410Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.vcubev20220410Conn
+	return cachedClient(me, newClientKey("vcube", vcubev20220410.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *vcubev20220410.Client {
+		cpf := me.NewServiceClientProfile("vcube", 300)
+		client, _ := vcubev20220410.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseAdvisorV20200721Client return ADVISOR client for service
 func (me *TencentCloudClient) UseAdvisorV20200721Client() *advisorv20200721.Client {
-	if me.advisorv20200721Conn != nil {
-		return me.advisorv20200721Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.advisorv20200721Conn, _ = v20200721.NewClient(me.Credential, me.Region, cpf)
-	me.advisorv20200721Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.advisorv20200721Conn
+	return cachedClient(me, newClientKey("advisor", v20200721.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *advisorv20200721.Client {
+		cpf := me.NewServiceClientProfile("advisor", 300)
+		client, _ := v20200721.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseVdbV20230616Client return VDB client for service
 func (me *TencentCloudClient) UseVdbV20230616Client() *vdbv20230616.Client {
-	if me.vdbv20230616Conn != nil {
-		return me.vdbv20230616Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.vdbv20230616Conn, _ = vdbv20230616.NewClient(me.Credential, me.Region, cpf)
-	me.vdbv20230616Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.vdbv20230616Conn
+	return cachedClient(me, newClientKey("vdb", vdbv20230616.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *vdbv20230616.Client {
+		cpf := me.NewServiceClientProfile("vdb", 300)
+		client, _ := vdbv20230616.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseGsV20191118Client return GS client for service
 func (me *TencentCloudClient) UseGsV20191118Client() *gsv20191118.Client {
-	if me.gsv20191118Conn != nil {
-		return me.gsv20191118Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.gsv20191118Conn, _ = gsv20191118.NewClient(me.Credential, me.Region, cpf)
-	me.gsv20191118Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.gsv20191118Conn
+	return cachedClient(me, newClientKey("gs", gsv20191118.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *gsv20191118.Client {
+		cpf := me.NewServiceClientProfile("gs", 300)
+		client, _ := gsv20191118.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseKeewidbV20220308Client return KeeWiDB client for service
 func (me *TencentCloudClient) UseKeewidbV20220308Client() *keewidbv20220308.Client {
-	if me.keewidbv20220308Conn != nil {
-		return me.keewidbv20220308Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.keewidbv20220308Conn, _ = keewidbv20220308.NewClient(me.Credential, me.Region, cpf)
-	me.keewidbv20220308Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.keewidbv20220308Conn
+	return cachedClient(me, newClientKey("keewidb", keewidbv20220308.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *keewidbv20220308.Client {
+		cpf := me.NewServiceClientProfile("keewidb", 300)
+		client, _ := keewidbv20220308.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
 
 // UseDbdcV20201029Client return DBDC client for service
 func (me *TencentCloudClient) UseDbdcV20201029Client() *dbdcv20201029.Client {
-	if me.dbdcv20201029Conn != nil {
-		return me.dbdcv20201029Conn
-	}
-	cpf := me.NewClientProfile(300)
-	me.dbdcv20201029Conn, _ = dbdcv20201029.NewClient(me.Credential, me.Region, cpf)
-	me.dbdcv20201029Conn.WithHttpTransport(&LogRoundTripper{})
-
-	return me.dbdcv20201029Conn
+	return cachedClient(me, newClientKey("dbdc", dbdcv20201029.APIVersion, me.Region, nil), func(logRoundTripper *LogRoundTripper) *dbdcv20201029.Client {
+		cpf := me.NewServiceClientProfile("dbdc", 300)
+		client, _ := dbdcv20201029.NewClient(me.GetCredential(), me.Region, cpf)
+		client.WithHttpTransport(logRoundTripper)
+		return client
+	})
 }
@@ -0,0 +1,88 @@
+package connectivity
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+// clientKey identifies a client in the clientCache.
+type clientKey struct {
+	product    string
+	version    string
+	region     string
+	instanceId string
+	// endpoint and timeout tell the COS clients of different buckets apart
+	endpoint string
+	timeout  time.Duration
+	// credential is the credential the client signs with, see
+	// TencentCloudClient.GetCredential
+	credential common.CredentialIface
+	// clientType is the type of the client, e.g. *cvm.Client
+	clientType reflect.Type
+	// resourceType is the TencentCloudClient.ResourceType of the client
+	resourceType string
+}
+
+// newClientKey returns the key of the client of product in region, which
+// sends the requests of the IacExtInfo of a resource, if any.
+func newClientKey(product, version, region string, iacExtInfo []IacExtInfo) clientKey {
+	key := clientKey{
+		product: product,
+		version: version,
+		region:  region,
+	}
+	if len(iacExtInfo) != 0 {
+		key.instanceId = iacExtInfo[0].InstanceId
+	}
+
+	return key
+}
+
+// clientCache holds the clients of a TencentCloudClient. It is shared by the
+// copies of the TencentCloudClient, e.g. the ones with another Region.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[clientKey]interface{}
+}
+
+// clientCacheMu guards the creation of TencentCloudClient.clients.
+var clientCacheMu sync.Mutex
+
+// clientCache returns the client cache of me, creating it if needed.
+func (me *TencentCloudClient) clientCache() *clientCache {
+	clientCacheMu.Lock()
+	defer clientCacheMu.Unlock()
+
+	if me.clients == nil {
+		me.clients = &clientCache{clients: make(map[clientKey]interface{})}
+	}
+	return me.clients
+}
+
+// cachedClient returns the client of key, calling newClient to create it on
+// the first call. The clients are never changed after they are created, so
+// that concurrent resources can share them, and newClient gets the
+// LogRoundTripper of the InstanceId of key.
+func cachedClient[T any](me *TencentCloudClient, key clientKey, newClient func(logRoundTripper *LogRoundTripper) T) T {
+	key.credential = me.GetCredential()
+	key.clientType = reflect.TypeOf((*T)(nil)).Elem()
+	key.resourceType = me.ResourceType
+
+	cache := me.clientCache()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if client, ok := cache.clients[key]; ok {
+		return client.(T)
+	}
+
+	logRoundTripper := me.NewLogRoundTripper(key.product)
+	logRoundTripper.InstanceId = key.instanceId
+	client := newClient(logRoundTripper)
+	cache.clients[key] = client
+
+	return client
+}
@@ -0,0 +1,70 @@
+package connectivity
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+func newTestClient() *TencentCloudClient {
+	return &TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+	}
+}
+
+func TestCachedClient(t *testing.T) {
+	client := newTestClient()
+
+	vpcClient := client.UseVpcClient()
+	assert.Same(t, vpcClient, client.UseVpcClient())
+	assert.NotSame(t, vpcClient, client.UseVpcClient(IacExtInfo{InstanceId: "vpc-1"}))
+	assert.Same(t, client.UseVpcClient(IacExtInfo{InstanceId: "vpc-1"}), client.UseVpcClient(IacExtInfo{InstanceId: "vpc-1"}))
+
+	// the same product and API version of another SDK
+	assert.NotNil(t, client.UseCvmClient())
+	assert.NotNil(t, client.UseCvmIntlClient())
+	assert.Same(t, client.UseCvmClient(), client.UseCvmV20170312Client())
+
+	regionClient := *client
+	regionClient.Region = "ap-shanghai"
+	assert.NotSame(t, vpcClient, regionClient.UseVpcClient())
+	assert.Equal(t, "ap-shanghai", regionClient.UseVpcClient().GetRegion())
+	assert.Same(t, client.UseMysqlClientRegion("ap-shanghai"), regionClient.UseMysqlClient())
+	assert.Same(t, client.UseMysqlClient(), client.UseMysqlClientRegion(""))
+
+	cosClient := client.UseTencentCosClient("bucket-1250000000")
+	assert.Same(t, cosClient, client.UseTencentCosClient("bucket-1250000000"))
+	assert.NotSame(t, cosClient, client.UseTencentCosClient("other-1250000000"))
+	assert.Equal(t, "bucket-1250000000.cos.ap-guangzhou.myqcloud.com", cosClient.BaseURL.BucketURL.Host)
+
+	client.Credential = common.NewCredential("AKIDother", "other")
+	assert.NotSame(t, vpcClient, client.UseVpcClient())
+}
+
+func TestCachedClientConcurrent(t *testing.T) {
+	client := newTestClient()
+
+	var wg sync.WaitGroup
+	vpcClients := make([]interface{}, 20)
+	for i := range vpcClients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			vpcClients[i] = client.UseVpcClient()
+			client.UseTencentCosClient(fmt.Sprintf("bucket%d-1250000000", i%3))
+			client.UseMysqlClient(IacExtInfo{InstanceId: fmt.Sprintf("cdb-%d", i%2)})
+		}(i)
+	}
+	wg.Wait()
+
+	for _, vpcClient := range vpcClients {
+		assert.Same(t, vpcClients[0], vpcClient)
+	}
+	// vpc, 3 COS buckets and 2 mysql clients
+	assert.Len(t, client.clients.clients, 6)
+}
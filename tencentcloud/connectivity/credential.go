@@ -0,0 +1,108 @@
+package connectivity
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+// credentialRefreshWindow is how long before its expiration a temporary
+// credential is refreshed.
+const credentialRefreshWindow = 5 * time.Minute
+
+// CredentialRefresher fetches a temporary credential, e.g. by assuming a role,
+// and returns it with its expiration. A zero expiration means the credential
+// never expires.
+type CredentialRefresher func() (credential *common.Credential, expiration time.Time, err error)
+
+// RefreshingCredential is a temporary credential, which is refreshed by its
+// refresher before it expires. It is shared by all the clients of a
+// TencentCloudClient, so that they sign the requests with the same
+// credential after it is refreshed.
+type RefreshingCredential struct {
+	mu         sync.Mutex
+	credential *common.Credential
+	expiration time.Time
+	refresher  CredentialRefresher
+}
+
+var _ common.CredentialIface = &RefreshingCredential{}
+
+// NewRefreshingCredential fetches the first credential of refresher, and
+// returns the RefreshingCredential refreshing it.
+func NewRefreshingCredential(refresher CredentialRefresher) (*RefreshingCredential, error) {
+	credential, expiration, err := refresher()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshingCredential{
+		credential: credential,
+		expiration: expiration,
+		refresher:  refresher,
+	}, nil
+}
+
+// current returns the credential, refreshing it if it expires within the
+// credentialRefreshWindow. A credential failing to refresh is kept until
+// it expires, and refreshed again on the next call.
+func (c *RefreshingCredential) current() *common.Credential {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.expiration.IsZero() || time.Until(c.expiration) > credentialRefreshWindow {
+		return c.credential
+	}
+
+	credential, expiration, err := c.refresher()
+	if err != nil {
+		log.Printf("[CRITICAL] refresh credential expiring at %s failed: %v", c.expiration.Format(time.RFC3339), err)
+		return c.credential
+	}
+
+	log.Printf("[DEBUG] credential refreshed, expiring at %s", expiration.Format(time.RFC3339))
+	c.credential = credential
+	c.expiration = expiration
+	return c.credential
+}
+
+func (c *RefreshingCredential) GetSecretId() string {
+	return c.current().SecretId
+}
+
+func (c *RefreshingCredential) GetSecretKey() string {
+	return c.current().SecretKey
+}
+
+func (c *RefreshingCredential) GetToken() string {
+	return c.current().Token
+}
+
+func (c *RefreshingCredential) GetCredential() (string, string, string) {
+	return c.current().GetCredential()
+}
+
+// s3CredentialProvider is the credentials.Provider of the COS clients of the
+// S3 API, reading credential on every request.
+type s3CredentialProvider struct {
+	credential common.CredentialIface
+}
+
+func (p *s3CredentialProvider) Retrieve() (credentials.Value, error) {
+	secretId, secretKey, token := p.credential.GetCredential()
+	return credentials.Value{
+		AccessKeyID:     secretId,
+		SecretAccessKey: secretKey,
+		SessionToken:    token,
+		ProviderName:    "TencentCloudCredential",
+	}, nil
+}
+
+// IsExpired is always true, so that the credential is retrieved again after
+// it is refreshed.
+func (p *s3CredentialProvider) IsExpired() bool {
+	return true
+}
@@ -0,0 +1,101 @@
+package connectivity
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func TestRefreshingCredential(t *testing.T) {
+	var refreshed int
+	var refreshErr error
+	expiration := time.Now().Add(time.Hour)
+	credential, err := NewRefreshingCredential(func() (*common.Credential, time.Time, error) {
+		if refreshErr != nil {
+			return nil, time.Time{}, refreshErr
+		}
+		refreshed++
+		return common.NewTokenCredential(fmt.Sprintf("AKID%d", refreshed), "key", fmt.Sprintf("token%d", refreshed)), expiration, nil
+	})
+	assert.NoError(t, err)
+
+	secretId, _, token := credential.GetCredential()
+	assert.Equal(t, "AKID1", secretId)
+	assert.Equal(t, "token1", token)
+	assert.Equal(t, 1, refreshed)
+
+	// expiring within the refresh window
+	credential.expiration = time.Now().Add(time.Minute)
+	assert.Equal(t, "AKID2", credential.GetSecretId())
+	assert.Equal(t, "token2", credential.GetToken())
+	assert.Equal(t, 2, refreshed)
+
+	// the credential is kept when it fails to refresh
+	credential.expiration = time.Now().Add(time.Minute)
+	refreshErr = errors.New("sts unavailable")
+	assert.Equal(t, "AKID2", credential.GetSecretId())
+	refreshErr = nil
+	assert.Equal(t, "AKID3", credential.GetSecretId())
+
+	// a credential without expiration is never refreshed
+	credential.expiration = time.Time{}
+	assert.Equal(t, "AKID3", credential.GetSecretId())
+	assert.Equal(t, 3, refreshed)
+
+	_, err = NewRefreshingCredential(func() (*common.Credential, time.Time, error) {
+		return nil, time.Time{}, errors.New("assume role failed")
+	})
+	assert.Error(t, err)
+}
+
+func TestRefreshingCredentialRotation(t *testing.T) {
+	var locker sync.Mutex
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locker.Lock()
+		tokens = append(tokens, r.Header.Get("X-TC-Token"))
+		locker.Unlock()
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	var refreshed int
+	credential, err := NewRefreshingCredential(func() (*common.Credential, time.Time, error) {
+		refreshed++
+		return common.NewTokenCredential("AKIDtest", "key", fmt.Sprintf("token%d", refreshed)), time.Now().Add(time.Hour), nil
+	})
+	assert.NoError(t, err)
+
+	client := &TencentCloudClient{
+		Region:    "ap-guangzhou",
+		Protocol:  "HTTPS",
+		Endpoints: map[string]string{"vpc": server.URL},
+	}
+	client.SetRefreshingCredential(credential)
+	assert.Equal(t, "token1", client.Credential.Token)
+
+	vpcClient := client.UseVpcClient()
+	_, err = vpcClient.DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+
+	// the cached client signs with the refreshed credential
+	credential.expiration = time.Now()
+	_, err = client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	assert.Same(t, vpcClient, client.UseVpcClient())
+	assert.Equal(t, []string{"token1", "token2"}, tokens)
+
+	client.SetCredential(common.NewTokenCredential("AKIDstatic", "key", "static"))
+	assert.NotSame(t, vpcClient, client.UseVpcClient())
+	_, err = client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, "static", tokens[len(tokens)-1])
+}
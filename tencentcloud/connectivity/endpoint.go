@@ -0,0 +1,124 @@
+package connectivity
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EndpointServices are the services whose endpoint can be set in
+// TencentCloudClient.Endpoints. They are named as in the API domains, e.g.
+// `cvm` for `cvm.tencentcloudapi.com`, and `cos` is the COS domain.
+var EndpointServices = []string{
+	"advisor", "antiddos", "api", "apigateway", "apm", "as", "bh", "bi", "billing",
+	"cam", "cat", "cbs", "cdb", "cdc", "cdn", "cdwch", "cdwdoris", "cdwpg", "cfs",
+	"cfw", "chdfs", "ciam", "ckafka", "clb", "cloudaudit", "cls", "config",
+	"controlcenter", "cos", "csip", "cvm", "cwp", "cynosdb", "dasb", "dayu",
+	"dbbrain", "dbdc", "dc", "dcdb", "dlc", "dnspod", "domain", "dts", "eb", "emr",
+	"es", "ga2", "gaap", "gs", "gwlb", "igtm", "keewidb", "kms", "lighthouse",
+	"live", "mariadb", "mdl", "mongodb", "monitor", "mps", "mqtt", "oceanus",
+	"organization", "postgres", "privatedns", "pts", "redis", "region", "rum",
+	"scf", "ses", "sms", "sqlserver", "ssl", "ssm", "sts", "tag", "tat",
+	"tcaplusdb", "tcm", "tcr", "tcss", "tdcpg", "tdmq", "tem", "teo", "thpc",
+	"tke", "trocket", "tse", "tsf", "vcube", "vdb", "vod", "vpc", "waf", "wedata",
+	"wss",
+}
+
+// EndpointDescription returns the description of the argument of service in
+// the `endpoints` block of the provider.
+func EndpointDescription(service string) string {
+	if service == "cos" {
+		return "The COS domain, which takes precedence over `cos_domain`, e.g. `https://cos.ap-guangzhou.myqcloud.com`."
+	}
+	return fmt.Sprintf("The endpoint of the `%[1]s` API, which takes precedence over `domain`, e.g. `%[1]s.internal.tencentcloudapi.com`. A URL such as `https://%[1]s.internal.tencentcloudapi.com` also sets the protocol.", service)
+}
+
+// splitEndpoint splits an endpoint of TencentCloudClient.Endpoints into the
+// scheme, which is empty unless the endpoint is a URL, and the host with an
+// optional path.
+func splitEndpoint(endpoint string) (scheme, host string) {
+	if scheme, host, found := strings.Cut(endpoint, "://"); found {
+		return scheme, host
+	}
+	return "", endpoint
+}
+
+// TransportConfig is the network setting of TencentCloudClient.Transport.
+type TransportConfig struct {
+	// ProxyUrl is the HTTP(S) proxy of all the requests, e.g.
+	// `http://proxy.example.com:3128`. The proxy of the environment
+	// variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY is used when empty.
+	ProxyUrl string
+	// CaBundle is the path of a PEM file of the certificate authorities
+	// trusted in addition to the ones of the system.
+	CaBundle string
+}
+
+// maxIdleConnsPerHost is the number of idle connections kept per API host.
+// The parallel resources of terraform call few hosts, while
+// http.DefaultTransport keeps only 2 connections per host.
+const maxIdleConnsPerHost = 32
+
+// defaultTransport is the transport of all API and COS calls of a client
+// without TencentCloudClient.Transport.
+var defaultTransport http.RoundTripper = newTransport(http.ProxyFromEnvironment)
+
+// newTransport returns a clone of http.DefaultTransport sending the requests
+// through proxy, which pools maxIdleConnsPerHost connections per host.
+func newTransport(proxy func(*http.Request) (*url.URL, error)) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.Proxy = proxy
+	return transport
+}
+
+// transports are the transports of NewTransport by TransportConfig, so that
+// the providers of the same config share the pooled connections.
+var transports sync.Map
+
+// NewTransport returns a transport sending the requests as config says, or
+// the default transport when config is empty.
+func NewTransport(config TransportConfig) (http.RoundTripper, error) {
+	if config == (TransportConfig{}) {
+		return defaultTransport, nil
+	}
+	if transport, ok := transports.Load(config); ok {
+		return transport.(http.RoundTripper), nil
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(config.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url %q: %v", config.ProxyUrl, err)
+		}
+		if proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q: scheme and host are required", config.ProxyUrl)
+		}
+		proxy = http.ProxyURL(proxyUrl)
+	}
+	transport := newTransport(proxy)
+
+	if config.CaBundle != "" {
+		pem, err := os.ReadFile(config.CaBundle)
+		if err != nil {
+			return nil, fmt.Errorf("read ca bundle failed: %v", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca bundle %s has no PEM certificate", config.CaBundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+
+	actual, _ := transports.LoadOrStore(config, transport)
+	return actual.(http.RoundTripper), nil
+}
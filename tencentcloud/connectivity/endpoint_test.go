@@ -0,0 +1,179 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func TestNewServiceClientProfile(t *testing.T) {
+	client := &TencentCloudClient{
+		Protocol: "HTTPS",
+		Domain:   "example.com",
+		Endpoints: map[string]string{
+			"cvm": "cvm.internal.tencentcloudapi.com",
+			"vpc": "http://vpc.example.com/api",
+		},
+	}
+
+	cpf := client.NewServiceClientProfile("cvm", 300)
+	assert.Equal(t, "cvm.internal.tencentcloudapi.com", cpf.HttpProfile.Endpoint)
+	assert.Equal(t, "HTTPS", cpf.HttpProfile.Scheme)
+
+	cpf = client.NewServiceClientProfile("vpc", 300)
+	assert.Equal(t, "vpc.example.com/api", cpf.HttpProfile.Endpoint)
+	assert.Equal(t, "http", cpf.HttpProfile.Scheme)
+
+	cpf = client.NewServiceClientProfile("cbs", 300)
+	assert.Empty(t, cpf.HttpProfile.Endpoint)
+	assert.Equal(t, "example.com", cpf.HttpProfile.RootDomain)
+
+	intlCpf := client.NewServiceClientIntlProfile("cvm", 300)
+	assert.Equal(t, "cvm.internal.tencentcloudapi.com", intlCpf.HttpProfile.Endpoint)
+}
+
+// serverTransport returns a transport sending the requests of every host to
+// server.
+func serverTransport(server *httptest.Server) http.RoundTripper {
+	transport := newTransport(nil)
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	return transport
+}
+
+func TestServiceEndpoint(t *testing.T) {
+	var hosts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Domain:     "unused.test",
+		Endpoints:  map[string]string{"vpc": "http://vpc-private.endpoint.test"},
+		Transport:  serverTransport(server),
+	}
+
+	response, err := client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, "req-1", *response.Response.RequestId)
+	assert.Equal(t, []string{"vpc-private.endpoint.test"}, hosts)
+}
+
+func TestOmitNilClientEndpoint(t *testing.T) {
+	var hosts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		_, _ = w.Write([]byte(`{"Response":{"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTP",
+		Domain:     "omitnil.test",
+		Endpoints:  map[string]string{"vpc": "http://vpc-private.omitnil.test"},
+		Transport:  serverTransport(server),
+	}
+
+	for _, module := range []string{"vpc", "tke"} {
+		request := tchttp.NewCommonRequest(module, "2018-05-25", "DescribeClusters")
+		assert.NoError(t, request.SetActionParameters(map[string]interface{}{}))
+		assert.NoError(t, client.UseOmitNilClient(module).Send(request, tchttp.NewCommonResponse()))
+	}
+	assert.Equal(t, []string{"vpc-private.omitnil.test", "tke.omitnil.test"}, hosts)
+}
+
+func TestRequestProduct(t *testing.T) {
+	request, err := http.NewRequest(http.MethodPost, "https://vpc-private.example.com", nil)
+	assert.NoError(t, err)
+	// the host of an endpoint is not the product
+	assert.Equal(t, "vpc", (&LogRoundTripper{Product: "vpc"}).requestProduct(request))
+	assert.Equal(t, "", (&LogRoundTripper{}).requestProduct(request))
+
+	request.Header.Set("Authorization", "TC3-HMAC-SHA256 Credential=AKIDtest/2024-01-01/cvm/tc3_request, SignedHeaders=content-type;host, Signature=abc")
+	assert.Equal(t, "cvm", (&LogRoundTripper{Product: "vpc"}).requestProduct(request))
+}
+
+func TestParseResponseMeta(t *testing.T) {
+	requestId, errorCode := parseResponseMeta([]byte(`{"Response":{"VpcSet":[],"RequestId":"req-1"}}`))
+	assert.Equal(t, "req-1", requestId)
+	assert.Empty(t, errorCode)
+
+	requestId, errorCode = parseResponseMeta([]byte(`{"Response": {"Error": {"Code": "RequestLimitExceeded", "Message": "limited"}, "RequestId": "req-2"}}`))
+	assert.Equal(t, "req-2", requestId)
+	assert.Equal(t, "RequestLimitExceeded", errorCode)
+
+	requestId, errorCode = parseResponseMeta([]byte(`not JSON`))
+	assert.Empty(t, requestId)
+	assert.Empty(t, errorCode)
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		_, _ = w.Write([]byte("proxied"))
+	}))
+	defer proxy.Close()
+
+	transport, err := NewTransport(TransportConfig{ProxyUrl: proxy.URL})
+	assert.NoError(t, err)
+
+	response, err := (&http.Client{Transport: transport}).Get("http://cvm.tencentcloudapi.invalid/")
+	if assert.NoError(t, err) {
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		assert.Equal(t, "proxied", string(body))
+	}
+	assert.Equal(t, []string{"http://cvm.tencentcloudapi.invalid/"}, proxied)
+
+	_, err = NewTransport(TransportConfig{ProxyUrl: "proxy.example.com:3128"})
+	assert.Error(t, err)
+}
+
+func TestNewTransportCaBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	_, err := (&http.Client{Transport: defaultTransport}).Get(server.URL)
+	assert.Error(t, err)
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.NoError(t, os.WriteFile(caBundle, certificate, 0600))
+
+	transport, err := NewTransport(TransportConfig{CaBundle: caBundle})
+	assert.NoError(t, err)
+	response, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if assert.NoError(t, err) {
+		_ = response.Body.Close()
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid.pem")
+	assert.NoError(t, os.WriteFile(invalid, []byte("not a certificate"), 0600))
+	_, err = NewTransport(TransportConfig{CaBundle: invalid})
+	assert.Error(t, err)
+
+	_, err = NewTransport(TransportConfig{CaBundle: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package connectivity
+
+import "strings"
+
+// IgnoreTagsConfig holds the tag keys and key prefixes configured in the provider
+// `ignore_tags` block. Matching keys are never read into state nor deleted on update.
+type IgnoreTagsConfig struct {
+	Keys        map[string]struct{}
+	KeyPrefixes []string
+}
+
+// NewIgnoreTagsConfig returns nil when neither keys nor prefixes are given.
+func NewIgnoreTagsConfig(keys, keyPrefixes []string) *IgnoreTagsConfig {
+	if len(keys) == 0 && len(keyPrefixes) == 0 {
+		return nil
+	}
+
+	config := &IgnoreTagsConfig{
+		Keys:        make(map[string]struct{}, len(keys)),
+		KeyPrefixes: keyPrefixes,
+	}
+	for _, k := range keys {
+		config.Keys[k] = struct{}{}
+	}
+
+	return config
+}
+
+// Ignored reports whether the tag key matches the configured keys or key prefixes.
+func (c *IgnoreTagsConfig) Ignored(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	if _, ok := c.Keys[key]; ok {
+		return true
+	}
+
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
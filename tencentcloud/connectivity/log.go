@@ -0,0 +1,173 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redactedValue = "******"
+
+// redactDenyKeys are the JSON keys whose values are redacted in the API
+// logs. A key matches when its lower case form contains any of them.
+var redactDenyKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"credential",
+	"privatekey",
+	"kubeconfig",
+	"signature",
+	"authorization",
+	"accesskey",
+	"certificate",
+	"userdata",
+}
+
+// redactDenyExactKeys are the JSON keys whose values are redacted in the
+// API logs, in lower case. They only match whole keys, as keys such as
+// NextToken or ClientToken hold no secret.
+var redactDenyExactKeys = map[string]bool{
+	"token":            true,
+	"securitytoken":    true,
+	"sessiontoken":     true,
+	"tmptoken":         true,
+	"accesstoken":      true,
+	"refreshtoken":     true,
+	"idtoken":          true,
+	"apitoken":         true,
+	"authtoken":        true,
+	"webidentitytoken": true,
+	"tokencode":        true,
+}
+
+// redactAllowKeys are JSON keys matching redactDenyKeys which hold no
+// sensitive value and are kept in the API logs, in lower case.
+var redactAllowKeys = map[string]bool{
+	"secretid":            true,
+	"secretname":          true,
+	"secretnames":         true,
+	"secretstatus":        true,
+	"secrettype":          true,
+	"secretlist":          true,
+	"secretmetadatas":     true,
+	"credentialid":        true,
+	"credentialtype":      true,
+	"certificateid":       true,
+	"certificateids":      true,
+	"certificatetype":     true,
+	"certificatestatus":   true,
+	"passwordpolicy":      true,
+	"resetpasswordstatus": true,
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	if redactAllowKeys[key] {
+		return false
+	}
+	if redactDenyExactKeys[key] {
+		return true
+	}
+	for _, deny := range redactDenyKeys {
+		if strings.Contains(key, deny) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns body with the values of sensitive JSON keys redacted.
+// A body which is not JSON is replaced by a placeholder, as it can not be
+// redacted reliably.
+func redactBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+
+	// numbers are kept as they are, large IDs do not fit a float64
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		placeholder, _ := json.Marshal("non-JSON body of " + strconv.Itoa(len(body)) + " bytes")
+		return placeholder
+	}
+
+	redacted, _ := json.Marshal(redactValue(value))
+	return redacted
+}
+
+// RedactJSON returns the JSON document s with the values of sensitive keys
+// redacted as in the API logs, e.g. `{"Password":"******"}`. It is used for
+// the request and response bodies logged by the services:
+//
+//	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId,
+//		request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
+func RedactJSON(s string) string {
+	return string(redactBody([]byte(s)))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isRedactedKey(key) {
+				v[key] = redactAll(item)
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// redactAll redacts every string in value. The structure of value is kept,
+// so that a redacted body still decodes into the same type.
+func redactAll(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return redactedValue
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = redactAll(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactAll(item)
+		}
+	}
+	return value
+}
+
+// apiLogRecord is the structured record logged for every API request.
+// RetryCount is the number of failed calls of the same request right before
+// this one, see nextRetryCount.
+type apiLogRecord struct {
+	Product    string          `json:"product"`
+	Action     string          `json:"action"`
+	Region     string          `json:"region,omitempty"`
+	Host       string          `json:"host"`
+	RequestId  string          `json:"request_id,omitempty"`
+	Latency    string          `json:"latency"`
+	LatencyMs  int64           `json:"latency_ms"`
+	RetryCount int             `json:"retry_count"`
+	ErrorCode  string          `json:"error_code,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	Response   json.RawMessage `json:"response,omitempty"`
+
+	latency time.Duration
+}
+
+func (r *apiLogRecord) setLatency(latency time.Duration) {
+	r.latency = latency
+	r.Latency = latency.String()
+	r.LatencyMs = latency.Milliseconds()
+}
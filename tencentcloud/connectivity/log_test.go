@@ -0,0 +1,199 @@
+package connectivity
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{
+		"InstanceName": "foo",
+		"Password": "Passw0rd!",
+		"LoginSettings": {"Password": "Passw0rd!", "KeyIds": ["skey-1"]},
+		"SecretName": "db",
+		"SecretString": "top-secret",
+		"Kubeconfig": "apiVersion: v1",
+		"Users": [{"UserName": "root", "UserPassword": "Passw0rd!"}],
+		"Credentials": {"TmpSecretId": "id", "TmpSecretKey": "key", "Token": "token"},
+		"Token": null,
+		"WebIdentityToken": "jwt",
+		"NextToken": "page-2",
+		"ClientToken": "idempotent",
+		"ExpiredTime": 1700000000
+	}`)
+
+	var redacted map[string]interface{}
+	assert.NoError(t, json.Unmarshal(redactBody(body), &redacted))
+
+	assert.Equal(t, "foo", redacted["InstanceName"])
+	assert.Equal(t, redactedValue, redacted["Password"])
+	assert.Equal(t, redactedValue, redacted["LoginSettings"].(map[string]interface{})["Password"])
+	assert.Equal(t, []interface{}{"skey-1"}, redacted["LoginSettings"].(map[string]interface{})["KeyIds"])
+	assert.Equal(t, "db", redacted["SecretName"])
+	assert.Equal(t, redactedValue, redacted["SecretString"])
+	assert.Equal(t, redactedValue, redacted["Kubeconfig"])
+	assert.Equal(t, "root", redacted["Users"].([]interface{})[0].(map[string]interface{})["UserName"])
+	assert.Equal(t, redactedValue, redacted["Users"].([]interface{})[0].(map[string]interface{})["UserPassword"])
+	assert.Equal(t, map[string]interface{}{
+		"TmpSecretId":  redactedValue,
+		"TmpSecretKey": redactedValue,
+		"Token":        redactedValue,
+	}, redacted["Credentials"])
+	assert.Nil(t, redacted["Token"])
+	assert.Equal(t, redactedValue, redacted["WebIdentityToken"])
+	assert.Equal(t, "page-2", redacted["NextToken"])
+	assert.Equal(t, "idempotent", redacted["ClientToken"])
+	assert.Equal(t, 1700000000.0, redacted["ExpiredTime"])
+
+	assert.Nil(t, redactBody(nil))
+	assert.Equal(t, `"non-JSON body of 11 bytes"`, string(redactBody([]byte("password=1&"))))
+}
+
+func TestRedactJSON(t *testing.T) {
+	request := cdb.NewCreateDBInstanceHourRequest()
+	request.InstanceName = common.StringPtr("db")
+	request.Password = common.StringPtr("Passw0rd!")
+	request.ProjectId = common.Int64Ptr(1234567890123456789)
+
+	assert.Equal(t, `{"InstanceName":"db","Password":"******","ProjectId":1234567890123456789}`, RedactJSON(request.ToJsonString()))
+	assert.Equal(t, `{"Response":{"RequestId":"req","SecretString":"******"}}`, RedactJSON(`{"Response":{"SecretString":"top-secret","RequestId":"req"}}`))
+	assert.Equal(t, "", RedactJSON(""))
+}
+
+func TestLogRoundTripper(t *testing.T) {
+	var sentHeaders []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sentHeaders = append(sentHeaders, r.Header.Clone())
+		_, _ = w.Write([]byte(`{"Response":{"RequestId":"req-1","Error":{"Code":"InvalidParameter","Message":"bad"}}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	body := `{"InstanceId":"ins-1","Password":"Passw0rd!"}`
+	request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	assert.NoError(t, err)
+	request.Header.Set("X-TC-Action", "ResetInstancesPassword")
+	request.Header.Set("X-TC-Region", "ap-guangzhou")
+
+	transport := &LogRoundTripper{}
+	for i := 0; i < 2; i++ {
+		request.Body, _ = request.GetBody()
+		response, err := transport.RoundTrip(request)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	// the request of the caller is left as it is
+	assert.Empty(t, request.Header.Get("X-TC-RequestClient"))
+	assert.Len(t, sentHeaders, 2)
+	for _, header := range sentHeaders {
+		assert.NotEmpty(t, header.Get("X-TC-RequestClient"))
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	for i, line := range lines {
+		assert.NotContains(t, line, "Passw0rd!")
+		assert.Contains(t, line, "[DEBUG] tencentcloud-sdk-go: ")
+
+		var record apiLogRecord
+		assert.NoError(t, json.Unmarshal([]byte(line[strings.Index(line, "{"):]), &record))
+		assert.Equal(t, "ResetInstancesPassword", record.Action)
+		assert.Equal(t, "ap-guangzhou", record.Region)
+		assert.Equal(t, "req-1", record.RequestId)
+		assert.Equal(t, "InvalidParameter", record.ErrorCode)
+		assert.Equal(t, i, record.RetryCount)
+		assert.JSONEq(t, `{"InstanceId":"ins-1","Password":"******"}`, string(record.Request))
+	}
+}
+
+func TestLogRoundTripperRetryCount(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			_, _ = w.Write([]byte(`{"Response":{"RequestId":"req-fail","Error":{"Code":"InternalError","Message":"busy"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Response":{"RequestId":"req-ok"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	// resource.Retry sends a new request with the same body after a failure
+	send := func(body string) {
+		request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		assert.NoError(t, err)
+		request.Header.Set("X-TC-Action", "DescribeRetryCount")
+		request.Header.Set("X-TC-Region", "ap-guangzhou")
+		response, err := (&LogRoundTripper{Product: "vpc"}).RoundTrip(request)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+	send(`{"VpcId":"vpc-1"}`)
+	send(`{"VpcId":"vpc-1"}`)
+	send(`{"VpcId":"vpc-1"}`)
+	// the request succeeded, so the next call is not a retry
+	send(`{"VpcId":"vpc-1"}`)
+	send(`{"VpcId":"vpc-2"}`)
+
+	var retryCounts []int
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record apiLogRecord
+		assert.NoError(t, json.Unmarshal([]byte(line[strings.Index(line, "{"):]), &record))
+		retryCounts = append(retryCounts, record.RetryCount)
+	}
+	assert.Equal(t, []int{0, 1, 2, 0, 0}, retryCounts)
+}
+
+func TestFailedRequestsBound(t *testing.T) {
+	failedRequests.Lock()
+	saved := failedRequests.values
+	failedRequests.values = make(map[[sha256.Size]byte]failedRequest)
+	failedRequests.Unlock()
+	defer func() {
+		failedRequests.Lock()
+		defer failedRequests.Unlock()
+		failedRequests.values = saved
+	}()
+
+	fingerprint := func(i int) [sha256.Size]byte {
+		return requestFingerprint(&apiLogRecord{Product: "vpc", Action: "DescribeVpcs"}, []byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < maxFailedRequests+10; i++ {
+		recordRequestResult(fingerprint(i), 0, true)
+	}
+	assert.Len(t, failedRequests.values, maxFailedRequests)
+	// the requests which failed first are forgotten
+	assert.Equal(t, 0, nextRetryCount(fingerprint(0)))
+	assert.Equal(t, 1, nextRetryCount(fingerprint(maxFailedRequests+9)))
+
+	// and so are the expired ones, before any other
+	failedRequests.Lock()
+	expired := failedRequests.values[fingerprint(20)]
+	expired.failedAt = expired.failedAt.Add(-2 * failedRequestTTL)
+	failedRequests.values[fingerprint(20)] = expired
+	failedRequests.Unlock()
+	recordRequestResult(fingerprint(-1), 0, true)
+	assert.Equal(t, 0, nextRetryCount(fingerprint(20)))
+	assert.Equal(t, 1, nextRetryCount(fingerprint(21)))
+	assert.Len(t, failedRequests.values, maxFailedRequests)
+}
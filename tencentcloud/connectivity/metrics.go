@@ -0,0 +1,279 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// API_METRICS_FILE is the file the API metrics are written to when the
+// provider stops, in the format of API_METRICS_FORMAT, which is either
+// `json`, the default, or `openmetrics`.
+const API_METRICS_FILE = "TENCENTCLOUD_API_METRICS_FILE"
+const API_METRICS_FORMAT = "TENCENTCLOUD_API_METRICS_FORMAT"
+
+const (
+	APIMetricsFormatJSON        = "json"
+	APIMetricsFormatOpenMetrics = "openmetrics"
+)
+
+// apiMetricsSummaryLimit is the number of the slowest API calls logged in
+// the summary.
+const apiMetricsSummaryLimit = 20
+
+type apiMetricKey struct {
+	Product      string
+	Action       string
+	Region       string
+	ResourceType string
+}
+
+// APIMetric is the statistics of the calls of an API action in a region by
+// a resource type. ResourceType is empty for the calls made outside of a
+// resource, e.g. by the provider itself. Retries counts the calls which
+// resent a request after its previous call failed, which is a heuristic, see
+// nextRetryCount.
+type APIMetric struct {
+	Product      string  `json:"product"`
+	Action       string  `json:"action"`
+	Region       string  `json:"region,omitempty"`
+	ResourceType string  `json:"resource_type,omitempty"`
+	Count        int     `json:"count"`
+	Errors       int     `json:"errors"`
+	Throttles    int     `json:"throttles"`
+	Retries      int     `json:"retries"`
+	TotalMs      float64 `json:"total_ms"`
+	P50Ms        float64 `json:"p50_ms"`
+	P95Ms        float64 `json:"p95_ms"`
+}
+
+type apiMetricValue struct {
+	errors    int
+	throttles int
+	retries   int
+	latencies []time.Duration
+}
+
+// apiMetrics aggregates the records of all the API calls of LogRoundTripper.
+var apiMetrics = struct {
+	sync.Mutex
+	values map[apiMetricKey]*apiMetricValue
+}{values: make(map[apiMetricKey]*apiMetricValue)}
+
+// recordAPIMetric adds the API call of record, made by resourceType, to the
+// API metrics.
+func recordAPIMetric(resourceType string, record *apiLogRecord) {
+	key := apiMetricKey{
+		Product:      record.Product,
+		Action:       record.Action,
+		Region:       record.Region,
+		ResourceType: resourceType,
+	}
+
+	apiMetrics.Lock()
+	defer apiMetrics.Unlock()
+
+	value, ok := apiMetrics.values[key]
+	if !ok {
+		value = &apiMetricValue{}
+		apiMetrics.values[key] = value
+	}
+	value.latencies = append(value.latencies, record.latency)
+	if record.RetryCount > 0 {
+		value.retries++
+	}
+	if strings.HasPrefix(record.ErrorCode, "RequestLimitExceeded") {
+		value.throttles++
+	}
+	if record.ErrorCode != "" || record.Error != "" {
+		value.errors++
+	}
+}
+
+// APIMetrics returns the statistics of the API calls made so far, the ones
+// taking the longest in total first.
+func APIMetrics() []APIMetric {
+	apiMetrics.Lock()
+	defer apiMetrics.Unlock()
+
+	metrics := make([]APIMetric, 0, len(apiMetrics.values))
+	for key, value := range apiMetrics.values {
+		latencies := append([]time.Duration(nil), value.latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		var total time.Duration
+		for _, latency := range latencies {
+			total += latency
+		}
+
+		metrics = append(metrics, APIMetric{
+			Product:      key.Product,
+			Action:       key.Action,
+			Region:       key.Region,
+			ResourceType: key.ResourceType,
+			Count:        len(latencies),
+			Errors:       value.errors,
+			Throttles:    value.throttles,
+			Retries:      value.retries,
+			TotalMs:      milliseconds(total),
+			P50Ms:        milliseconds(percentile(latencies, 50)),
+			P95Ms:        milliseconds(percentile(latencies, 95)),
+		})
+	}
+
+	sort.Slice(metrics, func(i, j int) bool {
+		if metrics[i].TotalMs != metrics[j].TotalMs {
+			return metrics[i].TotalMs > metrics[j].TotalMs
+		}
+		return metrics[i].Count > metrics[j].Count
+	})
+	return metrics
+}
+
+// percentile returns the nearest-rank percentile p of the sorted latencies.
+func percentile(latencies []time.Duration, p int) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	rank := (p*len(latencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return latencies[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// FlushAPIMetrics logs the summary of the API calls, and writes their
+// metrics to the file of API_METRICS_FILE if set. It is called when the
+// provider stops, and does nothing if no API is called, so that the
+// provider processes only serving the schema never overwrite the file.
+func FlushAPIMetrics() error {
+	metrics := APIMetrics()
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	logAPIMetrics(metrics)
+
+	path := os.Getenv(API_METRICS_FILE)
+	if path == "" {
+		return nil
+	}
+
+	format := os.Getenv(API_METRICS_FORMAT)
+	if format == "" {
+		format = APIMetricsFormatJSON
+	}
+	if format != APIMetricsFormatJSON && format != APIMetricsFormatOpenMetrics {
+		return fmt.Errorf("invalid %s %q, it must be %s or %s", API_METRICS_FORMAT, format, APIMetricsFormatJSON, APIMetricsFormatOpenMetrics)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create API metrics directory failed: %v", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create API metrics file failed: %v", err)
+	}
+	defer file.Close()
+
+	if format == APIMetricsFormatOpenMetrics {
+		err = writeOpenMetrics(file, metrics)
+	} else {
+		err = writeJSONMetrics(file, metrics)
+	}
+	if err != nil {
+		return fmt.Errorf("write API metrics file failed: %v", err)
+	}
+	return file.Close()
+}
+
+// logAPIMetrics writes the totals and the slowest API calls of metrics to
+// the provider log.
+func logAPIMetrics(metrics []APIMetric) {
+	var count, throttles, retries int
+	for _, metric := range metrics {
+		count += metric.Count
+		throttles += metric.Throttles
+		retries += metric.Retries
+	}
+	log.Printf("[INFO] tencentcloud API calls: %d calls, %d throttles, %d retries", count, throttles, retries)
+
+	for i, metric := range metrics {
+		if i == apiMetricsSummaryLimit {
+			log.Printf("[INFO] tencentcloud API calls: %d more actions, see %s", len(metrics)-i, API_METRICS_FILE)
+			break
+		}
+		resourceType := metric.ResourceType
+		if resourceType == "" {
+			resourceType = "-"
+		}
+		log.Printf("[INFO] tencentcloud API calls: %s.%s region=%s resource=%s count=%d total=%.0fms p50=%.0fms p95=%.0fms throttles=%d retries=%d",
+			metric.Product, metric.Action, metric.Region, resourceType, metric.Count, metric.TotalMs, metric.P50Ms, metric.P95Ms, metric.Throttles, metric.Retries)
+	}
+}
+
+func writeJSONMetrics(w io.Writer, metrics []APIMetric) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Calls []APIMetric `json:"calls"`
+	}{metrics})
+}
+
+// writeOpenMetrics writes metrics in the OpenMetrics text format, with the
+// latencies as a summary in seconds.
+func writeOpenMetrics(w io.Writer, metrics []APIMetric) error {
+	var b strings.Builder
+
+	counters := []struct {
+		name, help string
+		value      func(APIMetric) int
+	}{
+		{"tencentcloud_api_calls", "The number of TencentCloud API calls.", func(m APIMetric) int { return m.Count }},
+		{"tencentcloud_api_errors", "The number of TencentCloud API calls which failed.", func(m APIMetric) int { return m.Errors }},
+		{"tencentcloud_api_throttles", "The number of TencentCloud API calls rejected by the rate limit.", func(m APIMetric) int { return m.Throttles }},
+		{"tencentcloud_api_retries", "The number of retried TencentCloud API calls.", func(m APIMetric) int { return m.Retries }},
+	}
+	for _, counter := range counters {
+		fmt.Fprintf(&b, "# TYPE %s counter\n# HELP %s %s\n", counter.name, counter.name, counter.help)
+		for _, metric := range metrics {
+			fmt.Fprintf(&b, "%s_total{%s} %d\n", counter.name, openMetricsLabels(metric), counter.value(metric))
+		}
+	}
+
+	b.WriteString("# TYPE tencentcloud_api_latency_seconds summary\n")
+	b.WriteString("# UNIT tencentcloud_api_latency_seconds seconds\n")
+	b.WriteString("# HELP tencentcloud_api_latency_seconds The latency of TencentCloud API calls.\n")
+	for _, metric := range metrics {
+		labels := openMetricsLabels(metric)
+		fmt.Fprintf(&b, "tencentcloud_api_latency_seconds{%s,quantile=\"0.5\"} %g\n", labels, metric.P50Ms/1000)
+		fmt.Fprintf(&b, "tencentcloud_api_latency_seconds{%s,quantile=\"0.95\"} %g\n", labels, metric.P95Ms/1000)
+		fmt.Fprintf(&b, "tencentcloud_api_latency_seconds_sum{%s} %g\n", labels, metric.TotalMs/1000)
+		fmt.Fprintf(&b, "tencentcloud_api_latency_seconds_count{%s} %d\n", labels, metric.Count)
+	}
+	b.WriteString("# EOF\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var openMetricsEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func openMetricsLabels(metric APIMetric) string {
+	return fmt.Sprintf(`product="%s",action="%s",region="%s",resource_type="%s"`,
+		openMetricsEscaper.Replace(metric.Product),
+		openMetricsEscaper.Replace(metric.Action),
+		openMetricsEscaper.Replace(metric.Region),
+		openMetricsEscaper.Replace(metric.ResourceType))
+}
@@ -0,0 +1,174 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func resetAPIMetrics() {
+	apiMetrics.Lock()
+	defer apiMetrics.Unlock()
+
+	apiMetrics.values = make(map[apiMetricKey]*apiMetricValue)
+}
+
+func TestAPIMetrics(t *testing.T) {
+	resetAPIMetrics()
+	defer resetAPIMetrics()
+
+	for i := 1; i <= 20; i++ {
+		recordAPIMetric("tencentcloud_vpc", &apiLogRecord{Product: "vpc", Action: "DescribeVpcs", Region: "ap-guangzhou", latency: time.Duration(i) * time.Millisecond})
+	}
+	recordAPIMetric("tencentcloud_vpc", &apiLogRecord{Product: "vpc", Action: "DescribeVpcs", Region: "ap-guangzhou", RetryCount: 1, ErrorCode: "RequestLimitExceeded", latency: time.Second})
+	recordAPIMetric("", &apiLogRecord{Product: "sts", Action: "GetCallerIdentity", Region: "ap-guangzhou", latency: time.Millisecond})
+
+	metrics := APIMetrics()
+	assert.Equal(t, []APIMetric{
+		{
+			Product:      "vpc",
+			Action:       "DescribeVpcs",
+			Region:       "ap-guangzhou",
+			ResourceType: "tencentcloud_vpc",
+			Count:        21,
+			Errors:       1,
+			Throttles:    1,
+			Retries:      1,
+			TotalMs:      1210,
+			P50Ms:        11,
+			P95Ms:        20,
+		},
+		{
+			Product: "sts",
+			Action:  "GetCallerIdentity",
+			Region:  "ap-guangzhou",
+			Count:   1,
+			TotalMs: 1,
+			P50Ms:   1,
+			P95Ms:   1,
+		},
+	}, metrics)
+}
+
+func TestAPIMetricsResourceType(t *testing.T) {
+	resetAPIMetrics()
+	defer resetAPIMetrics()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"vpc": server.URL},
+	}
+
+	_, err := client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	_, err = client.WithResourceType("tencentcloud_vpc").UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+	_, err = client.WithResourceType("tencentcloud_vpc").UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	assert.NoError(t, err)
+
+	counts := make(map[string]int)
+	for _, metric := range APIMetrics() {
+		assert.Equal(t, "vpc", metric.Product)
+		assert.Equal(t, "DescribeVpcs", metric.Action)
+		counts[metric.ResourceType] = metric.Count
+	}
+	assert.Equal(t, map[string]int{"": 1, "tencentcloud_vpc": 2}, counts)
+}
+
+func TestAPIMetricsRetries(t *testing.T) {
+	resetAPIMetrics()
+	defer resetAPIMetrics()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"Response":{"Error":{"Code":"RequestLimitExceeded","Message":"slow down"},"RequestId":"req-1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"req-2"}}`))
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("AKIDtest", "test"),
+		Region:     "ap-guangzhou",
+		Endpoints:  map[string]string{"vpc": server.URL},
+	}
+
+	// the request is sent again after the failure, as resource.Retry does
+	request := vpc.NewDescribeVpcsRequest()
+	request.VpcIds = common.StringPtrs([]string{"vpc-retries"})
+	_, err := client.UseVpcClient().DescribeVpcs(request)
+	assert.Error(t, err)
+	_, err = client.UseVpcClient().DescribeVpcs(request)
+	assert.NoError(t, err)
+
+	metrics := APIMetrics()
+	if assert.Len(t, metrics, 1) {
+		assert.Equal(t, 2, metrics[0].Count)
+		assert.Equal(t, 1, metrics[0].Throttles)
+		assert.Equal(t, 1, metrics[0].Retries)
+	}
+}
+
+func TestFlushAPIMetrics(t *testing.T) {
+	resetAPIMetrics()
+	defer resetAPIMetrics()
+
+	path := filepath.Join(t.TempDir(), "metrics", "api.json")
+	t.Setenv(API_METRICS_FILE, path)
+
+	assert.NoError(t, FlushAPIMetrics())
+	assert.NoFileExists(t, path)
+
+	recordAPIMetric("tencentcloud_instance", &apiLogRecord{Product: "cvm", Action: "DescribeInstances", Region: "ap-guangzhou", latency: 250 * time.Millisecond})
+
+	assert.NoError(t, FlushAPIMetrics())
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var result struct {
+		Calls []APIMetric `json:"calls"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &result))
+	assert.Equal(t, []APIMetric{{
+		Product:      "cvm",
+		Action:       "DescribeInstances",
+		Region:       "ap-guangzhou",
+		ResourceType: "tencentcloud_instance",
+		Count:        1,
+		TotalMs:      250,
+		P50Ms:        250,
+		P95Ms:        250,
+	}}, result.Calls)
+
+	t.Setenv(API_METRICS_FORMAT, APIMetricsFormatOpenMetrics)
+	assert.NoError(t, FlushAPIMetrics())
+	content, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	labels := `product="cvm",action="DescribeInstances",region="ap-guangzhou",resource_type="tencentcloud_instance"`
+	assert.Contains(t, string(content), "# TYPE tencentcloud_api_calls counter\n")
+	assert.Contains(t, string(content), "tencentcloud_api_calls_total{"+labels+"} 1\n")
+	assert.Contains(t, string(content), "tencentcloud_api_throttles_total{"+labels+"} 0\n")
+	assert.Contains(t, string(content), "tencentcloud_api_latency_seconds{"+labels+`,quantile="0.95"} 0.25`+"\n")
+	assert.True(t, strings.HasSuffix(string(content), "# EOF\n"))
+
+	t.Setenv(API_METRICS_FORMAT, "csv")
+	assert.Error(t, FlushAPIMetrics())
+}
@@ -0,0 +1,159 @@
+package connectivity
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRetryBaseBackoff = time.Second
+	DefaultRetryMaxBackoff  = 10 * time.Second
+)
+
+// RetryPolicy is the retry policy configured by the provider retry block, see
+// TencentCloudClient.RetryPolicy.
+// The backoff and the number of attempts only apply to the retries of
+// tccommon.RetryContext, which tccommon.RetryWithContext and the framework
+// helper.RetryFramework use, when their context carries the policy, see
+// TencentCloudClient.RetryPolicyContext. The retries of resource.Retry and
+// resource.RetryContext keep their polling interval, only
+// RetryableErrorCodes applies to them through tccommon.RetryError, see
+// FailedCallRetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, 0 means retrying until
+	// the timeout expires.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry, it doubles on every
+	// retry up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Jitter is the fraction of each backoff that is randomized, between 0 and 1.
+	Jitter float64
+	// RetryableErrorCodes are retryable error codes in addition to the
+	// built-in ones, keyed by the service of the API endpoint, e.g. `cvm` or
+	// `postgres`, see tccommon.CallerProduct.
+	RetryableErrorCodes map[string][]string
+}
+
+// Backoff returns the wait before retrying after the given attempt.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	base, maxBackoff := p.BaseBackoff, p.MaxBackoff
+	if base <= 0 {
+		base = DefaultRetryBaseBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+
+	backoff := base
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	if p.Jitter > 0 {
+		backoff -= time.Duration(rand.Float64() * p.Jitter * float64(backoff))
+	}
+	return backoff
+}
+
+type retryPolicyContextKey struct{}
+
+// RetryPolicyContext returns a copy of ctx carrying the RetryPolicy of me, see
+// RetryPolicyFromContext.
+func (me *TencentCloudClient) RetryPolicyContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, retryPolicyContextKey{}, me.RetryPolicy)
+}
+
+// RetryPolicyFromContext returns the retry policy carried by ctx, or nil.
+func RetryPolicyFromContext(ctx context.Context) *RetryPolicy {
+	if ctx == nil {
+		return nil
+	}
+	policy, _ := ctx.Value(retryPolicyContextKey{}).(*RetryPolicy)
+	return policy
+}
+
+// failedCallPolicies are the retry policies of the clients which made the
+// failed API and COS calls, by request ID, see FailedCallRetryPolicy. They
+// are bounded and forgotten like failedRequests.
+var failedCallPolicies = struct {
+	sync.Mutex
+	values map[string]failedCallPolicy
+}{values: make(map[string]failedCallPolicy)}
+
+type failedCallPolicy struct {
+	policy   *RetryPolicy
+	failedAt time.Time
+}
+
+// recordFailedCall records that the call of requestId made by a client with
+// policy failed.
+func recordFailedCall(requestId string, policy *RetryPolicy) {
+	if requestId == "" || policy == nil {
+		return
+	}
+
+	failedCallPolicies.Lock()
+	defer failedCallPolicies.Unlock()
+
+	now := time.Now()
+	if len(failedCallPolicies.values) >= maxFailedRequests {
+		var oldest string
+		for key, value := range failedCallPolicies.values {
+			if now.Sub(value.failedAt) > failedRequestTTL {
+				delete(failedCallPolicies.values, key)
+				continue
+			}
+			if oldest == "" || value.failedAt.Before(failedCallPolicies.values[oldest].failedAt) {
+				oldest = key
+			}
+		}
+		if len(failedCallPolicies.values) >= maxFailedRequests {
+			delete(failedCallPolicies.values, oldest)
+		}
+	}
+	failedCallPolicies.values[requestId] = failedCallPolicy{policy: policy, failedAt: now}
+}
+
+// FailedCallRetryPolicy returns the retry policy of the client which made the
+// failed call of requestId, or nil when the client has no retry policy. The
+// error of a call only carries its request ID, this is how the policy of the
+// provider configuration which made the call is found by tccommon.RetryError.
+func FailedCallRetryPolicy(requestId string) *RetryPolicy {
+	if requestId == "" {
+		return nil
+	}
+
+	failedCallPolicies.Lock()
+	defer failedCallPolicies.Unlock()
+
+	failed, ok := failedCallPolicies.values[requestId]
+	if !ok || time.Since(failed.failedAt) > failedRequestTTL {
+		return nil
+	}
+	return failed.policy
+}
+
+// cosRetryPolicyTransport records the failed COS calls sent through Transport
+// by a client with Policy, see FailedCallRetryPolicy.
+type cosRetryPolicyTransport struct {
+	Policy    *RetryPolicy
+	Transport http.RoundTripper
+}
+
+func (t *cosRetryPolicyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.Transport.RoundTrip(request)
+	if err == nil && response.StatusCode >= http.StatusBadRequest {
+		recordFailedCall(response.Header.Get("X-Cos-Request-Id"), t.Policy)
+	}
+	return response, err
+}
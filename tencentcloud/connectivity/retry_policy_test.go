@@ -0,0 +1,96 @@
+package connectivity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(5))
+	assert.Equal(t, time.Second, policy.Backoff(100))
+
+	policy = &RetryPolicy{}
+	assert.Equal(t, DefaultRetryBaseBackoff, policy.Backoff(1))
+	assert.Equal(t, DefaultRetryMaxBackoff, policy.Backoff(100))
+
+	policy = &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		backoff := policy.Backoff(1)
+		assert.True(t, backoff > 500*time.Millisecond && backoff <= time.Second, backoff)
+	}
+}
+
+func TestRetryPolicyContext(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3}
+
+	assert.Nil(t, RetryPolicyFromContext(nil))
+	assert.Nil(t, RetryPolicyFromContext(context.Background()))
+	assert.Same(t, policy, RetryPolicyFromContext((&TencentCloudClient{RetryPolicy: policy}).RetryPolicyContext(context.Background())))
+	assert.Nil(t, RetryPolicyFromContext((&TencentCloudClient{}).RetryPolicyContext(context.Background())))
+}
+
+func TestFailedCallRetryPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the request ID of a failed call is its action
+		requestId := r.Header.Get("X-TC-Action")
+		if requestId == "DescribeOk" {
+			_, _ = w.Write([]byte(`{"Response":{"RequestId":"req-ok"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Response":{"RequestId":"` + requestId + `","Error":{"Code":"FailedOperation","Message":"busy"}}}`))
+	}))
+	defer server.Close()
+
+	send := func(client *TencentCloudClient, action string) {
+		request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+		assert.NoError(t, err)
+		request.Header.Set("X-TC-Action", action)
+		response, err := client.NewLogRoundTripper("cvm").RoundTrip(request)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	// every provider configuration keeps its own policy
+	policy := &RetryPolicy{RetryableErrorCodes: map[string][]string{"cvm": {"FailedOperation"}}}
+	send(&TencentCloudClient{RetryPolicy: policy}, "DescribeWithPolicy")
+	send(&TencentCloudClient{}, "DescribeWithoutPolicy")
+	send(&TencentCloudClient{RetryPolicy: policy}, "DescribeOk")
+
+	assert.Same(t, policy, FailedCallRetryPolicy("DescribeWithPolicy"))
+	assert.Nil(t, FailedCallRetryPolicy("DescribeWithoutPolicy"))
+	assert.Nil(t, FailedCallRetryPolicy("req-ok"))
+	assert.Nil(t, FailedCallRetryPolicy(""))
+}
+
+func TestCosRetryPolicyTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Cos-Request-Id", "cos"+r.URL.Path)
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusConflict)
+		}
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{RetryableErrorCodes: map[string][]string{"cos": {"OperationConflict"}}}
+	client := &http.Client{Transport: (&TencentCloudClient{RetryPolicy: policy}).httpTransport()}
+	for _, path := range []string{"/ok", "/conflict"} {
+		response, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	assert.Same(t, policy, FailedCallRetryPolicy("cos/conflict"))
+	assert.Nil(t, FailedCallRetryPolicy("cos/ok"))
+	assert.Equal(t, defaultTransport, (&TencentCloudClient{}).httpTransport())
+}
@@ -2,6 +2,7 @@ package connectivity
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,13 +10,38 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const REQUEST_CLIENT = "TENCENTCLOUD_API_REQUEST_CLIENT"
 const ENV_TESTING_ROUTE_USER_ID = "TENCENTCLOUD_ENV_TESTING_USER_ID"
 const ENV_TESTING_ROUTE_HEADER_KEY = "x-qcloud-user-id"
 
+// failedRequestTTL is how long a failed request is remembered by
+// failedRequests, which is longer than the interval between the attempts
+// of resource.Retry and the provider retry policy.
+const failedRequestTTL = 5 * time.Minute
+
+// maxFailedRequests bounds failedRequests, the request which failed first is
+// forgotten when another one fails and the map is full.
+const maxFailedRequests = 1024
+
+// failedRequests are the API requests whose last call failed, by
+// requestFingerprint, see nextRetryCount.
+var failedRequests = struct {
+	sync.Mutex
+	values map[[sha256.Size]byte]failedRequest
+}{values: make(map[[sha256.Size]byte]failedRequest)}
+
+type failedRequest struct {
+	// attempts is the number of calls of the request which failed in a row.
+	attempts int
+	failedAt time.Time
+}
+
 var ReqClient = "Terraform-latest"
 
 func SetReqClient(name string) {
@@ -28,6 +54,19 @@ func SetReqClient(name string) {
 type LogRoundTripper struct {
 	InstanceId    string
 	Authorization string
+	// Product is the service the requests are sent to, e.g. `vpc`, which is
+	// not always the host of the endpoint.
+	Product string
+	// RateLimiter limits the requests, they are not limited if it is nil.
+	RateLimiter *ratelimit.Limiter
+	// RetryPolicy is recorded for the failed requests, see
+	// FailedCallRetryPolicy.
+	RetryPolicy *RetryPolicy
+	// ResourceType is the resource type the API calls are counted for in the
+	// API metrics, see FlushAPIMetrics.
+	ResourceType string
+	// Transport sends the requests, the default transport if nil.
+	Transport http.RoundTripper
 }
 
 type IacExtInfo struct {
@@ -44,21 +83,38 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 
 	var start = time.Now()
 
-	defer func() { me.log(inBytes, outBytes, errRet, start) }()
+	record := &apiLogRecord{
+		Product: me.requestProduct(request),
+		Action:  request.Header.Get("X-TC-Action"),
+		Region:  request.Header.Get("X-TC-Region"),
+		Host:    request.Host,
+	}
+
+	var fingerprint [sha256.Size]byte
+	defer func() {
+		if inBytes != nil {
+			recordRequestResult(fingerprint, record.RetryCount, errRet != nil || record.ErrorCode != "")
+		}
+		if record.ErrorCode != "" {
+			recordFailedCall(record.RequestId, me.RetryPolicy)
+		}
+		me.log(record, inBytes, outBytes, errRet, start)
+		recordAPIMetric(me.ResourceType, record)
+	}()
 
 	bodyReader, errRet := request.GetBody()
 	if errRet != nil {
 		return
 	}
 
-	var headName = "X-Tc-Action"
-
 	if envReqClient := os.Getenv(REQUEST_CLIENT); envReqClient != "" {
 		ReqClient = envReqClient
 	}
 
+	// the headers are set on a clone, a RoundTripper must not modify request
+	outRequest := request.Clone(request.Context())
 	if routeUserID := os.Getenv(ENV_TESTING_ROUTE_USER_ID); routeUserID != "" {
-		request.Header.Set(ENV_TESTING_ROUTE_HEADER_KEY, routeUserID)
+		outRequest.Header.Set(ENV_TESTING_ROUTE_HEADER_KEY, routeUserID)
 	}
 
 	var reqClientFormat = ReqClient
@@ -67,26 +123,45 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 	}
 
 	if me.Authorization != "" {
-		request.Header.Set("Authorization", me.Authorization)
+		outRequest.Header.Set("Authorization", me.Authorization)
+	}
+
+	outRequest.Header.Set("X-TC-RequestClient", reqClientFormat)
+	inBytes, errRet = io.ReadAll(bodyReader)
+	if errRet != nil {
+		return
 	}
+	fingerprint = requestFingerprint(record, inBytes)
+	record.RetryCount = nextRetryCount(fingerprint)
 
-	request.Header.Set("X-TC-RequestClient", reqClientFormat)
-	inBytes = []byte(fmt.Sprintf("%s, request: ", request.Header[headName]))
-	requestBody, errRet := io.ReadAll(bodyReader)
+	cassette, errRet := currentVCRCassette()
 	if errRet != nil {
 		return
 	}
 
-	inBytes = append(inBytes, requestBody...)
-	headName = "X-Tc-Region"
-	appendMessage := []byte(fmt.Sprintf(
-		", (host %+v, region:%+v)",
-		request.Host,
-		request.Header[headName],
-	))
+	var interaction *vcrInteraction
+	if cassette != nil {
+		interaction = newVCRInteraction(record, inBytes)
+		if cassette.mode == VCRModeReplay {
+			response, outBytes, errRet = cassette.replay(request, interaction)
+			if errRet == nil {
+				record.RequestId, record.ErrorCode = parseResponseMeta(outBytes)
+			}
+			return
+		}
+	}
+
+	if me.RateLimiter != nil {
+		if errRet = me.RateLimiter.Wait(request.Context(), record.Product, record.Action); errRet != nil {
+			return
+		}
+	}
 
-	inBytes = append(inBytes, appendMessage...)
-	response, errRet = http.DefaultTransport.RoundTrip(request)
+	transport := me.Transport
+	if transport == nil {
+		transport = defaultTransport
+	}
+	response, errRet = transport.RoundTrip(outRequest)
 	if errRet != nil {
 		return
 	}
@@ -96,49 +171,174 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 		return
 	}
 
+	record.RequestId, record.ErrorCode = parseResponseMeta(outBytes)
+	if strings.HasPrefix(record.ErrorCode, "RequestLimitExceeded") && me.RateLimiter != nil {
+		me.RateLimiter.Throttle(record.Product, record.Action)
+	}
+
+	if cassette != nil {
+		if err := cassette.record(interaction, response, outBytes); err != nil {
+			log.Printf("[CRITICAL] record %s.%s to VCR cassette %s failed: %v", record.Product, record.Action, cassette.path, err)
+		}
+	}
+
 	response.Body = io.NopCloser(bytes.NewBuffer(outBytes))
 	return
 }
 
-func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time) {
-	var buf bytes.Buffer
-	buf.WriteString("######")
-	tag := "[DEBUG]"
-	if err != nil {
-		tag = "[CRITICAL]"
+// requestProduct returns the product of an API request, which is the service
+// of the TC3 credential scope, or else Product. The host is not used, as the
+// endpoint of a service can be set to any host, see
+// TencentCloudClient.Endpoints.
+func (me *LogRoundTripper) requestProduct(request *http.Request) string {
+	if _, scope, found := strings.Cut(request.Header.Get("Authorization"), "Credential="); found {
+		scope, _, _ = strings.Cut(scope, ",")
+		if parts := strings.Split(scope, "/"); len(parts) == 4 {
+			return parts[2]
+		}
 	}
+	return me.Product
+}
+
+// requestFingerprint identifies the calls of the same request, which are the
+// calls of an action in a region with the same body.
+func requestFingerprint(record *apiLogRecord, body []byte) [sha256.Size]byte {
+	hash := sha256.New()
+	for _, field := range []string{record.Product, record.Action, record.Region} {
+		hash.Write([]byte(field))
+		hash.Write([]byte{0})
+	}
+	hash.Write(body)
 
-	buf.WriteString(tag)
-	if len(in) > 0 {
-		buf.WriteString("tencentcloud-sdk-go: ")
-		buf.Write(in)
-	}
-
-	if len(out) > 0 {
-		buf.WriteString("; response:")
-		err := json.Compact(&buf, out)
-		if err != nil {
-			out := bytes.Replace(out,
-				[]byte("\n"),
-				[]byte(""),
-				-1)
-			out = bytes.Replace(out,
-				[]byte(" "),
-				[]byte(""),
-				-1)
-			buf.Write(out)
+	var fingerprint [sha256.Size]byte
+	hash.Sum(fingerprint[:0])
+	return fingerprint
+}
+
+// nextRetryCount returns how many times the request of fingerprint failed
+// right before this call. The retries are made by resource.Retry and
+// tccommon.RetryWithContext, which call the action again with the same
+// request after it failed, and not by the SDK, and they do not tell the
+// transport about the attempt. The count is a heuristic: a call is a retry
+// when the previous call of the same request failed less than
+// failedRequestTTL ago, whoever made it. The same request sent by two
+// resources, e.g. a Describe of the same ID, after one of them failed, or a
+// poll of a request which keeps failing until a resource is ready, is
+// counted as a retry as well.
+func nextRetryCount(fingerprint [sha256.Size]byte) int {
+	failedRequests.Lock()
+	defer failedRequests.Unlock()
+
+	failed, ok := failedRequests.values[fingerprint]
+	if !ok || time.Since(failed.failedAt) > failedRequestTTL {
+		return 0
+	}
+	return failed.attempts
+}
+
+// recordRequestResult records whether the call of the request of fingerprint,
+// which was retried retryCount times, failed, see nextRetryCount.
+func recordRequestResult(fingerprint [sha256.Size]byte, retryCount int, failed bool) {
+	failedRequests.Lock()
+	defer failedRequests.Unlock()
+
+	if !failed {
+		delete(failedRequests.values, fingerprint)
+		return
+	}
+
+	now := time.Now()
+	if _, ok := failedRequests.values[fingerprint]; !ok && len(failedRequests.values) >= maxFailedRequests {
+		evictFailedRequests(now)
+	}
+	failedRequests.values[fingerprint] = failedRequest{attempts: retryCount + 1, failedAt: now}
+}
+
+// evictFailedRequests forgets the requests which failed more than
+// failedRequestTTL ago, or else the one which failed first, so that a
+// request can be added to the full failedRequests. The caller holds the lock.
+func evictFailedRequests(now time.Time) {
+	var oldest [sha256.Size]byte
+	var oldestAt time.Time
+	for key, value := range failedRequests.values {
+		if now.Sub(value.failedAt) > failedRequestTTL {
+			delete(failedRequests.values, key)
+			continue
+		}
+		if oldestAt.IsZero() || value.failedAt.Before(oldestAt) {
+			oldest, oldestAt = key, value.failedAt
 		}
 	}
+	if len(failedRequests.values) >= maxFailedRequests {
+		delete(failedRequests.values, oldest)
+	}
+}
+
+// parseResponseMeta returns the request ID and the error code of an API
+// response, the error code is empty when the request succeeded. Only the
+// responses with an error are decoded, the request ID of the others is
+// looked up, see responseRequestId.
+func parseResponseMeta(body []byte) (requestId, errorCode string) {
+	if !bytes.Contains(body, []byte(`"Error"`)) {
+		return responseRequestId(body), ""
+	}
 
+	var result struct {
+		Response struct {
+			RequestId string
+			Error     struct {
+				Code string
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", ""
+	}
+	return result.Response.RequestId, result.Response.Error.Code
+}
+
+// responseRequestId returns the RequestId of an API response without decoding
+// it, the RequestId is the last field of the responses.
+func responseRequestId(body []byte) string {
+	key := []byte(`"RequestId"`)
+	index := bytes.LastIndex(body, key)
+	if index < 0 {
+		return ""
+	}
+
+	value := bytes.TrimLeft(body[index+len(key):], " \t\r\n")
+	value = bytes.TrimLeft(bytes.TrimPrefix(value, []byte(":")), " \t\r\n")
+	if !bytes.HasPrefix(value, []byte(`"`)) {
+		return ""
+	}
+	end := bytes.IndexByte(value[1:], '"')
+	if end < 0 {
+		return ""
+	}
+	return string(value[1 : end+1])
+}
+
+// log writes record as a JSON line to the provider log, which terraform
+// shows with TF_LOG=DEBUG. Sensitive values of the request and the response
+// are redacted, see redactDenyKeys.
+func (me *LogRoundTripper) log(record *apiLogRecord, in []byte, out []byte, err error, start time.Time) {
+	tag := "[DEBUG]"
 	if err != nil {
-		buf.WriteString("; error:")
-		buf.WriteString(err.Error())
+		tag = "[CRITICAL]"
+		record.Error = err.Error()
 	}
 
-	costFormat := fmt.Sprintf(",cost %s", time.Since(start).String())
-	buf.WriteString(costFormat)
+	record.setLatency(time.Since(start))
+	record.Request = redactBody(in)
+	record.Response = redactBody(out)
+
+	recordBytes, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		log.Printf("%s tencentcloud-sdk-go: %s.%s, marshal log record failed: %v", tag, record.Product, record.Action, marshalErr)
+		return
+	}
 
-	log.Println(buf.String())
+	log.Printf("%s tencentcloud-sdk-go: %s", tag, recordBytes)
 }
 
 // GetReqClientVersion returns the version of request client, if the version is empty or latest, return dev.
@@ -0,0 +1,271 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// VCR_MODE switches LogRoundTripper to record API calls to, or replay them
+// from, the cassette file set by VCR_CASSETTE. It is either `record` or
+// `replay`, any other value disables it.
+//
+// Responses are recorded as they are received to the raw cassette, see
+// VCRRawCassettePath, which can hold the secrets returned by the API and is
+// never committed. The cassette itself is saved with the responses redacted,
+// see ScrubVCRCassette.
+const VCR_MODE = "TENCENTCLOUD_VCR_MODE"
+const VCR_CASSETTE = "TENCENTCLOUD_VCR_CASSETTE"
+
+const (
+	VCRModeRecord = "record"
+	VCRModeReplay = "replay"
+	// VCRModeScrub is not handled by LogRoundTripper, see ScrubVCRCassette.
+	VCRModeScrub = "scrub"
+)
+
+// vcrIgnoredKeys are the request JSON keys that differ on every run and are
+// ignored when matching a request against the cassette. Signatures and
+// timestamps are sent in headers, which are never matched.
+var vcrIgnoredKeys = map[string]bool{
+	"ClientToken": true,
+}
+
+type vcrInteraction struct {
+	Product    string          `json:"product"`
+	Action     string          `json:"action"`
+	Region     string          `json:"region,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	StatusCode int             `json:"status_code"`
+	Response   json.RawMessage `json:"response"`
+}
+
+func (i *vcrInteraction) key() string {
+	return fmt.Sprintf("%s.%s/%s %s", i.Product, i.Action, i.Region, i.Request)
+}
+
+// vcrCassette holds the API calls recorded in a cassette file. Replaying
+// returns the responses of a request in the recorded order, and keeps
+// returning the last one once they are used up, so that polling a status
+// until it changes is replayed as recorded.
+type vcrCassette struct {
+	mu sync.Mutex
+
+	path         string
+	mode         string
+	Interactions []*vcrInteraction `json:"interactions"`
+	replayed     map[string]int
+}
+
+var (
+	vcrCassettes = make(map[string]*vcrCassette)
+	vcrLocker    sync.Mutex
+)
+
+// VCRRawCassettePath returns the path of the raw cassette of the cassette
+// path, e.g. `testdata/cassettes/TestFoo.raw.json`, which holds the
+// responses as they are received. Raw cassettes are ignored by git.
+func VCRRawCassettePath(path string) string {
+	return strings.TrimSuffix(path, ".json") + ".raw.json"
+}
+
+// currentVCRCassette returns the cassette configured by VCR_MODE and
+// VCR_CASSETTE, or nil when VCR is disabled. A recorded cassette starts
+// empty and overwrites the files, a replayed one is read from the raw
+// cassette, which replays exactly, or else from the redacted cassette.
+func currentVCRCassette() (*vcrCassette, error) {
+	mode, path := os.Getenv(VCR_MODE), os.Getenv(VCR_CASSETTE)
+	if (mode != VCRModeRecord && mode != VCRModeReplay) || path == "" {
+		return nil, nil
+	}
+
+	vcrLocker.Lock()
+	defer vcrLocker.Unlock()
+
+	key := mode + ":" + path
+	if cassette, ok := vcrCassettes[key]; ok {
+		return cassette, nil
+	}
+
+	cassette := &vcrCassette{path: path, mode: mode, replayed: make(map[string]int)}
+	if mode == VCRModeReplay {
+		replayPath := VCRRawCassettePath(path)
+		if _, err := os.Stat(replayPath); err != nil {
+			replayPath = path
+		}
+		content, err := os.ReadFile(replayPath)
+		if err != nil {
+			return nil, fmt.Errorf("read VCR cassette %s failed: %v", replayPath, err)
+		}
+		if err := json.Unmarshal(content, cassette); err != nil {
+			return nil, fmt.Errorf("parse VCR cassette %s failed: %v", replayPath, err)
+		}
+		// the cassette is saved indented, requests are matched compacted
+		for _, interaction := range cassette.Interactions {
+			interaction.Request = compactJSON(interaction.Request)
+			interaction.Response = compactJSON(interaction.Response)
+		}
+	}
+
+	vcrCassettes[key] = cassette
+	return cassette, nil
+}
+
+func newVCRInteraction(record *apiLogRecord, requestBody []byte) *vcrInteraction {
+	return &vcrInteraction{
+		Product: record.Product,
+		Action:  record.Action,
+		Region:  record.Region,
+		Request: vcrRequestBody(requestBody),
+	}
+}
+
+// vcrRequestBody returns the request body as it is matched, which is the
+// compacted JSON without vcrIgnoredKeys and with sensitive values redacted.
+func vcrRequestBody(body []byte) json.RawMessage {
+	var value map[string]interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return redactBody(body)
+	}
+
+	for key := range vcrIgnoredKeys {
+		delete(value, key)
+	}
+
+	content, _ := json.Marshal(value)
+	return redactBody(content)
+}
+
+// replay returns the recorded response of interaction.
+func (c *vcrCassette) replay(request *http.Request, interaction *vcrInteraction) (*http.Response, []byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := interaction.key()
+	var matched []*vcrInteraction
+	for _, item := range c.Interactions {
+		if item.key() == key {
+			matched = append(matched, item)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil, fmt.Errorf("VCR cassette %s has no interaction for %s.%s in %s, request: %s",
+			c.path, interaction.Product, interaction.Action, interaction.Region, interaction.Request)
+	}
+
+	index := c.replayed[key]
+	if index < len(matched)-1 {
+		c.replayed[key] = index + 1
+	} else {
+		index = len(matched) - 1
+	}
+
+	item := matched[index]
+	return &http.Response{
+		Status:        http.StatusText(item.StatusCode),
+		StatusCode:    item.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(item.Response)),
+		ContentLength: int64(len(item.Response)),
+		Request:       request,
+	}, item.Response, nil
+}
+
+// record appends interaction with the response to the cassette and saves it.
+func (c *vcrCassette) record(interaction *vcrInteraction, response *http.Response, responseBody []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interaction.StatusCode = response.StatusCode
+	interaction.Response = compactJSON(responseBody)
+	c.Interactions = append(c.Interactions, interaction)
+
+	return c.save()
+}
+
+// save writes the cassette to the raw cassette, readable by the owner only,
+// and redacted to the cassette file.
+func (c *vcrCassette) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	if err := c.write(VCRRawCassettePath(c.path), 0600); err != nil {
+		return err
+	}
+	return c.scrubbed().write(c.path, 0644)
+}
+
+func (c *vcrCassette) write(path string, perm os.FileMode) error {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	// the mode of an existing file is kept by OpenFile, it is set before the
+	// content is written
+	if err := file.Chmod(perm); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// scrubbed returns a copy of the cassette with the sensitive values of the
+// responses redacted.
+func (c *vcrCassette) scrubbed() *vcrCassette {
+	scrubbed := &vcrCassette{path: c.path, mode: c.mode}
+	for _, interaction := range c.Interactions {
+		item := *interaction
+		item.Response = redactBody(item.Response)
+		scrubbed.Interactions = append(scrubbed.Interactions, &item)
+	}
+	return scrubbed
+}
+
+// ScrubVCRCassette writes the cassette file path from its raw cassette, or
+// from the file itself when there is none, with the sensitive values of the
+// recorded responses redacted as in the debug logs. Requests are always
+// recorded redacted. A test reading a redacted value into its state, e.g. a
+// generated password, replays that value redacted and may fail its checks
+// without the raw cassette.
+func ScrubVCRCassette(path string) error {
+	source := VCRRawCassettePath(path)
+	if _, err := os.Stat(source); err != nil {
+		source = path
+	}
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return fmt.Errorf("read VCR cassette %s failed: %v", source, err)
+	}
+
+	cassette := &vcrCassette{path: path}
+	if err := json.Unmarshal(content, cassette); err != nil {
+		return fmt.Errorf("parse VCR cassette %s failed: %v", source, err)
+	}
+	return cassette.scrubbed().write(path, 0644)
+}
+
+func compactJSON(raw json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
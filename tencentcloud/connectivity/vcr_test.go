@@ -0,0 +1,111 @@
+package connectivity
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func vcrRequest(t *testing.T, url, action, body, signature string) *http.Request {
+	request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	request.Header.Set("X-TC-Action", action)
+	request.Header.Set("X-TC-Region", "ap-guangzhou")
+	request.Header.Set("X-TC-Timestamp", signature)
+	request.Header.Set("Authorization", "TC3-HMAC-SHA256 Signature="+signature)
+	return request
+}
+
+func vcrRoundTrip(t *testing.T, request *http.Request) string {
+	response, err := (&LogRoundTripper{}).RoundTrip(request)
+	if !assert.NoError(t, err) {
+		return ""
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func TestVCRRecordReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = fmt.Fprintf(w, `{"Response":{"RequestId":"req-%d","Status":"%d"}}`, calls, calls)
+	}))
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(VCR_CASSETTE, cassette)
+
+	t.Setenv(VCR_MODE, VCRModeRecord)
+	assert.Equal(t, `{"Response":{"RequestId":"req-1","Status":"1"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "CreateVpc", `{"VpcName":"foo","ClientToken":"a"}`, "1")))
+	assert.Equal(t, `{"Response":{"RequestId":"req-2","Status":"2"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-1"]}`, "2")))
+	assert.Equal(t, `{"Response":{"RequestId":"req-3","Status":"3"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-1"]}`, "3")))
+	server.Close()
+
+	t.Setenv(VCR_MODE, VCRModeReplay)
+	// the signature, the timestamp and the client token differ from the
+	// recorded ones, and the server is gone
+	assert.Equal(t, `{"Response":{"RequestId":"req-1","Status":"1"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "CreateVpc", `{"ClientToken":"b","VpcName":"foo"}`, "4")))
+	assert.Equal(t, `{"Response":{"RequestId":"req-2","Status":"2"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-1"]}`, "5")))
+	assert.Equal(t, `{"Response":{"RequestId":"req-3","Status":"3"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-1"]}`, "6")))
+	// the last response is repeated once the recorded ones are used up
+	assert.Equal(t, `{"Response":{"RequestId":"req-3","Status":"3"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-1"]}`, "7")))
+
+	_, err := (&LogRoundTripper{}).RoundTrip(vcrRequest(t, server.URL, "DescribeVpcs", `{"VpcIds":["vpc-2"]}`, "8"))
+	assert.ErrorContains(t, err, "has no interaction for")
+	assert.Equal(t, 3, calls)
+}
+
+func TestVCRScrub(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"Response":{"RequestId":"req-1","Password":"p@ss"}}`)
+	}))
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(VCR_CASSETTE, cassette)
+
+	t.Setenv(VCR_MODE, VCRModeRecord)
+	vcrRoundTrip(t, vcrRequest(t, server.URL, "CreateAccount", `{"Password":"p@ss"}`, "1"))
+	server.Close()
+
+	// the response is recorded as it is received to the raw cassette only
+	raw, err := os.ReadFile(VCRRawCassettePath(cassette))
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), "p@ss")
+	if info, err := os.Stat(VCRRawCassettePath(cassette)); assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+	content, err := os.ReadFile(cassette)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "p@ss")
+	assert.Contains(t, string(content), "req-1")
+
+	// the raw cassette is replayed exactly
+	t.Setenv(VCR_MODE, VCRModeReplay)
+	assert.Equal(t, `{"Response":{"RequestId":"req-1","Password":"p@ss"}}`,
+		vcrRoundTrip(t, vcrRequest(t, server.URL, "CreateAccount", `{"Password":"p@ss"}`, "2")))
+
+	// an old cassette recorded unredacted is scrubbed in place
+	assert.NoError(t, os.Rename(VCRRawCassettePath(cassette), cassette))
+	assert.NoError(t, ScrubVCRCassette(cassette))
+	content, err = os.ReadFile(cassette)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "p@ss")
+	assert.Contains(t, string(content), "req-1")
+}
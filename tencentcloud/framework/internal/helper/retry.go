@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 )
 
 // RetryableErrorFn is the callback used by RetryFramework to classify an
@@ -37,6 +39,13 @@ type RetryableErrorFn func(err error) bool
 //
 // When err is nil it returns directly; when err is classified as retryable
 // it enters the retry loop, otherwise it terminates immediately.
+//
+// The retry policy of the provider configuration applies as in
+// tccommon.RetryWithContext: the backoff and max attempts of the policy
+// carried by ctx govern the loop, see
+// connectivity.TencentCloudClient.RetryPolicyContext, and the retryable error
+// codes of the calling service package are retryable regardless of the
+// predicate.
 func RetryFramework[T any](
 	ctx context.Context,
 	timeout time.Duration,
@@ -52,13 +61,14 @@ func RetryFramework[T any](
 		out  T
 	)
 
-	retryErr := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+	product := tccommon.CallerProduct(1)
+	retryErr := tccommon.RetryContext(ctx, timeout, func() *resource.RetryError {
 		got, err := fn()
 		if err == nil {
 			out = got
 			return nil
 		}
-		if retryable(err) {
+		if retryable(err) || tccommon.IsRetryableByPolicy(product, err) {
 			return resource.RetryableError(err)
 		}
 		return resource.NonRetryableError(err)
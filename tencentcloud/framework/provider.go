@@ -56,6 +56,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/framework/internal/sdkv2schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sdkv2list"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
 )
 
@@ -146,6 +147,14 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: "The cos domain of the API request, Default is `https://cos.{region}.myqcloud.com`, Other Examples: `https://cluster-123456.cos-cdc.ap-guangzhou.myqcloud.com`.",
 			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The HTTP(S) proxy of all the API and COS requests, e.g. `http://proxy.example.com:3128`. It can also be sourced from the `TENCENTCLOUD_PROXY_URL` environment variable. If not set, the proxy of the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables is used.",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a PEM file of certificate authorities trusted in addition to the ones of the system, e.g. the one of a TLS intercepting proxy. It can also be sourced from the `TENCENTCLOUD_CA_BUNDLE` environment variable.",
+			},
 			"enable_pod_oidc": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Whether to enable pod oidc.",
@@ -158,10 +167,18 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: "The profile name as set in the shared credentials. It can also be sourced from the `TENCENTCLOUD_PROFILE` environment variable. If not set, the default profile created with `tccli configure` will be used.",
 			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "A command printing the credential as JSON with the keys `SecretId`, `SecretKey`, `Token` and `Expiration` in RFC3339 format, e.g. the CLI of a SSO broker. The command is run again to refresh the credential before it expires. It is used when `secret_id` and `secret_key` are not set, and can also be sourced from the `TENCENTCLOUD_CREDENTIAL_PROCESS` environment variable or the `credential_process` of the profile.",
+			},
 			"cam_role_name": schema.StringAttribute{
 				Optional:    true,
 				Description: "The name of the CVM instance CAM role. It can be sourced from the `TENCENTCLOUD_CAM_ROLE_NAME` environment variable.",
 			},
+			"organization_member_uin": schema.StringAttribute{
+				Optional:    true,
+				Description: "The UIN of an organization member account. If provided, terraform will attempt to access the member account by assuming the role of its authorized identity of the Organization service, preferring the preset identity, using the credentials of the organization administrator. It can be sourced from the `TENCENTCLOUD_ORGANIZATION_MEMBER_UIN` environment variable.",
+			},
 			"allowed_account_ids": schema.SetAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
@@ -174,8 +191,8 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.SetNestedBlock{
-				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+			"assume_role": schema.ListNestedBlock{
+				Description: "The `assume_role` blocks. If provided, terraform will attempt to assume these roles in order, each one using the credentials of the previous one, starting with the supplied credentials, the role of `assume_role_with_saml`, `assume_role_with_web_identity` or `enable_pod_oidc`, or the session of `mfa_certification`.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"role_arn": sdkv2schema.StringAttributeWithEnvDefault{
@@ -289,6 +306,41 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 					},
 				},
 			},
+			"default_tags": schema.ListNestedBlock{
+				Description: "The `default_tags` block. If provided, these tags are applied to every resource that supports `tags`. Tags with the same key configured on the resource take precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Key-value map of tags applied to all taggable resources.",
+						},
+					},
+				},
+			},
+			"endpoints": schema.ListNestedBlock{
+				Description: "The `endpoints` block. If provided, the requests of a service are sent to its endpoint instead of the one of `domain` or `cos_domain`, e.g. a private link endpoint.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: endpointsAttributes(),
+				},
+			},
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "The `ignore_tags` block. If provided, the matching tag keys are ignored when reading resource tags and are never deleted on update, so that tags managed outside of terraform do not cause drift.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Tag keys to ignore.",
+						},
+						"key_prefixes": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Tag key prefixes to ignore.",
+						},
+					},
+				},
+			},
 			"mfa_certification": schema.SetNestedBlock{
 				Description: "The `mfa_certification` block. If provided, terraform will attempt to use the provided credentials for MFA authentication.",
 				NestedObject: schema.NestedBlockObject{
@@ -312,10 +364,86 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "The `rate_limit` block. If provided, the requests of this provider configuration wait on a token bucket per action, and the built-in API rate limits are lifted for it. With or without it, the rate of an action is lowered when the API responds with `RequestLimitExceeded`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"qps": schema.Int64Attribute{
+							Optional:    true,
+							Description: "QPS of each action without a product or action specific value. Default is 15.",
+						},
+						"product_qps": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Description: "QPS of each action of a product, keyed by the product name used in the API endpoint, e.g. `vpc` or `cvm`.",
+						},
+						"action_qps": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Description: "QPS of an action, keyed by `product.Action`, e.g. `vpc.DescribeVpcs`.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "The `retry` block. If provided, retryable API errors are retried with an exponential backoff with jitter instead of a fixed polling interval, within the read and write retry timeouts. The backoff and `max_attempts` only apply to the operations retried with the provider retry helpers, the other operations only retry the additional `retryable_error_codes`. Each provider configuration (alias) has its own `retry` block, the configurations without one keep the default retries.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts of a retried operation. Default is 0, which means retrying until the retry timeout expires.",
+						},
+						"base_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "Wait before the first retry, doubled on every following retry. Default is `1s`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "Maximum wait between two retries. Default is `10s`.",
+						},
+						"jitter": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Fraction of each wait that is randomized, between 0 and 1. Default is 0.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"retryable_error_codes": schema.ListNestedBlock{
+							Description: "Error codes retried in addition to the built-in retryable error codes.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"product": schema.StringAttribute{
+										Required:    true,
+										Description: "Product the error codes apply to, which is the service of the API endpoint, the same as in `rate_limit.product_qps`, e.g. `cvm`, `vpc` or `postgres`.",
+									},
+									"codes": schema.SetAttribute{
+										Required:    true,
+										ElementType: types.StringType,
+										Description: "Error codes to retry, e.g. `ResourceInUse` or `FailedOperation.TaskConflict`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// endpointsAttributes returns the attributes of the `endpoints` block, which
+// mirror the SDKv2 provider schema.
+func endpointsAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(connectivity.EndpointServices))
+	for _, service := range connectivity.EndpointServices {
+		attributes[service] = schema.StringAttribute{
+			Optional:    true,
+			Description: connectivity.EndpointDescription(service),
+		}
+	}
+
+	return attributes
+}
+
 // Configure reuses the *connectivity.TencentCloudClient that SDKv2 has
 // already constructed.
 //
@@ -343,6 +471,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	resp.ResourceData = meta
 	resp.DataSourceData = meta
 	resp.EphemeralResourceData = meta
+	resp.ListResourceData = meta
 	resp.ActionData = meta
 }
 
@@ -369,9 +498,31 @@ func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.Ephe
 	return frameworkEphemeralResources()
 }
 
-// ListResources aggregates every framework-side list resource.
-func (p *Provider) ListResources(_ context.Context) []func() list.ListResource {
-	return frameworkListResources()
+// ListResources aggregates every framework-side list resource. List
+// resources of SDKv2 managed resources additionally receive the resource
+// registered in the SDKv2 provider, see sdkv2list.ResourceSetter.
+func (p *Provider) ListResources(ctx context.Context) []func() list.ListResource {
+	factories := frameworkListResources()
+	if p.Primary == nil {
+		return factories
+	}
+
+	result := make([]func() list.ListResource, 0, len(factories))
+	for _, factory := range factories {
+		result = append(result, func() list.ListResource {
+			l := factory()
+			if setter, ok := l.(sdkv2list.ResourceSetter); ok {
+				resp := &resource.MetadataResponse{}
+				l.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "tencentcloud"}, resp)
+				if r, ok := p.Primary.ResourcesMap[resp.TypeName]; ok {
+					setter.SetSDKv2Resource(r)
+				}
+			}
+			return l
+		})
+	}
+
+	return result
 }
 
 // Actions aggregates every framework-side action. Implementations live in
@@ -2,9 +2,12 @@ package framework
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
@@ -172,3 +175,41 @@ func TestFrameworkProvider_ConfigureFailsWithoutSharedMeta(t *testing.T) {
 	// are cumbersome to construct — the acceptance test phase drives them
 	// through a real mux); this test only asserts the precondition.
 }
+
+// TestFrameworkProvider_ListResourcesUseSDKv2Resources verifies that every
+// list resource backed by an SDKv2 managed resource reports the raw schemas
+// of the resource registered in the SDKv2 provider, including the attributes
+// Provider() adds to every resource (e.g. `tags_all`). A mismatch would make
+// terraform reject the list results.
+func TestFrameworkProvider_ListResourcesUseSDKv2Resources(t *testing.T) {
+	ctx := context.Background()
+	primary := tencentcloud.Provider()
+	fwProv := NewProvider(primary).(*Provider)
+
+	for _, factory := range fwProv.ListResources(ctx) {
+		l := factory()
+
+		var metadataResp resource.MetadataResponse
+		l.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "tencentcloud"}, &metadataResp)
+
+		withSchemas, ok := l.(list.ListResourceWithRawV5Schemas)
+		if !ok {
+			continue
+		}
+
+		r, ok := primary.ResourcesMap[metadataResp.TypeName]
+		if !ok {
+			t.Errorf("list resource %q has no SDKv2 resource", metadataResp.TypeName)
+			continue
+		}
+
+		var schemasResp list.RawV5SchemaResponse
+		withSchemas.RawV5Schemas(ctx, list.RawV5SchemaRequest{}, &schemasResp)
+		if schemasResp.ProtoV5IdentitySchema == nil {
+			t.Errorf("list resource %q: SDKv2 resource has no identity", metadataResp.TypeName)
+		}
+		if !reflect.DeepEqual(schemasResp.ProtoV5Schema, r.ProtoSchema(ctx)()) {
+			t.Errorf("list resource %q: schema differs from the SDKv2 provider resource", metadataResp.TypeName)
+		}
+	}
+}
@@ -17,7 +17,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cam"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cdb"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/dts"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/es"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/postgresql"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sqlserver"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/ssm"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sts"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tke"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 )
 
 // resourceFactories lists every framework Resource factory.
@@ -29,18 +40,44 @@ var resourceFactories = []func() resource.Resource{
 var dataSourceFactories = []func() datasource.DataSource{}
 
 // functionFactories lists every framework Function factory.
-var functionFactories = []func() function.Function{}
+var functionFactories = []func() function.Function{
+	cam.NewCamPolicyNormalizeFunction,
+	cam.NewCamPolicyValidateFunction,
+	common.NewBuildResourceIdFunction,
+	common.NewParseQcsArnFunction,
+	common.NewParseResourceIdFunction,
+	common.NewQcsArnFunction,
+}
 
 // ephemeralResourceFactories lists every framework EphemeralResource factory.
 var ephemeralResourceFactories = []func() ephemeral.EphemeralResource{
+	cdb.NewMysqlPasswordEphemeralResource,
+	postgresql.NewPostgresqlPasswordEphemeralResource,
+	sqlserver.NewSqlserverPasswordEphemeralResource,
 	ssm.NewSsmSecretVersionEphemeralResource,
+	sts.NewStsAssumeRoleEphemeralResource,
+	sts.NewStsFederationTokenEphemeralResource,
+	tke.NewKubernetesClusterKubeconfigEphemeralResource,
 }
 
 // listResourceFactories lists every framework ListResource factory.
-var listResourceFactories = []func() list.ListResource{}
+var listResourceFactories = []func() list.ListResource{
+	cvm.NewInstanceListResource,
+	vpc.NewSecurityGroupListResource,
+	vpc.NewSubnetListResource,
+	vpc.NewVpcListResource,
+}
 
 // actionFactories lists every framework Action factory.
-var actionFactories = []func() action.Action{}
+var actionFactories = []func() action.Action{
+	cdb.NewMysqlRestartDbInstancesAction,
+	cvm.NewCvmRebootInstanceAction,
+	cvm.NewCvmStartInstanceAction,
+	cvm.NewCvmStopInstanceAction,
+	dts.NewDtsSyncJobStartAction,
+	dts.NewDtsSyncJobStopAction,
+	es.NewElasticsearchRestartInstanceAction,
+}
 
 // frameworkResources returns every framework Resource factory.
 func frameworkResources() []func() resource.Resource { return resourceFactories }
@@ -0,0 +1,160 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The functions below build CustomizeDiff rules for the cross-field constraints
+// of a resource, so that invalid combinations are reported at plan time instead
+// of by the cloud API. Combine them with customdiff.All. Values are compared in
+// their string form, e.g. `true` for a TypeBool argument, and a rule is skipped
+// while the value it depends on is unknown.
+
+// DiffRequiredWhen requires the arguments to be set in the config when the argument key is
+// one of values. An Optional+Computed argument missing from the config counts as not set,
+// although its planned value is unknown.
+func DiffRequiredWhen(key string, values []string, arguments ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		value, ok := diffValueIn(d, key, values)
+		if !ok {
+			return nil
+		}
+
+		for _, v := range arguments {
+			set, known := diffConfigured(d, v)
+			if known && !set {
+				return fmt.Errorf("argument `%s` is required when `%s` is `%s`", v, key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// DiffConflictsWhen rejects the arguments when the argument key is one of values.
+func DiffConflictsWhen(key string, values []string, arguments ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		value, ok := diffValueIn(d, key, values)
+		if !ok {
+			return nil
+		}
+
+		for _, v := range arguments {
+			if !d.NewValueKnown(v) {
+				continue
+			}
+			if _, set := d.GetOk(v); set {
+				return fmt.Errorf("argument `%s` cannot be set when `%s` is `%s`", v, key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// DiffEnumPerParent restricts the value of the argument key to the values allowed for the
+// value of the argument parent. A parent value missing from allowed is not restricted.
+func DiffEnumPerParent(key, parent string, allowed map[string][]string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !d.NewValueKnown(parent) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		parentValue := fmt.Sprint(d.Get(parent))
+		values, ok := allowed[parentValue]
+		if !ok {
+			return nil
+		}
+
+		v, set := d.GetOk(key)
+		if !set {
+			return nil
+		}
+
+		value := fmt.Sprint(v)
+		for _, item := range values {
+			if item == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("argument `%s` must be one of %v when `%s` is `%s`, got `%s`", key, values, parent, parentValue, value)
+	}
+}
+
+// DiffImmutableAfterCreate rejects the change of the arguments once the resource is created.
+// It is the plan time counterpart of ImmutableArgsChek.
+func DiffImmutableAfterCreate(arguments ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		for _, v := range arguments {
+			if d.HasChange(v) {
+				return fmt.Errorf("argument `%s` cannot be changed", v)
+			}
+		}
+		return nil
+	}
+}
+
+func diffValueIn(d *schema.ResourceDiff, key string, values []string) (string, bool) {
+	if !d.NewValueKnown(key) {
+		return "", false
+	}
+
+	value := fmt.Sprint(d.Get(key))
+	for _, v := range values {
+		if v == value {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// diffConfigured reports whether the argument key is set in the config, and whether that is
+// known yet. It falls back to the planned value when the config is not available.
+func diffConfigured(d *schema.ResourceDiff, key string) (set, known bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		if !d.NewValueKnown(key) {
+			return false, false
+		}
+		_, set = d.GetOk(key)
+		return set, true
+	}
+
+	for _, part := range strings.Split(key, ".") {
+		if !raw.IsKnown() {
+			return false, false
+		}
+		if raw.IsNull() {
+			return false, true
+		}
+
+		var step cty.PathStep = cty.GetAttrStep{Name: part}
+		if index, err := strconv.Atoi(part); err == nil {
+			step = cty.IndexStep{Key: cty.NumberIntVal(int64(index))}
+		}
+		v, err := step.Apply(raw)
+		if err != nil {
+			return false, true
+		}
+		raw = v
+	}
+
+	if !raw.IsWhollyKnown() {
+		return false, false
+	}
+	if raw.IsNull() {
+		return false, true
+	}
+
+	// the zero values of the config are not set either
+	_, set = d.GetOk(key)
+	return set, true
+}
@@ -0,0 +1,133 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/gocty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUnknownValue is the value the SDK uses for the unknown values of a config
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func testCustomDiffResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"charge_type":   {Type: schema.TypeString, Optional: true},
+			"period":        {Type: schema.TypeInt, Optional: true},
+			"spot_price":    {Type: schema.TypeString, Optional: true},
+			"protocol":      {Type: schema.TypeString, Optional: true},
+			"scheduler":     {Type: schema.TypeString, Optional: true},
+			"zone":          {Type: schema.TypeString, Optional: true},
+			"intranet":      {Type: schema.TypeBool, Optional: true},
+			"intranet_zone": {Type: schema.TypeString, Optional: true},
+			"host_id":       {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+		CustomizeDiff: customdiff.All(
+			DiffRequiredWhen("charge_type", []string{"PREPAID"}, "period"),
+			DiffRequiredWhen("charge_type", []string{"CDHPAID"}, "host_id"),
+			DiffRequiredWhen("intranet", []string{"true"}, "intranet_zone"),
+			DiffConflictsWhen("charge_type", []string{"PREPAID", "POSTPAID"}, "spot_price"),
+			DiffEnumPerParent("scheduler", "protocol", map[string][]string{
+				"TCP":  {"WRR", "LEAST_CONN"},
+				"HTTP": {"WRR"},
+			}),
+			DiffImmutableAfterCreate("zone"),
+		),
+	}
+}
+
+// testCustomDiff plans raw over state, which is nil on create. Like terraform, it passes the
+// raw config along with the state.
+func testCustomDiff(state *terraform.InstanceState, raw map[string]interface{}) error {
+	r := testCustomDiffResource()
+	if state == nil {
+		state = &terraform.InstanceState{}
+	} else {
+		state = state.DeepCopy()
+	}
+	state.RawConfig = testRawConfig(r, raw)
+
+	_, err := r.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(raw), nil)
+	return err
+}
+
+func testRawConfig(r *schema.Resource, raw map[string]interface{}) cty.Value {
+	attributes := map[string]cty.Value{}
+	for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		v, ok := raw[name]
+		switch {
+		case !ok:
+			attributes[name] = cty.NullVal(ty)
+		case v == testUnknownValue:
+			attributes[name] = cty.UnknownVal(ty)
+		default:
+			value, err := gocty.ToCtyValue(v, ty)
+			if err != nil {
+				panic(err)
+			}
+			attributes[name] = value
+		}
+	}
+
+	return cty.ObjectVal(attributes)
+}
+
+func TestDiffRequiredWhen(t *testing.T) {
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "PREPAID", "period": 1}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "POSTPAID"}))
+	assert.EqualError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "PREPAID"}),
+		"argument `period` is required when `charge_type` is `PREPAID`")
+
+	// values of other types are compared in their string form
+	assert.EqualError(t, testCustomDiff(nil, map[string]interface{}{"intranet": true}),
+		"argument `intranet_zone` is required when `intranet` is `true`")
+
+	// an unknown value is not checked
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "PREPAID", "period": testUnknownValue}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": testUnknownValue}))
+}
+
+func TestDiffRequiredWhenComputed(t *testing.T) {
+	// an Optional+Computed argument missing from the config is planned unknown, but not set
+	assert.EqualError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "CDHPAID"}),
+		"argument `host_id` is required when `charge_type` is `CDHPAID`")
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "CDHPAID", "host_id": "host-1"}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "CDHPAID", "host_id": testUnknownValue}))
+
+	// the value read into the state does not stand for the config
+	state := &terraform.InstanceState{ID: "ins-1", Attributes: map[string]string{"id": "ins-1", "charge_type": "POSTPAID", "host_id": "host-1"}}
+	assert.NoError(t, testCustomDiff(state, map[string]interface{}{"charge_type": "POSTPAID"}))
+	assert.EqualError(t, testCustomDiff(state, map[string]interface{}{"charge_type": "CDHPAID"}),
+		"argument `host_id` is required when `charge_type` is `CDHPAID`")
+	assert.NoError(t, testCustomDiff(state, map[string]interface{}{"charge_type": "CDHPAID", "host_id": "host-1"}))
+}
+
+func TestDiffConflictsWhen(t *testing.T) {
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "SPOTPAID", "spot_price": "0.5"}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"spot_price": "0.5"}))
+	assert.EqualError(t, testCustomDiff(nil, map[string]interface{}{"charge_type": "POSTPAID", "spot_price": "0.5"}),
+		"argument `spot_price` cannot be set when `charge_type` is `POSTPAID`")
+}
+
+func TestDiffEnumPerParent(t *testing.T) {
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"protocol": "TCP", "scheduler": "LEAST_CONN"}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"protocol": "HTTP"}))
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"protocol": "UDP", "scheduler": "IP_HASH"}))
+	assert.EqualError(t, testCustomDiff(nil, map[string]interface{}{"protocol": "HTTP", "scheduler": "LEAST_CONN"}),
+		"argument `scheduler` must be one of [WRR] when `protocol` is `HTTP`, got `LEAST_CONN`")
+}
+
+func TestDiffImmutableAfterCreate(t *testing.T) {
+	state := &terraform.InstanceState{ID: "ins-1", Attributes: map[string]string{"id": "ins-1", "zone": "ap-guangzhou-3"}}
+
+	assert.NoError(t, testCustomDiff(nil, map[string]interface{}{"zone": "ap-guangzhou-4"}))
+	assert.NoError(t, testCustomDiff(state, map[string]interface{}{"zone": "ap-guangzhou-3"}))
+	assert.EqualError(t, testCustomDiff(state, map[string]interface{}{"zone": "ap-guangzhou-4"}),
+		"argument `zone` cannot be changed")
+}
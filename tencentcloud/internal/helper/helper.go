@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"math/big"
 	"reflect"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -64,8 +66,18 @@ func DataResourceIdHash(id string) string {
 	return fmt.Sprintf("%d", HashString(id))
 }
 
+// GetTags returns the tags of key k. For the top-level `tags` argument, the planned `tags_all`
+// is preferred when present, so that the provider `default_tags` are included.
 func GetTags(d *schema.ResourceData, k string) map[string]string {
 	tags := make(map[string]string)
+	if k == "tags" {
+		if raw, ok := d.GetOk("tags_all"); ok {
+			for k, v := range raw.(map[string]interface{}) {
+				tags[k] = v.(string)
+			}
+			return tags
+		}
+	}
 	if raw, ok := d.GetOk(k); ok {
 		for k, v := range raw.(map[string]interface{}) {
 			tags[k] = v.(string)
@@ -74,12 +86,98 @@ func GetTags(d *schema.ResourceData, k string) map[string]string {
 	return tags
 }
 
+// HasTagsChange reports whether the resource tags, or the provider default tags merged into
+// `tags_all`, have changed.
+func HasTagsChange(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.HasChange("tags_all")
+}
+
+// GetTagsChange works like d.GetChange("tags"), but returns the old and new `tags_all` when
+// the resource has one, so the provider `default_tags` take part in DiffTags.
+func GetTagsChange(d *schema.ResourceData) (interface{}, interface{}) {
+	oldTags, newTags := d.GetChange("tags")
+	oldTagsAll, newTagsAll := d.GetChange("tags_all")
+	if v, ok := oldTagsAll.(map[string]interface{}); ok && len(v) > 0 {
+		oldTags = oldTagsAll
+	}
+
+	if v, ok := newTagsAll.(map[string]interface{}); ok && len(v) > 0 {
+		newTags = newTagsAll
+	}
+
+	return oldTags, newTags
+}
+
 func BuildToken() string {
 	buf := make([]byte, 16)
 	_, _ = rand.Read(buf)
 	return base64.StdEncoding.EncodeToString(buf)
 }
 
+const (
+	passwordLowerChars  = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpperChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumberChars = "0123456789"
+)
+
+// GeneratePassword returns a random password of the given length that contains
+// at least one lowercase letter, one uppercase letter, one number and, when
+// symbols is not empty, one of symbols.
+func GeneratePassword(length int, symbols string) (string, error) {
+	classes := []string{passwordLowerChars, passwordUpperChars, passwordNumberChars}
+	if symbols != "" {
+		classes = append(classes, symbols)
+	}
+	if length < len(classes) {
+		return "", fmt.Errorf("password length must be at least %d, got %d", len(classes), length)
+	}
+
+	all := strings.Join(classes, "")
+	password := make([]byte, length)
+	for i := range password {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = chars[n.Int64()]
+	}
+
+	// move the mandatory characters away from the head of the password
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		j := n.Int64()
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// GetStringWithWriteOnly returns the value of key, or the value of the
+// write-only argument woKey when key is not set. Write-only values are never
+// persisted in state, so they are read from the raw config.
+func GetStringWithWriteOnly(d *schema.ResourceData, key, woKey string) string {
+	if v, ok := d.GetOk(key); ok {
+		return v.(string)
+	}
+
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return ""
+	}
+	v := raw.GetAttr(woKey)
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	return v.AsString()
+}
+
 func BuildUUID() string {
 	return uuid.New().String()
 }
@@ -254,6 +352,20 @@ func ImmutableArgsChek(d *schema.ResourceData, arguments ...string) error {
 	return nil
 }
 
+// ProgressRefreshFunc wraps refresh so that progress is called whenever the polled state
+// changes, e.g. to send the progress events of a framework action while it waits.
+func ProgressRefreshFunc(refresh resource.StateRefreshFunc, progress func(state string)) resource.StateRefreshFunc {
+	last := ""
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err == nil && state != last {
+			last = state
+			progress(state)
+		}
+		return result, state, err
+	}
+}
+
 func IsEmptyStr(s *string) bool {
 	if s == nil {
 		return true
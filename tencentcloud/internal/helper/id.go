@@ -1,9 +1,16 @@
 package helper
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 const connect = "#"
 
+// IdentityIdKey is the identity attribute of resources identified by their plain `id`.
+const IdentityIdKey = "id"
+
 func IdFormat(s ...string) string {
 	return strings.Join(s, connect)
 }
@@ -11,3 +18,30 @@ func IdFormat(s ...string) string {
 func IdParse(s string) []string {
 	return strings.Split(s, connect)
 }
+
+// IdIdentity declares a resource identity made of the resource `id` only, for resources
+// imported with schema.ImportStatePassthroughWithIdentity(IdentityIdKey). The Read function
+// of such a resource must call SetIdIdentity.
+func IdIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				IdentityIdKey: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "ID of the resource.",
+				},
+			}
+		},
+	}
+}
+
+// SetIdIdentity records the resource ID in the identity declared by IdIdentity.
+func SetIdIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	return identity.Set(IdentityIdKey, d.Id())
+}
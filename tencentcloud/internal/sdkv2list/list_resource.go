@@ -0,0 +1,201 @@
+// Package sdkv2list carries the plumbing shared by framework list resources
+// whose managed resource is still implemented with SDKv2 (for example
+// tencentcloud_instance or tencentcloud_vpc).
+//
+// A framework list resource must return results that match the schema and
+// identity schema of its managed resource. For SDKv2 managed resources those
+// schemas are not known to the framework, so Base exposes them through
+// list.ListResourceWithRawV5Schemas and converts the SDKv2 state of every
+// listed resource into the framework result.
+//
+// The managed resource must declare an Identity (see helper.IdIdentity) so
+// that listed results can be imported by identity.
+package sdkv2list
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+// ResourceSetter is implemented by list resources backed by an SDKv2 managed
+// resource. framework.Provider.ListResources hands them the *schema.Resource
+// registered in the SDKv2 provider, so that the raw schemas and the Read
+// function in use are exactly the ones served by mux, including the
+// attributes the SDKv2 provider adds to every resource (e.g. `tags_all`).
+type ResourceSetter interface {
+	SetSDKv2Resource(r *schema.Resource)
+}
+
+// Item is a single resource found by a list resource.
+type Item struct {
+	// Id is the SDKv2 resource ID, also used as the `id` identity attribute.
+	Id string
+	// DisplayName is the human-readable name shown by `terraform query`.
+	DisplayName string
+}
+
+// Base implements Metadata, RawV5Schemas and Configure for a list resource
+// backed by an SDKv2 managed resource. Concrete list resources embed it and
+// only implement ListResourceConfigSchema and List.
+type Base struct {
+	// TypeName is the managed resource type, e.g. "tencentcloud_instance".
+	TypeName string
+	// NewResource builds the SDKv2 managed resource. It is only used when the
+	// provider did not hand over the registered resource via SetSDKv2Resource.
+	NewResource func() *schema.Resource
+
+	resource *schema.Resource
+	meta     *sharedmeta.ProviderMeta
+}
+
+var _ ResourceSetter = &Base{}
+
+// SetSDKv2Resource implements ResourceSetter.
+func (b *Base) SetSDKv2Resource(r *schema.Resource) {
+	b.resource = r
+}
+
+// Resource returns the SDKv2 managed resource listed by this list resource.
+func (b *Base) Resource() *schema.Resource {
+	if b.resource == nil {
+		b.resource = b.NewResource()
+	}
+
+	return b.resource
+}
+
+func (b *Base) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = b.TypeName
+}
+
+// RawV5Schemas exposes the schema and identity schema of the SDKv2 managed
+// resource, which the framework cannot derive on its own.
+func (b *Base) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	r := b.Resource()
+	resp.ProtoV5Schema = r.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.ProtoIdentitySchema(ctx)()
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (b *Base) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	b.meta = meta
+}
+
+// Meta returns the provider meta received in Configure, or nil when the
+// provider has not been configured yet.
+func (b *Base) Meta() *sharedmeta.ProviderMeta {
+	return b.meta
+}
+
+// NotConfiguredDiagnostics is returned by List when the provider has not been
+// configured, e.g. because the SDKv2 provider failed to build the client.
+func NotConfiguredDiagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"TencentCloud provider not configured",
+		"The list resource was called before the provider was configured. "+
+			"Please file an issue if you see this in production.",
+	)
+	return diags
+}
+
+// Results streams one list result per item. The identity is always set;
+// when req.IncludeResource is true the SDKv2 Read function is run to fill the
+// resource state as well, and items that disappeared in the meantime are
+// skipped. The stream stops once req.Limit results have been pushed.
+func (b *Base) Results(ctx context.Context, req list.ListRequest, items []Item) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range items {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			result, ok := b.newResult(ctx, req, item)
+			if !ok {
+				continue
+			}
+
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
+
+func (b *Base) newResult(ctx context.Context, req list.ListRequest, item Item) (list.ListResult, bool) {
+	r := b.Resource()
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.DisplayName
+
+	state := &terraform.InstanceState{
+		ID:         item.Id,
+		Attributes: map[string]string{"id": item.Id},
+		Identity:   map[string]string{helper.IdentityIdKey: item.Id},
+	}
+
+	if req.IncludeResource {
+		newState, diags := r.RefreshWithoutUpgrade(ctx, state, b.meta)
+		if diags.HasError() {
+			for _, d := range diags {
+				if d.Severity == sdkdiag.Error {
+					result.Diagnostics.AddError(d.Summary, d.Detail)
+				} else {
+					result.Diagnostics.AddWarning(d.Summary, d.Detail)
+				}
+			}
+			return result, true
+		}
+		if newState == nil {
+			return result, false
+		}
+		state = newState
+	}
+
+	d := r.Data(state)
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Error converting resource identity", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identity
+
+	if req.IncludeResource {
+		resourceState, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Error converting resource state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *resourceState
+	}
+
+	return result, true
+}
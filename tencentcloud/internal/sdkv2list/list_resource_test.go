@@ -0,0 +1,136 @@
+package sdkv2list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func newTestResource() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			if d.Id() == "gone" {
+				d.SetId("")
+				return nil
+			}
+
+			if err := helper.SetIdIdentity(d); err != nil {
+				return err
+			}
+			return d.Set("name", "name-"+d.Id())
+		},
+		Identity: helper.IdIdentity(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func newTestListRequest(includeResource bool, limit int64) list.ListRequest {
+	return list.ListRequest{
+		IncludeResource: includeResource,
+		Limit:           limit,
+		ResourceSchema: fwschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"id":   fwschema.StringAttribute{Computed: true},
+				"name": fwschema.StringAttribute{Computed: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+func collect(t *testing.T, b *Base, req list.ListRequest, items []Item) []list.ListResult {
+	t.Helper()
+
+	var results []list.ListResult
+	for result := range b.Results(context.Background(), req, items) {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func getString(t *testing.T, v tftypes.Value, attr string) string {
+	t.Helper()
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		t.Fatalf("converting %s: %v", v, err)
+	}
+	var s string
+	if err := attrs[attr].As(&s); err != nil {
+		t.Fatalf("converting %s: %v", attr, err)
+	}
+	return s
+}
+
+// TestBase_Results verifies that the SDKv2 Read is run for every item, and
+// that items which disappeared are skipped.
+func TestBase_Results(t *testing.T) {
+	b := &Base{TypeName: "tencentcloud_test", NewResource: newTestResource}
+	items := []Item{{Id: "a", DisplayName: "A"}, {Id: "gone"}, {Id: "b", DisplayName: "B"}}
+
+	results := collect(t, b, newTestListRequest(true, 0), items)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	for i, id := range []string{"a", "b"} {
+		if got := getString(t, results[i].Identity.Raw, "id"); got != id {
+			t.Errorf("result %d: identity id = %q, want %q", i, got, id)
+		}
+		if got := getString(t, results[i].Resource.Raw, "name"); got != "name-"+id {
+			t.Errorf("result %d: name = %q, want %q", i, got, "name-"+id)
+		}
+	}
+	if results[1].DisplayName != "B" {
+		t.Errorf("display name = %q, want %q", results[1].DisplayName, "B")
+	}
+}
+
+// TestBase_ResultsIdentityOnly verifies that only the identity is set when
+// Terraform does not ask for the resource state, and that Limit is honoured.
+func TestBase_ResultsIdentityOnly(t *testing.T) {
+	b := &Base{TypeName: "tencentcloud_test", NewResource: newTestResource}
+	items := []Item{{Id: "a"}, {Id: "b"}}
+
+	results := collect(t, b, newTestListRequest(false, 1), items)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if got := getString(t, results[0].Identity.Raw, "id"); got != "a" {
+		t.Errorf("identity id = %q, want %q", got, "a")
+	}
+	if !results[0].Resource.Raw.IsNull() {
+		t.Errorf("expected a null resource state, got %s", results[0].Resource.Raw)
+	}
+}
+
+// TestBase_SetSDKv2Resource verifies that the resource handed over by the
+// provider takes precedence over NewResource.
+func TestBase_SetSDKv2Resource(t *testing.T) {
+	registered := newTestResource()
+	b := &Base{TypeName: "tencentcloud_test", NewResource: newTestResource}
+	b.SetSDKv2Resource(registered)
+
+	if b.Resource() != registered {
+		t.Fatalf("expected the registered resource to be used")
+	}
+}
@@ -7,9 +7,12 @@
 package sharedmeta
 
 import (
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
 
+var _ tccommon.ProviderMeta = &ProviderMeta{}
+
 // ProviderMeta is the concrete type of req.ProviderData that framework-side
 // resources/data sources receive in their Configure phase. Each framework
 // resource should type-assert to *ProviderMeta in Configure to obtain Client.
@@ -23,3 +26,10 @@ type ProviderMeta struct {
 	// behaviour.
 	Client *connectivity.TencentCloudClient
 }
+
+// GetAPIV3Conn makes *ProviderMeta usable as the meta argument of SDKv2 CRUD
+// functions, e.g. when a list resource reads the SDKv2 managed resource it
+// enumerates.
+func (m *ProviderMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return m.Client
+}
@@ -1,16 +1,22 @@
 package tencentcloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
+	"os/exec"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 	sdkcommon "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	commonJson "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/json"
@@ -141,6 +147,8 @@ const (
 	PROVIDER_PROTOCOL       = "TENCENTCLOUD_PROTOCOL"
 	PROVIDER_DOMAIN         = "TENCENTCLOUD_DOMAIN"
 	PROVIDER_COS_DOMAIN     = "TENCENTCLOUD_COS_DOMAIN"
+	PROVIDER_PROXY_URL      = "TENCENTCLOUD_PROXY_URL"
+	PROVIDER_CA_BUNDLE      = "TENCENTCLOUD_CA_BUNDLE"
 	//internal version: replace envYunti begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 	//internal version: replace envYunti end, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 	PROVIDER_ASSUME_ROLE_ARN                     = "TENCENTCLOUD_ASSUME_ROLE_ARN"
@@ -161,7 +169,9 @@ const (
 	PROVIDER_MFA_CERTIFICATION_DURATION_SECONDS  = "TENCENTCLOUD_MFA_CERTIFICATION_DURATION_SECONDS"
 	PROVIDER_SHARED_CREDENTIALS_DIR              = "TENCENTCLOUD_SHARED_CREDENTIALS_DIR"
 	PROVIDER_PROFILE                             = "TENCENTCLOUD_PROFILE"
+	PROVIDER_CREDENTIAL_PROCESS                  = "TENCENTCLOUD_CREDENTIAL_PROCESS"
 	PROVIDER_CAM_ROLE_NAME                       = "TENCENTCLOUD_CAM_ROLE_NAME"
+	PROVIDER_ORGANIZATION_MEMBER_UIN             = "TENCENTCLOUD_ORGANIZATION_MEMBER_UIN"
 	POD_OIDC_TKE_REGION                          = "TKE_REGION"
 	POD_OIDC_TKE_WEB_IDENTITY_TOKEN_FILE         = "TKE_WEB_IDENTITY_TOKEN_FILE"
 	POD_OIDC_TKE_PROVIDER_ID                     = "TKE_PROVIDER_ID"
@@ -171,6 +181,14 @@ const (
 const (
 	DEFAULT_REGION  = "ap-guangzhou"
 	DEFAULT_PROFILE = "default"
+	// DEFAULT_POD_OIDC_SESSION_DURATION is the session duration in seconds of
+	// the credential of sdkcommon.DefaultTkeOIDCRoleArnProvider
+	DEFAULT_POD_OIDC_SESSION_DURATION = 7200
+	// DEFAULT_ORGANIZATION_MEMBER_SESSION_NAME and
+	// DEFAULT_ORGANIZATION_MEMBER_SESSION_DURATION are used to assume the role
+	// of an organization member
+	DEFAULT_ORGANIZATION_MEMBER_SESSION_NAME     = "terraform-organization-member"
+	DEFAULT_ORGANIZATION_MEMBER_SESSION_DURATION = 7200
 )
 
 type TencentCloudClient struct {
@@ -189,7 +207,7 @@ func (meta *TencentCloudClient) GetAPIV3Conn() *connectivity.TencentCloudClient
 }
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
@@ -236,13 +254,24 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_COS_DOMAIN, nil),
 				Description: "The cos domain of the API request, Default is `https://cos.{region}.myqcloud.com`, Other Examples: `https://cluster-123456.cos-cdc.ap-guangzhou.myqcloud.com`.",
 			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROXY_URL, nil),
+				Description: "The HTTP(S) proxy of all the API and COS requests, e.g. `http://proxy.example.com:3128`. It can also be sourced from the `TENCENTCLOUD_PROXY_URL` environment variable. If not set, the proxy of the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables is used.",
+			},
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CA_BUNDLE, nil),
+				Description: "The path of a PEM file of certificate authorities trusted in addition to the ones of the system, e.g. the one of a TLS intercepting proxy. It can also be sourced from the `TENCENTCLOUD_CA_BUNDLE` environment variable.",
+			},
 			//internal version: replace enableBpass begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 			//internal version: replace enableBpass end, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 			"assume_role": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Optional:    true,
-				MaxItems:    1,
-				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+				Description: "The `assume_role` blocks. If provided, terraform will attempt to assume these roles in order, each one using the credentials of the previous one, starting with the supplied credentials, the role of `assume_role_with_saml`, `assume_role_with_web_identity` or `enable_pod_oidc`, or the session of `mfa_certification`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role_arn": {
@@ -424,12 +453,25 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROFILE, nil),
 				Description: "The profile name as set in the shared credentials. It can also be sourced from the `TENCENTCLOUD_PROFILE` environment variable. If not set, the default profile created with `tccli configure` will be used.",
 			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CREDENTIAL_PROCESS, nil),
+				Description: "A command printing the credential as JSON with the keys `SecretId`, `SecretKey`, `Token` and `Expiration` in RFC3339 format, e.g. the CLI of a SSO broker. The command is run again to refresh the credential before it expires. It is used when `secret_id` and `secret_key` are not set, and can also be sourced from the `TENCENTCLOUD_CREDENTIAL_PROCESS` environment variable or the `credential_process` of the profile.",
+			},
 			"cam_role_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CAM_ROLE_NAME, nil),
 				Description: "The name of the CVM instance CAM role. It can be sourced from the `TENCENTCLOUD_CAM_ROLE_NAME` environment variable.",
 			},
+			"organization_member_uin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_ORGANIZATION_MEMBER_UIN, nil),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a UIN"),
+				Description:  "The UIN of an organization member account. If provided, terraform will attempt to access the member account by assuming the role of its authorized identity of the Organization service, preferring the preset identity, using the credentials of the organization administrator. It can be sourced from the `TENCENTCLOUD_ORGANIZATION_MEMBER_UIN` environment variable.",
+			},
 			"mfa_certification": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -478,6 +520,134 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"allowed_account_ids", "assume_role_with_saml", "assume_role_with_web_identity"},
 				Description:   "List of forbidden TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `allowed_account_ids`, If use `assume_role_with_saml` or `assume_role_with_web_identity`, it is not supported.",
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `default_tags` block. If provided, these tags are applied to every resource that supports `tags`. Tags with the same key configured on the resource take precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Key-value map of tags applied to all taggable resources.",
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `rate_limit` block. If provided, the requests of this provider configuration wait on a token bucket per action, and the built-in API rate limits are lifted for it. With or without it, the rate of an action is lowered when the API responds with `RequestLimitExceeded`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"qps": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "QPS of each action without a product or action specific value. Default is 15.",
+						},
+						"product_qps": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "QPS of each action of a product, keyed by the product name used in the API endpoint, e.g. `vpc` or `cvm`.",
+						},
+						"action_qps": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "QPS of an action, keyed by `product.Action`, e.g. `vpc.DescribeVpcs`.",
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `retry` block. If provided, retryable API errors are retried with an exponential backoff with jitter instead of a fixed polling interval, within the read and write retry timeouts. The backoff and `max_attempts` only apply to the operations retried with the provider retry helpers, the other operations only retry the additional `retryable_error_codes`. Each provider configuration (alias) has its own `retry` block, the configurations without one keep the default retries.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: tccommon.ValidateIntegerMin(0),
+							Description:  "Maximum number of attempts of a retried operation. Default is 0, which means retrying until the retry timeout expires.",
+						},
+						"base_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      connectivity.DefaultRetryBaseBackoff.String(),
+							ValidateFunc: tccommon.ValidateDuration,
+							Description:  "Wait before the first retry, doubled on every following retry. Default is `1s`.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      connectivity.DefaultRetryMaxBackoff.String(),
+							ValidateFunc: tccommon.ValidateDuration,
+							Description:  "Maximum wait between two retries. Default is `10s`.",
+						},
+						"jitter": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+							Description:  "Fraction of each wait that is randomized, between 0 and 1. Default is 0.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Error codes retried in addition to the built-in retryable error codes.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"product": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Product the error codes apply to, which is the service of the API endpoint, the same as in `rate_limit.product_qps`, e.g. `cvm`, `vpc` or `postgres`.",
+									},
+									"codes": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Error codes to retry, e.g. `ResourceInUse` or `FailedOperation.TaskConflict`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `endpoints` block. If provided, the requests of a service are sent to its endpoint instead of the one of `domain` or `cos_domain`, e.g. a private link endpoint.",
+				Elem:        &schema.Resource{Schema: endpointsSchema()},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `ignore_tags` block. If provided, the matching tag keys are ignored when reading resource tags and are never deleted on update, so that tags managed outside of terraform do not cause drift.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys to ignore.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag key prefixes to ignore.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -2768,16 +2938,112 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	tag.ApplyDefaultTags(provider.ResourcesMap)
+	withResourceTypeMeta(provider.ResourcesMap, "")
+	withResourceTypeMeta(provider.DataSourcesMap, "data.")
+
+	return provider
+}
+
+// endpointsSchema returns the schema of the `endpoints` block, which has an
+// argument per service of connectivity.EndpointServices.
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema, len(connectivity.EndpointServices))
+	for _, service := range connectivity.EndpointServices {
+		endpoints[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: connectivity.EndpointDescription(service),
+		}
+	}
+
+	return endpoints
+}
+
+// withResourceTypeMeta wraps the functions of resources so that their API
+// calls are counted for the resource type in the API metrics, see
+// connectivity.FlushAPIMetrics. The resource types are prefixed with prefix,
+// e.g. `data.` for the data sources.
+func withResourceTypeMeta(resources map[string]*schema.Resource, prefix string) {
+	for name, r := range resources {
+		resourceType := prefix + name
+		resourceMeta := func(meta interface{}) interface{} {
+			if client, ok := meta.(*TencentCloudClient); ok && client.apiV3Conn != nil {
+				return &TencentCloudClient{apiV3Conn: client.apiV3Conn.WithResourceType(resourceType)}
+			}
+			return meta
+		}
+
+		wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+			if f == nil {
+				return nil
+			}
+
+			return func(d *schema.ResourceData, meta interface{}) error {
+				return f(d, resourceMeta(meta))
+			}
+		}
+
+		wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			if f == nil {
+				return nil
+			}
+
+			return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(ctx, d, resourceMeta(meta))
+			}
+		}
+
+		r.Create = wrap(r.Create)
+		r.Read = wrap(r.Read)
+		r.Update = wrap(r.Update)
+		r.Delete = wrap(r.Delete)
+		r.CreateContext = wrapContext(r.CreateContext)
+		r.ReadContext = wrapContext(r.ReadContext)
+		r.UpdateContext = wrapContext(r.UpdateContext)
+		r.DeleteContext = wrapContext(r.DeleteContext)
+		r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+		r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+		r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+		r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+
+		if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return customizeDiff(ctx, d, resourceMeta(meta))
+			}
+		}
+
+		if importer := r.Importer; importer != nil {
+			if state := importer.State; state != nil {
+				importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+					return state(d, resourceMeta(meta))
+				}
+			}
+			if stateContext := importer.StateContext; stateContext != nil {
+				importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+					return stateContext(ctx, d, resourceMeta(meta))
+				}
+			}
+		}
+	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	// the profile is loaded for each provider, as the aliases of the provider
+	// may use different profiles
+	var providerConfig map[string]interface{}
 	var getProviderConfig = func(key string) string {
-		var str string
-		value, err := getConfigFromProfile(d, key)
-		if err == nil && value != nil {
-			str = value.(string)
+		if providerConfig == nil {
+			config, err := getConfigFromProfile(d)
+			if err != nil {
+				config = map[string]interface{}{}
+			}
+
+			providerConfig = config
 		}
 
+		str, _ := providerConfig[key].(string)
 		return str
 	}
 
@@ -2785,6 +3051,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		secretId            string
 		secretKey           string
 		securityToken       string
+		credentialProcess   string
 		region              string
 		protocol            string
 		domain              string
@@ -2813,15 +3080,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		region = v.(string)
 	}
 
-	if secretId == "" && secretKey == "" && securityToken == "" {
+	if v, ok := d.GetOk("credential_process"); ok {
+		credentialProcess = v.(string)
+	}
+
+	if secretId == "" && secretKey == "" && securityToken == "" && credentialProcess == "" {
 		secretId = getProviderConfig("secretId")
 		secretKey = getProviderConfig("secretKey")
 		securityToken = getProviderConfig("token")
+		if secretId == "" && secretKey == "" {
+			credentialProcess = getProviderConfig("credential_process")
+		}
 		if region == "" {
 			region = getProviderConfig("region")
 		}
 	}
 
+	// the credential process is only used without static credentials
+	if secretId != "" || secretKey != "" {
+		credentialProcess = ""
+	}
+
 	if region == "" {
 		region = DEFAULT_REGION
 	}
@@ -2856,6 +3135,93 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		CosDomain: cosDomain,
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok {
+		defaultTagsList := v.([]interface{})
+		if len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+			defaultTags := defaultTagsList[0].(map[string]interface{})
+			tcClient.apiV3Conn.DefaultTags = make(map[string]string)
+			for k, v := range defaultTags["tags"].(map[string]interface{}) {
+				tcClient.apiV3Conn.DefaultTags[k] = v.(string)
+			}
+		}
+	}
+
+	var rateLimitConfig *ratelimit.Config
+	if v, ok := d.GetOk("rate_limit"); ok {
+		rateLimitList := v.([]interface{})
+		if len(rateLimitList) == 1 && rateLimitList[0] != nil {
+			rateLimit := rateLimitList[0].(map[string]interface{})
+			rateLimitConfig = &ratelimit.Config{
+				Qps:        int64(rateLimit["qps"].(int)),
+				ProductQps: make(map[string]int64),
+				ActionQps:  make(map[string]int64),
+			}
+			for k, v := range rateLimit["product_qps"].(map[string]interface{}) {
+				rateLimitConfig.ProductQps[k] = int64(v.(int))
+			}
+			for k, v := range rateLimit["action_qps"].(map[string]interface{}) {
+				rateLimitConfig.ActionQps[k] = int64(v.(int))
+			}
+		}
+	}
+	// every provider configuration has its own limiter, which throttles the
+	// actions responding with RequestLimitExceeded even without rate_limit
+	tcClient.apiV3Conn.RateLimiter = ratelimit.NewLimiter(rateLimitConfig)
+
+	if v, ok := d.GetOk("retry"); ok {
+		retryList := v.([]interface{})
+		if len(retryList) == 1 && retryList[0] != nil {
+			retry := retryList[0].(map[string]interface{})
+			retryPolicy := &connectivity.RetryPolicy{
+				MaxAttempts:         retry["max_attempts"].(int),
+				Jitter:              retry["jitter"].(float64),
+				RetryableErrorCodes: make(map[string][]string),
+			}
+			// the values are checked by ValidateDuration
+			retryPolicy.BaseBackoff, _ = time.ParseDuration(retry["base_backoff"].(string))
+			retryPolicy.MaxBackoff, _ = time.ParseDuration(retry["max_backoff"].(string))
+			for _, item := range retry["retryable_error_codes"].([]interface{}) {
+				codes := item.(map[string]interface{})
+				product := codes["product"].(string)
+				retryPolicy.RetryableErrorCodes[product] = append(retryPolicy.RetryableErrorCodes[product],
+					helper.InterfacesStrings(codes["codes"].(*schema.Set).List())...)
+			}
+			tcClient.apiV3Conn.RetryPolicy = retryPolicy
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		ignoreTagsList := v.([]interface{})
+		if len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+			ignoreTags := ignoreTagsList[0].(map[string]interface{})
+			tcClient.apiV3Conn.IgnoreTags = connectivity.NewIgnoreTagsConfig(
+				helper.InterfacesStrings(ignoreTags["keys"].(*schema.Set).List()),
+				helper.InterfacesStrings(ignoreTags["key_prefixes"].(*schema.Set).List()),
+			)
+		}
+	}
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		endpointsList := v.([]interface{})
+		if len(endpointsList) == 1 && endpointsList[0] != nil {
+			tcClient.apiV3Conn.Endpoints = make(map[string]string)
+			for k, v := range endpointsList[0].(map[string]interface{}) {
+				if v.(string) != "" {
+					tcClient.apiV3Conn.Endpoints[k] = v.(string)
+				}
+			}
+		}
+	}
+
+	transport, err := connectivity.NewTransport(connectivity.TransportConfig{
+		ProxyUrl: d.Get("proxy_url").(string),
+		CaBundle: d.Get("ca_bundle").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tcClient.apiV3Conn.Transport = transport
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		for _, v := range v.(*schema.Set).List() {
 			allowedAccountIds = append(allowedAccountIds, v.(string))
@@ -2872,6 +3238,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		needAccountFilter = true
 	}
 
+	// get auth from credential process
+	if credentialProcess != "" {
+		needSecret = false
+		err = setRefreshingCredential(&tcClient, func() (*sdkcommon.Credential, time.Time, error) {
+			return getCredentialFromProcess(credentialProcess)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Get auth from credential process failed. Reason: %s", err.Error())
+		}
+	}
+
 	// get auth from CAM role name
 	if camRoleName != "" {
 		needSecret = false
@@ -2955,7 +3332,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			needSecret = false
 		} else if envWebIdentityToken != "" {
 			// use assume role with oidc
-			err = genClientWithOidcSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, envWebIdentityToken, envProviderId)
+			err = genClientWithOidcSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, envWebIdentityToken, "", envProviderId)
 			if err != nil {
 				return nil, fmt.Errorf("Get auth from assume role with OIDC by env failed. Reason: %s", err.Error())
 			}
@@ -2966,33 +3343,6 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
-	// get assume role from tf
-	if v, ok := d.GetOk("assume_role"); ok {
-		assumeRoleList := v.(*schema.Set).List()
-		if len(assumeRoleList) == 1 {
-			assumeRole := assumeRoleList[0].(map[string]interface{})
-			assumeRoleArn = assumeRole["role_arn"].(string)
-			assumeRoleSessionName = assumeRole["session_name"].(string)
-			assumeRoleSessionDuration = assumeRole["session_duration"].(int)
-			assumeRolePolicy = assumeRole["policy"].(string)
-			assumeRoleExternalId = assumeRole["external_id"].(string)
-			assumeRoleSourceIdentity = assumeRole["source_identity"].(string)
-			assumeRoleSerialNumber = assumeRole["serial_number"].(string)
-			assumeRoleTokenCode = assumeRole["token_code"].(string)
-
-			err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode)
-			if err != nil {
-				return nil, fmt.Errorf("Get auth from assume role failed. Reason: %s", err.Error())
-			}
-
-			if camRoleName != "" {
-				needSecret = false
-			} else {
-				needSecret = true
-			}
-		}
-	}
-
 	var (
 		assumeRoleSamlAssertion        string
 		assumeRolePrincipalArn         string
@@ -3047,22 +3397,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 				return nil, fmt.Errorf("`role_arn` can not be empty. you can choose to set it in `role_arn` or `role_arn_file`.\n")
 			}
 
-			// get token with priority: field first, then file
+			// get token with priority: field first, then file, which is read
+			// again when the credential is refreshed
 			assumeRoleWebIdentityToken = assumeRoleWithWebIdentity["web_identity_token"].(string)
-			if assumeRoleWebIdentityToken == "" && assumeRoleWebIdentityTokenFile != "" {
-				config, err := getConfigFromWebIdentityTokenFile(assumeRoleWebIdentityTokenFile)
-				if err != nil {
-					return nil, err
-				}
-
-				assumeRoleWebIdentityToken = config["web_identity_token"].(string)
-			}
-
-			if assumeRoleWebIdentityToken == "" {
+			if assumeRoleWebIdentityToken != "" {
+				assumeRoleWebIdentityTokenFile = ""
+			} else if assumeRoleWebIdentityTokenFile == "" {
 				return nil, fmt.Errorf("`web_identity_token` can not be empty. you can choose to set it in `web_identity_token` or `web_identity_token_file`.\n")
 			}
 
-			err = genClientWithOidcSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRoleWebIdentityToken, assumeRoleProviderId)
+			err = genClientWithOidcSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRoleWebIdentityToken, assumeRoleWebIdentityTokenFile, assumeRoleProviderId)
 			if err != nil {
 				return nil, fmt.Errorf("Get auth from assume role with OIDC failed. Reason: %s", err.Error())
 			}
@@ -3071,6 +3415,21 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	// get auth from pod OIDC, the credential is the source of the roles
+	// assumed below
+	if v, ok := d.GetOkExists("enable_pod_oidc"); ok && v.(bool) {
+		if os.Getenv(POD_OIDC_TKE_REGION) != "" && os.Getenv(POD_OIDC_TKE_WEB_IDENTITY_TOKEN_FILE) != "" && os.Getenv(POD_OIDC_TKE_PROVIDER_ID) != "" && os.Getenv(POD_OIDC_TKE_ROLE_ARN) != "" {
+			err := genClientWithPodOidc(&tcClient)
+			if err != nil {
+				return nil, fmt.Errorf("Get auth from enable pod OIDC failed. Reason: %s", err.Error())
+			}
+
+			needSecret = false
+		} else {
+			return nil, fmt.Errorf("Can not get `TKE_REGION`, `TKE_WEB_IDENTITY_TOKEN_FILE`, `TKE_PROVIDER_ID`, `TKE_ROLE_ARN`. Must config serviceAccountName for pod.\n")
+		}
+	}
+
 	// get mfa from env
 	mfaCertificationSerialNumber := os.Getenv(PROVIDER_MFA_CERTIFICATION_SERIAL_NUMBER)
 	mfaCertificationTokenCode := os.Getenv(PROVIDER_MFA_CERTIFICATION_TOKEN_CODE)
@@ -3112,16 +3471,25 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
-	if v, ok := d.GetOkExists("enable_pod_oidc"); ok && v.(bool) {
-		if os.Getenv(POD_OIDC_TKE_REGION) != "" && os.Getenv(POD_OIDC_TKE_WEB_IDENTITY_TOKEN_FILE) != "" && os.Getenv(POD_OIDC_TKE_PROVIDER_ID) != "" && os.Getenv(POD_OIDC_TKE_ROLE_ARN) != "" {
-			err := genClientWithPodOidc(&tcClient)
+	// get assume role from tf, the roles are assumed in order, each one with
+	// the credentials of the previous one, the first one with the credentials
+	// of pod OIDC or MFA if they are set
+	if v, ok := d.GetOk("assume_role"); ok {
+		for _, item := range v.([]interface{}) {
+			assumeRole := item.(map[string]interface{})
+			assumeRoleArn = assumeRole["role_arn"].(string)
+			assumeRoleSessionName = assumeRole["session_name"].(string)
+			assumeRoleSessionDuration = assumeRole["session_duration"].(int)
+			assumeRolePolicy = assumeRole["policy"].(string)
+			assumeRoleExternalId = assumeRole["external_id"].(string)
+			assumeRoleSourceIdentity = assumeRole["source_identity"].(string)
+			assumeRoleSerialNumber = assumeRole["serial_number"].(string)
+			assumeRoleTokenCode = assumeRole["token_code"].(string)
+
+			err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode)
 			if err != nil {
-				return nil, fmt.Errorf("Get auth from enable pod OIDC failed. Reason: %s", err.Error())
+				return nil, fmt.Errorf("Get auth from assume role `%s` failed. Reason: %s", assumeRoleArn, err.Error())
 			}
-
-			needSecret = false
-		} else {
-			return nil, fmt.Errorf("Can not get `TKE_REGION`, `TKE_WEB_IDENTITY_TOKEN_FILE`, `TKE_PROVIDER_ID`, `TKE_ROLE_ARN`. Must config serviceAccountName for pod.\n")
 		}
 	}
 
@@ -3129,6 +3497,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("Please set your `secret_id` and `secret_key`.\n")
 	}
 
+	// get auth of organization member
+	if v, ok := d.GetOk("organization_member_uin"); ok {
+		err = genClientWithOrganizationMember(&tcClient, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Get auth of organization member failed. Reason: %s", err.Error())
+		}
+	}
+
 	if needAccountFilter {
 		// get indentity
 		indentity, err := getCallerIdentity(&tcClient)
@@ -3173,11 +3549,11 @@ func genClientWithCAM(tcClient *TencentCloudClient, roleName string) error {
 	}
 
 	// using STS credentials
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
+	tcClient.apiV3Conn.SetCredential(sdkcommon.NewTokenCredential(
 		camResp.TmpSecretId,
 		camResp.TmpSecretKey,
 		camResp.Token,
-	)
+	))
 
 	return nil
 }
@@ -3185,7 +3561,6 @@ func genClientWithCAM(tcClient *TencentCloudClient, roleName string) error {
 func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string, assumeRoleExternalId string, assumeRoleSourceIdentity string, assumeRoleSerialNumber string, assumeRoleTokenCode string) error {
 	// applying STS credentials
 	request := sdksts.NewAssumeRoleRequest()
-	response := sdksts.NewAssumeRoleResponse()
 	request.RoleArn = helper.String(assumeRoleArn)
 	request.RoleSessionName = helper.String(assumeRoleSessionName)
 	request.DurationSeconds = helper.IntUint64(assumeRoleSessionDuration)
@@ -3209,37 +3584,41 @@ func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSes
 		request.TokenCode = helper.String(assumeRoleTokenCode)
 	}
 
-	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := tcClient.apiV3Conn.UseStsClient().AssumeRole(request)
-		if e != nil {
-			return tccommon.RetryError(e)
+	// the role is assumed again with the credential it is assumed with now
+	source := *tcClient.apiV3Conn
+	stsService := sts.NewStsService(&source)
+	assumeRole := func() (*sdkcommon.Credential, time.Time, error) {
+		response, err := stsService.AssumeRole(tccommon.ContextNil, request)
+		if err != nil {
+			return nil, time.Time{}, err
 		}
 
-		if result == nil || result.Response == nil || result.Response.Credentials == nil {
-			return resource.NonRetryableError(fmt.Errorf("Get Assume Role failed, Response is nil."))
+		var expiredTime int64
+		if response.ExpiredTime != nil {
+			expiredTime = *response.ExpiredTime
 		}
 
-		response = result
-		return nil
-	})
-
-	if err != nil {
-		return err
+		credential := sdkcommon.NewTokenCredential(
+			*response.Credentials.TmpSecretId,
+			*response.Credentials.TmpSecretKey,
+			*response.Credentials.Token,
+		)
+		return credential, credentialExpiration(expiredTime, assumeRoleSessionDuration), nil
 	}
 
-	if response.Response.Credentials.TmpSecretId == nil || response.Response.Credentials.TmpSecretKey == nil || response.Response.Credentials.Token == nil {
-		return fmt.Errorf("Get Assume Role failed, Credentials is nil.")
-	}
+	// a MFA token code can not be used again to refresh the credential
+	if assumeRoleTokenCode != "" {
+		credential, _, err := assumeRole()
+		if err != nil {
+			return err
+		}
 
-	// using STS credentials
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
-		*response.Response.Credentials.TmpSecretId,
-		*response.Response.Credentials.TmpSecretKey,
-		*response.Response.Credentials.Token,
-	)
+		tcClient.apiV3Conn.SetCredential(credential)
+		return nil
+	}
 
-	return nil
+	// using STS credentials, refreshed before they expire
+	return setRefreshingCredential(tcClient, assumeRole)
 }
 
 func genClientWithSamlSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRoleSamlAssertion, assumeRolePrincipalArn string) error {
@@ -3254,7 +3633,7 @@ func genClientWithSamlSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRol
 	var stsExtInfo connectivity.StsExtInfo
 	stsExtInfo.Authorization = "SKIP"
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		tcClient.apiV3Conn.RateLimiter.Check(request.GetAction())
 		result, e := tcClient.apiV3Conn.UseStsClient(stsExtInfo).AssumeRoleWithSAML(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -3277,60 +3656,83 @@ func genClientWithSamlSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRol
 	}
 
 	// using STS credentials
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
+	tcClient.apiV3Conn.SetCredential(sdkcommon.NewTokenCredential(
 		*response.Response.Credentials.TmpSecretId,
 		*response.Response.Credentials.TmpSecretKey,
 		*response.Response.Credentials.Token,
-	)
+	))
 
 	return nil
 }
 
-func genClientWithOidcSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy, assumeRoleProviderId string) error {
-	// applying STS credentials
-	request := sdksts.NewAssumeRoleWithWebIdentityRequest()
-	response := sdksts.NewAssumeRoleWithWebIdentityResponse()
+// genClientWithOidcSTS assumes a role with an OIDC token, which is read
+// again from assumeRoleWebIdentityTokenFile to refresh the credential if
+// the file is set.
+func genClientWithOidcSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRoleWebIdentityToken, assumeRoleWebIdentityTokenFile, assumeRoleProviderId string) error {
 	if assumeRoleProviderId == "" {
 		assumeRoleProviderId = "OIDC"
 	}
-	request.RoleArn = helper.String(assumeRoleArn)
-	request.RoleSessionName = helper.String(assumeRoleSessionName)
-	request.DurationSeconds = helper.IntInt64(assumeRoleSessionDuration)
-	request.WebIdentityToken = helper.String(assumeRolePolicy)
-	request.ProviderId = helper.String(assumeRoleProviderId)
-	var stsExtInfo connectivity.StsExtInfo
-	stsExtInfo.Authorization = "SKIP"
-	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := tcClient.apiV3Conn.UseStsClient(stsExtInfo).AssumeRoleWithWebIdentity(request)
-		if e != nil {
-			return tccommon.RetryError(e)
+
+	source := *tcClient.apiV3Conn
+	assumeRole := func() (*sdkcommon.Credential, time.Time, error) {
+		webIdentityToken := assumeRoleWebIdentityToken
+		if assumeRoleWebIdentityTokenFile != "" {
+			config, err := getConfigFromWebIdentityTokenFile(assumeRoleWebIdentityTokenFile)
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+
+			webIdentityToken = config["web_identity_token"].(string)
 		}
 
-		if result == nil || result.Response == nil || result.Response.Credentials == nil {
-			return resource.NonRetryableError(fmt.Errorf("Get Assume Role with OIDC failed, Response is nil."))
+		// applying STS credentials
+		request := sdksts.NewAssumeRoleWithWebIdentityRequest()
+		response := sdksts.NewAssumeRoleWithWebIdentityResponse()
+		request.RoleArn = helper.String(assumeRoleArn)
+		request.RoleSessionName = helper.String(assumeRoleSessionName)
+		request.DurationSeconds = helper.IntInt64(assumeRoleSessionDuration)
+		request.WebIdentityToken = helper.String(webIdentityToken)
+		request.ProviderId = helper.String(assumeRoleProviderId)
+		var stsExtInfo connectivity.StsExtInfo
+		stsExtInfo.Authorization = "SKIP"
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			tcClient.apiV3Conn.RateLimiter.Check(request.GetAction())
+			result, e := source.UseStsClient(stsExtInfo).AssumeRoleWithWebIdentity(request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if result == nil || result.Response == nil || result.Response.Credentials == nil {
+				return resource.NonRetryableError(fmt.Errorf("Get Assume Role with OIDC failed, Response is nil."))
+			}
+
+			response = result
+			return nil
+		})
+
+		if err != nil {
+			return nil, time.Time{}, err
 		}
 
-		response = result
-		return nil
-	})
+		if response.Response.Credentials.TmpSecretId == nil || response.Response.Credentials.TmpSecretKey == nil || response.Response.Credentials.Token == nil {
+			return nil, time.Time{}, fmt.Errorf("Get Assume Role failed, Credentials is nil.")
+		}
 
-	if err != nil {
-		return err
-	}
+		var expiredTime int64
+		if response.Response.ExpiredTime != nil {
+			expiredTime = int64(*response.Response.ExpiredTime)
+		}
 
-	if response.Response.Credentials.TmpSecretId == nil || response.Response.Credentials.TmpSecretKey == nil || response.Response.Credentials.Token == nil {
-		return fmt.Errorf("Get Assume Role failed, Credentials is nil.")
+		credential := sdkcommon.NewTokenCredential(
+			*response.Response.Credentials.TmpSecretId,
+			*response.Response.Credentials.TmpSecretKey,
+			*response.Response.Credentials.Token,
+		)
+		return credential, credentialExpiration(expiredTime, assumeRoleSessionDuration), nil
 	}
 
-	// using STS credentials
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
-		*response.Response.Credentials.TmpSecretId,
-		*response.Response.Credentials.TmpSecretKey,
-		*response.Response.Credentials.Token,
-	)
-
-	return nil
+	// using STS credentials, refreshed before they expire
+	return setRefreshingCredential(tcClient, assumeRole)
 }
 
 func genClientWithMfaSTS(tcClient *TencentCloudClient, mfaCertificationSerialNumber string, mfaCertificationTokenCode string, mfaCertificationDurationSeconds int) error {
@@ -3342,7 +3744,7 @@ func genClientWithMfaSTS(tcClient *TencentCloudClient, mfaCertificationSerialNum
 	request.DurationSeconds = helper.IntInt64(mfaCertificationDurationSeconds)
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		tcClient.apiV3Conn.RateLimiter.Check(request.GetAction())
 		result, e := tcClient.apiV3Conn.UseStsClient().GetSessionToken(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -3365,103 +3767,142 @@ func genClientWithMfaSTS(tcClient *TencentCloudClient, mfaCertificationSerialNum
 	}
 
 	// using STS credentials
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
+	tcClient.apiV3Conn.SetCredential(sdkcommon.NewTokenCredential(
 		*response.Response.Credentials.TmpSecretId,
 		*response.Response.Credentials.TmpSecretKey,
 		*response.Response.Credentials.Token,
-	)
+	))
 
 	return nil
 }
 
-var providerConfig map[string]interface{}
+// getConfigFromProfile loads the shared credentials profile of the provider
+// configured by d.
+func getConfigFromProfile(d *schema.ResourceData) (map[string]interface{}, error) {
+	var (
+		profile              string
+		sharedCredentialsDir string
+		credentialPath       string
+		configurePath        string
+	)
+
+	if v, ok := d.GetOk("profile"); ok {
+		profile = v.(string)
+	} else {
+		profile = DEFAULT_PROFILE
+	}
 
-func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{}, error) {
-	if providerConfig == nil {
-		var (
-			profile              string
-			sharedCredentialsDir string
-			credentialPath       string
-			configurePath        string
-		)
+	if v, ok := d.GetOk("shared_credentials_dir"); ok {
+		sharedCredentialsDir = v.(string)
+	}
 
-		if v, ok := d.GetOk("profile"); ok {
-			profile = v.(string)
-		} else {
-			profile = DEFAULT_PROFILE
+	tmpSharedCredentialsDir, err := homedir.Expand(sharedCredentialsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpSharedCredentialsDir == "" {
+		credentialPath = fmt.Sprintf("%s/.tccli/%s.credential", os.Getenv("HOME"), profile)
+		configurePath = fmt.Sprintf("%s/.tccli/%s.configure", os.Getenv("HOME"), profile)
+		if runtime.GOOS == "windows" {
+			credentialPath = fmt.Sprintf("%s/.tccli/%s.credential", os.Getenv("USERPROFILE"), profile)
+			configurePath = fmt.Sprintf("%s/.tccli/%s.configure", os.Getenv("USERPROFILE"), profile)
 		}
+	} else {
+		credentialPath = fmt.Sprintf("%s/%s.credential", tmpSharedCredentialsDir, profile)
+		configurePath = fmt.Sprintf("%s/%s.configure", tmpSharedCredentialsDir, profile)
+	}
 
-		if v, ok := d.GetOk("shared_credentials_dir"); ok {
-			sharedCredentialsDir = v.(string)
+	providerConfig := make(map[string]interface{})
+	_, err = os.Stat(credentialPath)
+	if !os.IsNotExist(err) {
+		data, err := os.ReadFile(credentialPath)
+		if err != nil {
+			return nil, err
 		}
 
-		tmpSharedCredentialsDir, err := homedir.Expand(sharedCredentialsDir)
+		config := map[string]interface{}{}
+		err = json.Unmarshal(data, &config)
 		if err != nil {
 			return nil, err
 		}
 
-		if tmpSharedCredentialsDir == "" {
-			credentialPath = fmt.Sprintf("%s/.tccli/%s.credential", os.Getenv("HOME"), profile)
-			configurePath = fmt.Sprintf("%s/.tccli/%s.configure", os.Getenv("HOME"), profile)
-			if runtime.GOOS == "windows" {
-				credentialPath = fmt.Sprintf("%s/.tccli/%s.credential", os.Getenv("USERPROFILE"), profile)
-				configurePath = fmt.Sprintf("%s/.tccli/%s.configure", os.Getenv("USERPROFILE"), profile)
+		for k, v := range config {
+			if strValue, ok := v.(string); ok {
+				providerConfig[k] = strings.TrimSpace(strValue)
 			}
-		} else {
-			credentialPath = fmt.Sprintf("%s/%s.credential", tmpSharedCredentialsDir, profile)
-			configurePath = fmt.Sprintf("%s/%s.configure", tmpSharedCredentialsDir, profile)
 		}
+	}
 
-		providerConfig = make(map[string]interface{})
-		_, err = os.Stat(credentialPath)
-		if !os.IsNotExist(err) {
-			data, err := os.ReadFile(credentialPath)
-			if err != nil {
-				return nil, err
-			}
+	_, err = os.Stat(configurePath)
+	if !os.IsNotExist(err) {
+		data, err := os.ReadFile(configurePath)
+		if err != nil {
+			return nil, err
+		}
 
-			config := map[string]interface{}{}
-			err = json.Unmarshal(data, &config)
-			if err != nil {
-				return nil, err
-			}
+		config := map[string]interface{}{}
+		err = json.Unmarshal(data, &config)
+		if err != nil {
+			return nil, err
+		}
 
-			for k, v := range config {
-				if strValue, ok := v.(string); ok {
-					providerConfig[k] = strings.TrimSpace(strValue)
+	outerLoop:
+		for k, v := range config {
+			if k == "_sys_param" {
+				tmpMap := v.(map[string]interface{})
+				for tmpK, tmpV := range tmpMap {
+					if tmpK == "region" {
+						providerConfig[tmpK] = strings.TrimSpace(tmpV.(string))
+						break outerLoop
+					}
 				}
 			}
 		}
+	}
 
-		_, err = os.Stat(configurePath)
-		if !os.IsNotExist(err) {
-			data, err := os.ReadFile(configurePath)
-			if err != nil {
-				return nil, err
-			}
+	return providerConfig, nil
+}
 
-			config := map[string]interface{}{}
-			err = json.Unmarshal(data, &config)
-			if err != nil {
-				return nil, err
-			}
+// genClientWithOrganizationMember accesses the organization member
+// memberUin by assuming the role of its authorized identity, preferring the
+// preset identity, with the credentials of the organization administrator.
+func genClientWithOrganizationMember(tcClient *TencentCloudClient, memberUin string) error {
+	uin, err := strconv.ParseInt(memberUin, 10, 64)
+	if err != nil {
+		return err
+	}
 
-		outerLoop:
-			for k, v := range config {
-				if k == "_sys_param" {
-					tmpMap := v.(map[string]interface{})
-					for tmpK, tmpV := range tmpMap {
-						if tmpK == "region" {
-							providerConfig[tmpK] = strings.TrimSpace(tmpV.(string))
-							break outerLoop
-						}
-					}
-				}
-			}
+	organizationService := tco.NewOrganizationService(tcClient.apiV3Conn)
+	identities, err := organizationService.DescribeOrganizationMemberAuthIdentities(tccommon.ContextNil, uin)
+	if err != nil {
+		return err
+	}
+
+	var roleName string
+	for _, identity := range identities {
+		// status 1: configured
+		if identity.IdentityRoleName == nil || identity.Status == nil || *identity.Status != 1 {
+			continue
+		}
+
+		// type 1: preset identity
+		if identity.IdentityType != nil && *identity.IdentityType == 1 {
+			roleName = *identity.IdentityRoleName
+			break
+		}
+
+		if roleName == "" {
+			roleName = *identity.IdentityRoleName
 		}
 	}
 
-	return providerConfig[ProfileKey], nil
+	if roleName == "" {
+		return fmt.Errorf("No authorized identity is configured for the organization member %s.", memberUin)
+	}
+
+	roleArn := fmt.Sprintf("qcs::cam::uin/%d:roleName/%s", uin, roleName)
+	return genClientWithSTS(tcClient, roleArn, DEFAULT_ORGANIZATION_MEMBER_SESSION_NAME, DEFAULT_ORGANIZATION_MEMBER_SESSION_DURATION, "", "", "", "", "")
 }
 
 func genClientWithPodOidc(tcClient *TencentCloudClient) error {
@@ -3470,29 +3911,57 @@ func genClientWithPodOidc(tcClient *TencentCloudClient) error {
 		return err
 	}
 
-	assumeResp, err := provider.GetCredential()
+	// the provider reads the token file of the pod again on every call
+	assumeRole := func() (*sdkcommon.Credential, time.Time, error) {
+		assumeResp, err := provider.GetCredential()
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		credential := sdkcommon.NewTokenCredential(
+			assumeResp.GetSecretId(),
+			assumeResp.GetSecretKey(),
+			assumeResp.GetToken(),
+		)
+		return credential, credentialExpiration(0, DEFAULT_POD_OIDC_SESSION_DURATION), nil
+	}
+
+	return setRefreshingCredential(tcClient, assumeRole)
+}
+
+// setRefreshingCredential makes the temporary credential of refresher the
+// credential of tcClient, which is refreshed before it expires.
+func setRefreshingCredential(tcClient *TencentCloudClient, refresher connectivity.CredentialRefresher) error {
+	credential, err := connectivity.NewRefreshingCredential(refresher)
 	if err != nil {
 		return err
 	}
 
-	tcClient.apiV3Conn.Credential = sdkcommon.NewTokenCredential(
-		assumeResp.GetSecretId(),
-		assumeResp.GetSecretKey(),
-		assumeResp.GetToken(),
-	)
-
+	tcClient.apiV3Conn.SetRefreshingCredential(credential)
 	return nil
 }
 
+// credentialExpiration returns the expiration of a temporary credential,
+// which is the unix time expiredTime, or sessionDuration seconds later if
+// expiredTime is unknown.
+func credentialExpiration(expiredTime int64, sessionDuration int) time.Time {
+	if expiredTime > 0 {
+		return time.Unix(expiredTime, 0)
+	}
+
+	return time.Now().Add(time.Duration(sessionDuration) * time.Second)
+}
+
 func getCallerIdentity(tcClient *TencentCloudClient) (indentity *sdksts.GetCallerIdentityResponseParams, err error) {
-	ak := tcClient.apiV3Conn.Credential.SecretId
-	sk := tcClient.apiV3Conn.Credential.SecretKey
-	token := tcClient.apiV3Conn.Credential.Token
+	credential := tcClient.apiV3Conn.GetCredential()
 	region := tcClient.apiV3Conn.Region
-	credential := sdkcommon.NewTokenCredential(ak, sk, token)
 	cpf := sdkprofile.NewClientProfile()
 	cpf.HttpProfile.Endpoint = "sts.tencentcloudapi.com"
+	if endpoint := tcClient.apiV3Conn.Endpoints["sts"]; endpoint != "" {
+		cpf = tcClient.apiV3Conn.NewServiceClientProfile("sts", 300)
+	}
 	client, _ := sdksts.NewClient(credential, region, cpf)
+	client.WithHttpTransport(tcClient.apiV3Conn.NewLogRoundTripper("sts"))
 	request := sdksts.NewGetCallerIdentityRequest()
 	response := sdksts.NewGetCallerIdentityResponse()
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -3550,6 +4019,60 @@ func verifyAccountIDAllowed(indentity *sdksts.GetCallerIdentityResponseParams, a
 	return nil
 }
 
+// credentialProcessTimeout is how long a `credential_process` may run.
+const credentialProcessTimeout = time.Minute
+
+// credentialProcessOutput is the credential a `credential_process` prints.
+type credentialProcessOutput struct {
+	SecretId   string
+	SecretKey  string
+	Token      string
+	Expiration string
+}
+
+// getCredentialFromProcess runs the `credential_process` command in a shell,
+// which prints a credential as JSON, e.g. `{"SecretId": "...", "SecretKey":
+// "...", "Token": "...", "Expiration": "2024-01-01T00:00:00Z"}`. A credential
+// without `Expiration` never expires.
+func getCredentialFromProcess(command string) (*sdkcommon.Credential, time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	data, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, time.Time{}, fmt.Errorf("failed to run `credential_process`: %v: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, time.Time{}, fmt.Errorf("failed to run `credential_process`: %w", err)
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to parse the output of `credential_process` as JSON: %w", err)
+	}
+
+	if output.SecretId == "" || output.SecretKey == "" {
+		return nil, time.Time{}, fmt.Errorf("field `SecretId` or `SecretKey` in the output of `credential_process` is empty")
+	}
+
+	var expiration time.Time
+	if output.Expiration != "" {
+		expiration, err = time.Parse(time.RFC3339, output.Expiration)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("field `Expiration` in the output of `credential_process` must be in RFC3339 format: %w", err)
+		}
+	}
+
+	return sdkcommon.NewTokenCredential(output.SecretId, output.SecretKey, output.Token), expiration, nil
+}
+
 func getConfigFromRoleArnFile(filePath string) (map[string]interface{}, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
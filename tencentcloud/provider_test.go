@@ -1,9 +1,17 @@
 package tencentcloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
@@ -25,3 +33,259 @@ func TestProvider(t *testing.T) {
 func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
+
+func TestWithResourceTypeMeta(t *testing.T) {
+	var resourceTypes []string
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		resourceTypes = append(resourceTypes, meta.(*TencentCloudClient).GetAPIV3Conn().ResourceType)
+		return nil
+	}
+	resources := map[string]*schema.Resource{
+		"tencentcloud_vpc": {Read: read},
+	}
+	dataSources := map[string]*schema.Resource{
+		"tencentcloud_vpc_instances": {Read: read},
+	}
+	withResourceTypeMeta(resources, "")
+	withResourceTypeMeta(dataSources, "data.")
+
+	meta := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+	_ = resources["tencentcloud_vpc"].Read(nil, meta)
+	_ = dataSources["tencentcloud_vpc_instances"].Read(nil, meta)
+
+	if fmt.Sprint(resourceTypes) != "[tencentcloud_vpc data.tencentcloud_vpc_instances]" {
+		t.Fatalf("unexpected resource types: %v", resourceTypes)
+	}
+	if meta.apiV3Conn.ResourceType != "" {
+		t.Fatalf("the provider meta is changed: %s", meta.apiV3Conn.ResourceType)
+	}
+}
+
+func TestGetCredentialFromProcess(t *testing.T) {
+	credential, expiration, err := getCredentialFromProcess(`printf '{"SecretId":"AKIDtest","SecretKey":"key","Token":"token","Expiration":"2030-01-01T00:00:00Z"}'`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if credential.SecretId != "AKIDtest" || credential.SecretKey != "key" || credential.Token != "token" {
+		t.Fatalf("unexpected credential: %+v", credential)
+	}
+	if !expiration.Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected expiration: %s", expiration)
+	}
+
+	// a credential without expiration never expires
+	_, expiration, err = getCredentialFromProcess(`printf '{"SecretId":"AKIDtest","SecretKey":"key"}'`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !expiration.IsZero() {
+		t.Fatalf("unexpected expiration: %s", expiration)
+	}
+
+	for _, command := range []string{
+		`echo "sso login required" >&2; exit 1`,
+		`printf 'not json'`,
+		`printf '{"SecretId":"AKIDtest"}'`,
+		`printf '{"SecretId":"AKIDtest","SecretKey":"key","Expiration":"tomorrow"}'`,
+	} {
+		if _, _, err := getCredentialFromProcess(command); err == nil {
+			t.Fatalf("expect error for %s", command)
+		}
+	}
+
+	_, _, err = getCredentialFromProcess(`echo "sso login required" >&2; exit 1`)
+	if !strings.Contains(err.Error(), "sso login required") {
+		t.Fatalf("stderr is not in the error: %s", err)
+	}
+}
+
+// unsetProviderEnv unsets the environment variables of the provider
+// arguments, so that providerConfigure only uses the configuration of a test.
+func unsetProviderEnv(t *testing.T) {
+	for _, env := range []string{
+		PROVIDER_SECRET_ID,
+		PROVIDER_SECRET_KEY,
+		PROVIDER_SECURITY_TOKEN,
+		PROVIDER_REGION,
+		PROVIDER_PROFILE,
+		PROVIDER_SHARED_CREDENTIALS_DIR,
+		PROVIDER_CREDENTIAL_PROCESS,
+		PROVIDER_CAM_ROLE_NAME,
+		PROVIDER_ORGANIZATION_MEMBER_UIN,
+		PROVIDER_ASSUME_ROLE_ARN,
+		PROVIDER_MFA_CERTIFICATION_SERIAL_NUMBER,
+		POD_OIDC_TKE_REGION,
+	} {
+		t.Setenv(env, "")
+	}
+}
+
+func TestProviderConfigureProfilePerAlias(t *testing.T) {
+	unsetProviderEnv(t)
+
+	writeProfile := func(dir, profile, secretId, region string) {
+		credential := fmt.Sprintf(`{"secretId": "%s", "secretKey": "key-%s"}`, secretId, profile)
+		configure := fmt.Sprintf(`{"_sys_param": {"region": "%s"}}`, region)
+		if err := os.WriteFile(filepath.Join(dir, profile+".credential"), []byte(credential), 0600); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, profile+".configure"), []byte(configure), 0600); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	dir, otherDir := t.TempDir(), t.TempDir()
+	writeProfile(dir, "default", "AKIDdefault", "ap-guangzhou")
+	writeProfile(dir, "prod", "AKIDprod", "ap-shanghai")
+	writeProfile(otherDir, "prod", "AKIDother", "ap-beijing")
+
+	// the aliases of a provider are configured in turn in the same process
+	for _, c := range []struct {
+		raw      map[string]interface{}
+		secretId string
+		region   string
+	}{
+		{map[string]interface{}{"shared_credentials_dir": dir}, "AKIDdefault", "ap-guangzhou"},
+		{map[string]interface{}{"shared_credentials_dir": dir, "profile": "prod"}, "AKIDprod", "ap-shanghai"},
+		{map[string]interface{}{"shared_credentials_dir": otherDir, "profile": "prod"}, "AKIDother", "ap-beijing"},
+		{map[string]interface{}{"shared_credentials_dir": dir, "profile": "prod", "region": "ap-hongkong"}, "AKIDprod", "ap-hongkong"},
+	} {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, c.raw)
+		meta, err := providerConfigure(d)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		conn := meta.(*TencentCloudClient).GetAPIV3Conn()
+		if conn.Credential.SecretId != c.secretId || conn.Region != c.region {
+			t.Fatalf("provider %v uses the profile of %s in %s", c.raw, conn.Credential.SecretId, conn.Region)
+		}
+	}
+}
+
+func TestProviderConfigureAssumeRoleChain(t *testing.T) {
+	unsetProviderEnv(t)
+
+	// the role assumed and the token of the credentials assuming it
+	var assumed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-TC-Action") == "GetSessionToken" {
+			_, _ = fmt.Fprintf(w, `{"Response":{"Credentials":{"TmpSecretId":"AKID-mfa","TmpSecretKey":"key-mfa","Token":"token-mfa"},"ExpiredTime":%d,"RequestId":"req-1"}}`,
+				time.Now().Add(time.Hour).Unix())
+			return
+		}
+
+		var request struct {
+			RoleArn    string
+			ExternalId string
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		assumed = append(assumed, fmt.Sprintf("%s:%s:%s", request.RoleArn, request.ExternalId, r.Header.Get("X-TC-Token")))
+		_, _ = fmt.Fprintf(w, `{"Response":{"Credentials":{"TmpSecretId":"AKID-%[1]s","TmpSecretKey":"key-%[1]s","Token":"token-%[1]s"},"ExpiredTime":%[2]d,"RequestId":"req-1"}}`,
+			request.RoleArn, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	configure := func(raw map[string]interface{}) (interface{}, error) {
+		assumed = nil
+		config := map[string]interface{}{
+			"secret_id":  "AKIDsource",
+			"secret_key": "key",
+			"endpoints":  []interface{}{map[string]interface{}{"sts": server.URL}},
+			"assume_role": []interface{}{
+				map[string]interface{}{"role_arn": "identity", "session_name": "test", "session_duration": 3600},
+				map[string]interface{}{"role_arn": "member", "session_name": "test", "session_duration": 3600, "external_id": "ext"},
+			},
+		}
+		for k, v := range raw {
+			config[k] = v
+		}
+		return providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, config))
+	}
+
+	meta, err := configure(nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if fmt.Sprint(assumed) != "[identity:: member:ext:token-identity]" {
+		t.Fatalf("unexpected assumed roles: %v", assumed)
+	}
+	if token := meta.(*TencentCloudClient).GetAPIV3Conn().GetCredential().GetToken(); token != "token-member" {
+		t.Fatalf("unexpected token: %s", token)
+	}
+
+	// the MFA session is the source of the chain, not replacing its result
+	meta, err = configure(map[string]interface{}{
+		"mfa_certification": []interface{}{
+			map[string]interface{}{"serial_number": "qcs::cam:uin/100000000001::mfa/softToken", "token_code": "123456", "duration_seconds": 1800},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if fmt.Sprint(assumed) != "[identity::token-mfa member:ext:token-identity]" {
+		t.Fatalf("unexpected assumed roles: %v", assumed)
+	}
+	if token := meta.(*TencentCloudClient).GetAPIV3Conn().GetCredential().GetToken(); token != "token-member" {
+		t.Fatalf("unexpected token: %s", token)
+	}
+
+	// pod OIDC fails before any role of the chain is assumed
+	t.Setenv(POD_OIDC_TKE_REGION, "ap-guangzhou")
+	t.Setenv(POD_OIDC_TKE_PROVIDER_ID, "provider")
+	t.Setenv(POD_OIDC_TKE_ROLE_ARN, "pod")
+	t.Setenv(POD_OIDC_TKE_WEB_IDENTITY_TOKEN_FILE, filepath.Join(t.TempDir(), "missing"))
+	if _, err = configure(map[string]interface{}{"enable_pod_oidc": true}); err == nil || !strings.Contains(err.Error(), "pod OIDC") {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(assumed) != 0 {
+		t.Fatalf("unexpected assumed roles: %v", assumed)
+	}
+}
+
+func TestProviderConfigureOrganizationMember(t *testing.T) {
+	unsetProviderEnv(t)
+
+	var assumed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("X-TC-Action") {
+		case "DescribeOrganizationMemberAuthIdentities":
+			_, _ = w.Write([]byte(`{"Response":{"Items":[` +
+				`{"IdentityId":2,"IdentityRoleName":"custom-role","IdentityType":2,"Status":1,"MemberUin":100000000002},` +
+				`{"IdentityId":3,"IdentityRoleName":"broken-role","IdentityType":1,"Status":2,"MemberUin":100000000002},` +
+				`{"IdentityId":1,"IdentityRoleName":"OrganizationAccessControlRole","IdentityType":1,"Status":1,"MemberUin":100000000002}` +
+				`],"Total":3,"RequestId":"req-1"}}`))
+		case "AssumeRole":
+			var request struct {
+				RoleArn string
+			}
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			assumed = append(assumed, request.RoleArn)
+			_, _ = fmt.Fprintf(w, `{"Response":{"Credentials":{"TmpSecretId":"AKIDmember","TmpSecretKey":"key","Token":"token-member"},"ExpiredTime":%d,"RequestId":"req-2"}}`,
+				time.Now().Add(time.Hour).Unix())
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"secret_id":               "AKIDadmin",
+		"secret_key":              "key",
+		"endpoints":               []interface{}{map[string]interface{}{"sts": server.URL, "organization": server.URL}},
+		"organization_member_uin": "100000000002",
+	})
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if fmt.Sprint(assumed) != "[qcs::cam::uin/100000000002:roleName/OrganizationAccessControlRole]" {
+		t.Fatalf("unexpected assumed roles: %v", assumed)
+	}
+	if secretId := meta.(*TencentCloudClient).GetAPIV3Conn().GetCredential().GetSecretId(); secretId != "AKIDmember" {
+		t.Fatalf("unexpected secret id: %s", secretId)
+	}
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// minQps is the lowest rate an adaptive bucket backs off to.
+	minQps = 1.0
+
+	// throttleFactor is applied to the current rate every time the API
+	// responds with RequestLimitExceeded.
+	throttleFactor = 0.5
+
+	// recoverRatio is the share of the configured rate regained per second
+	// after the rate has been lowered.
+	recoverRatio = 0.1
+)
+
+// bucket is a token bucket. Callers reserve a token and sleep until it is
+// available instead of polling, so waiting goroutines do not burn CPU.
+type bucket struct {
+	mu sync.Mutex
+
+	limit  float64 // configured QPS
+	rate   float64 // current QPS, lower than limit after throttling
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(qps float64) *bucket {
+	return &bucket{
+		limit:  qps,
+		rate:   qps,
+		tokens: burstOf(qps),
+		last:   time.Now(),
+	}
+}
+
+func burstOf(qps float64) float64 {
+	return math.Max(1, qps)
+}
+
+// Wait blocks until a token is available, or until ctx is done, in which
+// case the token is given back and the error of ctx is returned.
+func (b *bucket) Wait(ctx context.Context) error {
+	d := b.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a caller which stopped waiting.
+func (b *bucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(burstOf(b.rate), b.tokens+1)
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		if b.rate < b.limit {
+			b.rate = math.Min(b.limit, b.rate+elapsed*b.limit*recoverRatio)
+		}
+		b.tokens = math.Min(burstOf(b.rate), b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+}
+
+// Throttle lowers the rate after the API reported RequestLimitExceeded and
+// returns the new rate.
+func (b *bucket) Throttle() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rate = math.Max(math.Min(minQps, b.limit), b.rate*throttleFactor)
+	b.tokens = math.Min(b.tokens, 0)
+	return b.rate
+}
+
+// Recovered reports whether the rate is back to the limit after throttling.
+func (b *bucket) Recovered() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	return b.rate >= b.limit
+}
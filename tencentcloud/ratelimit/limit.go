@@ -1,30 +1,30 @@
 package ratelimit
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"math/rand"
 	"runtime"
 	"strings"
 	"sync"
-	"time"
-
-	"github.com/yangwenmai/ratelimit/simpleratelimit"
 )
 
 var (
 	limitConfig = make(map[string]int64)
 
-	limitContainer = make(map[string]*simpleratelimit.RateLimiter)
+	limitContainer = make(map[string]*bucket)
 
 	locker sync.Mutex
 )
 
+// ProCheck waits for the built-in limit of namespace.action. It does not know
+// the provider configuration making the request, use Limiter.ProCheck of the
+// client where there is one.
 func ProCheck(namespace, action string) {
 
 	key := fmt.Sprintf("%s.%s", namespace, action)
 
-	var limit *simpleratelimit.RateLimiter
+	var limit *bucket
 
 	locker.Lock()
 
@@ -39,35 +39,133 @@ func ProCheck(namespace, action string) {
 	}
 
 	if limitContainer[key] == nil {
-		limitContainer[key] = simpleratelimit.New(int(limitNumber), time.Second)
+		limitContainer[key] = newBucket(float64(limitNumber))
 	}
 
 	limit = limitContainer[key]
 	locker.Unlock()
 
-	old := time.Now()
+	_ = limit.Wait(context.Background())
+}
 
-	for limit.Limit() {
-		//Prevent wake at same time
-		sleepMs := 10 + rand.Intn(30)
-		time.Sleep(time.Duration(sleepMs) * time.Microsecond)
+// Check is ProCheck with the file name of the caller as the namespace.
+func Check(action string) {
+	ProCheck(callerFileName(2), action)
+}
 
-		if time.Since(old) > 5*time.Minute {
-			log.Printf("[WARN] %s wait too long, we try to release it", key)
-			break
-		}
+func callerFileName(skip int) string {
+
+	_, filePath, _, _ := runtime.Caller(skip)
+
+	items := strings.Split(filePath, `/`)
+	items = strings.Split(items[len(items)-1], `\`)
+
+	return strings.TrimSuffix(items[len(items)-1], ".go")
+}
+
+// Config is the rate limit configured by the provider rate_limit block.
+type Config struct {
+	// Qps is the QPS of every action without a product or action specific value.
+	Qps int64
+	// ProductQps is the QPS of every action of a product, keyed by the product
+	// name used in the API endpoint, e.g. `vpc` or `cvm`.
+	ProductQps map[string]int64
+	// ActionQps is the QPS of an action, keyed by `product.Action`,
+	// e.g. `vpc.DescribeVpcs`.
+	ActionQps map[string]int64
+}
+
+// Limiter limits the API requests of a provider configuration. With a
+// Config, every action is limited by a token bucket. Without one, the
+// built-in limits of Limiter.ProCheck apply, and an action is only limited by
+// Limiter from its first RequestLimitExceeded until its rate recovers to
+// DefaultLimit. In both cases the rate of an action is lowered by Throttle.
+type Limiter struct {
+	mu sync.Mutex
+
+	config  *Config
+	buckets map[string]*bucket
+}
+
+// NewLimiter returns a Limiter limiting the requests to cfg, which is nil
+// without the rate_limit block.
+func NewLimiter(cfg *Config) *Limiter {
+	return &Limiter{config: cfg, buckets: make(map[string]*bucket)}
+}
+
+// Configured reports whether the provider configuration of l sets the
+// rate_limit block, which replaces the built-in limits. l may be nil.
+func (l *Limiter) Configured() bool {
+	return l != nil && l.config != nil
+}
+
+// ProCheck waits for the built-in limit of namespace.action, unless the
+// provider configuration of l sets the rate_limit block. l may be nil, e.g.
+// for a client built without a provider configuration.
+func (l *Limiter) ProCheck(namespace, action string) {
+	if l.Configured() {
+		return
 	}
+	ProCheck(namespace, action)
+}
 
+// Check is Limiter.ProCheck with the file name of the caller as the namespace.
+func (l *Limiter) Check(action string) {
+	l.ProCheck(callerFileName(2), action)
 }
 
-func Check(action string) {
+// Wait blocks until a request of product.action is allowed, or until ctx is
+// done, in which case it returns the error of ctx.
+func (l *Limiter) Wait(ctx context.Context, product, action string) error {
+	if limit := l.bucket(product, action, false); limit != nil {
+		return limit.Wait(ctx)
+	}
+	return nil
+}
 
-	_, filePath, _, _ := runtime.Caller(1)
+// Throttle lowers the rate of product.action after the API responded with
+// RequestLimitExceeded. The rate recovers gradually afterwards.
+func (l *Limiter) Throttle(product, action string) {
+	rate := l.bucket(product, action, true).Throttle()
+	log.Printf("[WARN] %s.%s request limit exceeded, lower rate limit to %.2f QPS", product, action, rate)
+}
 
-	items := strings.Split(filePath, `/`)
-	items = strings.Split(items[len(items)-1], `\`)
+func (l *Limiter) bucket(product, action string, create bool) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	fileName := strings.TrimSuffix(items[len(items)-1], ".go")
+	key := fmt.Sprintf("%s.%s", product, action)
+	if limit, ok := l.buckets[key]; ok {
+		// an adaptive bucket is dropped once it has recovered
+		if l.config == nil && !create && limit.Recovered() {
+			delete(l.buckets, key)
+			return nil
+		}
+		return limit
+	}
 
-	ProCheck(fileName, action)
+	if l.config == nil && !create {
+		return nil
+	}
+
+	l.buckets[key] = newBucket(float64(l.qps(product, key)))
+	return l.buckets[key]
+}
+
+func (l *Limiter) qps(product, key string) int64 {
+	if l.config == nil {
+		return DefaultLimit
+	}
+
+	qps := l.config.ActionQps[key]
+	if qps <= 0 {
+		qps = l.config.ProductQps[product]
+	}
+	if qps <= 0 {
+		qps = l.config.Qps
+	}
+	if qps <= 0 {
+		qps = DefaultLimit
+	}
+	return qps
 }
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketReserve(t *testing.T) {
+	b := newBucket(2)
+	now := b.last
+
+	assert.Equal(t, time.Duration(0), b.reserve(now))
+	assert.Equal(t, time.Duration(0), b.reserve(now))
+	assert.Equal(t, 500*time.Millisecond, b.reserve(now))
+	assert.Equal(t, time.Second, b.reserve(now))
+
+	// the tokens owed above are paid back before new requests are allowed
+	assert.Equal(t, 500*time.Millisecond, b.reserve(now.Add(time.Second)))
+}
+
+func TestBucketThrottle(t *testing.T) {
+	b := newBucket(10)
+
+	assert.Equal(t, 5.0, b.Throttle())
+	assert.Equal(t, 2.5, b.Throttle())
+	assert.Equal(t, 1.25, b.Throttle())
+	assert.Equal(t, 1.0, b.Throttle())
+
+	// a throttled bucket regains recoverRatio of its limit per second
+	b.reserve(b.last.Add(time.Second))
+	assert.Equal(t, 2.0, b.rate)
+	b.reserve(b.last.Add(time.Minute))
+	assert.Equal(t, 10.0, b.rate)
+}
+
+func TestBucketWaitCancel(t *testing.T) {
+	b := newBucket(1)
+	assert.NoError(t, b.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.Wait(ctx), context.DeadlineExceeded)
+
+	// the token of the cancelled wait is given back
+	assert.Equal(t, time.Duration(0), b.reserve(b.last.Add(time.Second)))
+}
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(&Config{
+		Qps:        3,
+		ProductQps: map[string]int64{"vpc": 5},
+		ActionQps:  map[string]int64{"vpc.DescribeVpcs": 7},
+	})
+	assert.True(t, l.Configured())
+	assert.Equal(t, 7.0, l.bucket("vpc", "DescribeVpcs", false).limit)
+	assert.Equal(t, 5.0, l.bucket("vpc", "DescribeSubnets", false).limit)
+	assert.Equal(t, 3.0, l.bucket("cvm", "DescribeInstances", false).limit)
+	assert.Same(t, l.bucket("vpc", "DescribeVpcs", false), l.bucket("vpc", "DescribeVpcs", false))
+
+	// the limiters of provider aliases do not share their config
+	other := NewLimiter(&Config{})
+	assert.Equal(t, float64(DefaultLimit), other.bucket("cvm", "DescribeInstances", false).limit)
+	assert.Equal(t, 3.0, l.bucket("cvm", "DescribeInstances", false).limit)
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	l := NewLimiter(nil)
+	assert.False(t, l.Configured())
+	assert.Nil(t, l.bucket("vpc", "DescribeVpcs", false))
+
+	// without a config an action is limited once it is throttled
+	l.Throttle("vpc", "DescribeVpcs")
+	limit := l.bucket("vpc", "DescribeVpcs", false)
+	if assert.NotNil(t, limit) {
+		assert.InDelta(t, float64(DefaultLimit)/2, limit.rate, 0.1)
+	}
+	assert.Nil(t, l.bucket("vpc", "DescribeSubnets", false))
+
+	// and no longer once it has recovered
+	limit.last = limit.last.Add(-time.Minute)
+	assert.Nil(t, l.bucket("vpc", "DescribeVpcs", false))
+}
+
+func TestLimiterProCheck(t *testing.T) {
+	namespace := "limit_test_" + t.Name()
+	limitConfig[namespace] = 1
+	defer func() {
+		locker.Lock()
+		defer locker.Unlock()
+
+		delete(limitConfig, namespace)
+		delete(limitConfig, namespace+".DescribeVpcs")
+		delete(limitContainer, namespace+".DescribeVpcs")
+	}()
+
+	// the built-in limit of one request per second allows the first request
+	var unconfigured *Limiter
+	unconfigured.ProCheck(namespace, "DescribeVpcs")
+	assert.False(t, unconfigured.Configured())
+
+	// the limiter of a provider configuration with rate_limit skips it, the
+	// limiters of other provider configurations do not
+	configured := NewLimiter(&Config{Qps: 100})
+	start := time.Now()
+	configured.ProCheck(namespace, "DescribeVpcs")
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	start = time.Now()
+	NewLimiter(nil).ProCheck(namespace, "DescribeVpcs")
+	assert.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestLimiterCheckNamespace(t *testing.T) {
+	var l *Limiter
+	l.Check("DescribeVpcs")
+
+	locker.Lock()
+	defer locker.Unlock()
+	assert.NotNil(t, limitContainer["limit_test.DescribeVpcs"])
+}
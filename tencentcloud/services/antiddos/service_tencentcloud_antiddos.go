@@ -15,7 +15,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewAntiddosService(client *connectivity.TencentCloudClient) AntiddosService {
@@ -42,7 +41,7 @@ func (me *AntiddosService) DescribeListBGPIPInstances(ctx context.Context, insta
 	request.Offset = &offsetInt64
 	limitInt64 := uint64(limit)
 	request.Limit = &limitInt64
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	var response *antiddos.DescribeListBGPIPInstancesResponse
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		response, err = me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
@@ -59,7 +58,7 @@ func (me *AntiddosService) DescribeListBGPIPInstances(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	result = response.Response.InstanceList
@@ -90,7 +89,7 @@ func (me *AntiddosService) AssociateDDoSEipAddress(ctx context.Context, instance
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -121,7 +120,7 @@ func (me *AntiddosService) AssociateDDoSEipLoadBalancer(ctx context.Context, ins
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -150,7 +149,7 @@ func (me *AntiddosService) DisassociateDDoSEipAddress(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -177,7 +176,7 @@ func (me *AntiddosService) DescribeListProtectThresholdConfig(ctx context.Contex
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -193,7 +192,7 @@ func (me *AntiddosService) DescribeListBlackWhiteIpList(ctx context.Context, ins
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBlackWhiteIpList(request)
 		if e != nil {
 			err = e
@@ -220,7 +219,7 @@ func (me *AntiddosService) DescribeListPortAclList(ctx context.Context, instance
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListPortAclList(request)
 		if e != nil {
 			err = e
@@ -257,7 +256,7 @@ func (me *AntiddosService) DescribeListProtocolBlockConfig(ctx context.Context,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -283,7 +282,7 @@ func (me *AntiddosService) DescribeDDoSConnectLimitList(ctx context.Context, ins
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -309,7 +308,7 @@ func (me *AntiddosService) DescribeListDDoSAI(ctx context.Context, instanceId st
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -324,7 +323,7 @@ func (me *AntiddosService) DescribeListDDoSGeoIPBlockConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListDDoSGeoIPBlockConfig(request)
 		if e != nil {
 			err = e
@@ -350,7 +349,7 @@ func (me *AntiddosService) DescribeListDDoSSpeedLimitConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListDDoSSpeedLimitConfig(request)
 		if e != nil {
 			err = e
@@ -388,7 +387,7 @@ func (me *AntiddosService) DescribeListPacketFilterConfig(ctx context.Context, i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -414,7 +413,7 @@ func (me *AntiddosService) DescribeListPacketFilterConfig(ctx context.Context, i
 // 	})
 // 	if err != nil {
 // 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-// 			logId, request.GetAction(), request.ToJsonString(), err.Error())
+// 			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 // 		return
 // 	}
 // 	return
@@ -441,7 +440,7 @@ func (me *AntiddosService) CreateDDoSBlackWhiteIpList(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -467,7 +466,7 @@ func (me *AntiddosService) ModifyDDoSThreshold(ctx context.Context, business, in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -490,7 +489,7 @@ func (me *AntiddosService) ModifyDDoSLevel(ctx context.Context, business, instan
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -516,7 +515,7 @@ func (me *AntiddosService) CreatePortAclConfig(ctx context.Context, instanceId s
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -537,7 +536,7 @@ func (me *AntiddosService) CreateProtocolBlockConfig(ctx context.Context, instan
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -558,7 +557,7 @@ func (me *AntiddosService) CreateWaterPrintConfig(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -573,7 +572,7 @@ func (me *AntiddosService) DescribeListWaterPrintConfig(ctx context.Context, ins
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListWaterPrintConfig(request)
 		if e != nil {
 			err = e
@@ -603,7 +602,7 @@ func (me *AntiddosService) DeleteWaterPrintConfig(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -623,7 +622,7 @@ func (me *AntiddosService) SwitchWaterPrintConfig(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -644,7 +643,7 @@ func (me *AntiddosService) CreateDDoSConnectLimit(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -665,7 +664,7 @@ func (me *AntiddosService) CreateDDoSAI(ctx context.Context, instanceId, ddosAI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -686,7 +685,7 @@ func (me *AntiddosService) CreateDDoSGeoIPBlockConfig(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -707,7 +706,7 @@ func (me *AntiddosService) CreateDDoSSpeedLimitConfig(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -728,7 +727,7 @@ func (me *AntiddosService) CreatePacketFilterConfig(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -756,7 +755,7 @@ func (me *AntiddosService) DeleteDDoSBlackWhiteIpList(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -776,7 +775,7 @@ func (me *AntiddosService) DeletePortAclConfig(ctx context.Context, instanceId s
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -804,7 +803,7 @@ func (me *AntiddosService) DeleteProtocolBlockConfig(ctx context.Context, instan
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -836,7 +835,7 @@ func (me *AntiddosService) DeleteDDoSConnectLimit(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -857,7 +856,7 @@ func (me *AntiddosService) DeleteDDoSAI(ctx context.Context, instanceId string)
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -878,7 +877,7 @@ func (me *AntiddosService) DeleteDDoSGeoIPBlockConfig(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -899,7 +898,7 @@ func (me *AntiddosService) DeleteDDoSSpeedLimitConfig(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -920,7 +919,7 @@ func (me *AntiddosService) DeletePacketFilterConfig(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -946,7 +945,7 @@ func (me *AntiddosService) DeleteDDoSThreshold(ctx context.Context, business, in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -969,7 +968,7 @@ func (me *AntiddosService) DeleteyDDoSLevel(ctx context.Context, business, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -985,7 +984,7 @@ func (me *AntiddosService) DescribeCCThresholdList(ctx context.Context, business
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCThresholdList(request)
 		if e != nil {
 			err = e
@@ -1024,7 +1023,7 @@ func (me *AntiddosService) ModifyCCThresholdPolicy(ctx context.Context, instance
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1040,7 +1039,7 @@ func (me *AntiddosService) DescribeCcGeoIPBlockConfigList(ctx context.Context, b
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCcGeoIPBlockConfigList(request)
 		if e != nil {
 			err = e
@@ -1074,7 +1073,7 @@ func (me *AntiddosService) CreateCcGeoIPBlockConfig(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1094,7 +1093,7 @@ func (me *AntiddosService) DeleteCcGeoIPBlockConfig(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1110,7 +1109,7 @@ func (me *AntiddosService) DescribeCcBlackWhiteIpList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCcBlackWhiteIpList(request)
 		if e != nil {
 			err = e
@@ -1152,7 +1151,7 @@ func (me *AntiddosService) CreateCcBlackWhiteIpList(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1172,7 +1171,7 @@ func (me *AntiddosService) DeleteCcBlackWhiteIpList(ctx context.Context, instanc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1188,7 +1187,7 @@ func (me *AntiddosService) DescribeCCPrecisionPlyList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCPrecisionPlyList(request)
 		if e != nil {
 			err = e
@@ -1223,7 +1222,7 @@ func (me *AntiddosService) CreateCCPrecisionPolicy(ctx context.Context, instance
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1243,7 +1242,7 @@ func (me *AntiddosService) DeleteCCPrecisionPolicy(ctx context.Context, instance
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1266,7 +1265,7 @@ func (me *AntiddosService) ModifyCCLevelPolicy(ctx context.Context, instanceId,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1282,7 +1281,7 @@ func (me *AntiddosService) DescribeCCReqLimitPolicyList(ctx context.Context, bus
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCReqLimitPolicyList(request)
 		if e != nil {
 			err = e
@@ -1316,7 +1315,7 @@ func (me *AntiddosService) CreateCCReqLimitPolicy(ctx context.Context, instanceI
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1336,7 +1335,7 @@ func (me *AntiddosService) DeleteCCRequestLimitPolicy(ctx context.Context, insta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1349,7 +1348,7 @@ func (me *AntiddosService) DescribeCCLevelPolicy(ctx context.Context, domain, in
 	request.Ip = &ip
 	request.Protocol = &protocol
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, e := me.client.UseAntiddosClient().DescribeCCLevelPolicy(request)
 	if e != nil {
 		err = e
@@ -1369,7 +1368,7 @@ func (me *AntiddosService) DescribeListBGPIPInstanceById(ctx context.Context, bu
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
 		if e != nil {
 			err = e
@@ -1396,7 +1395,7 @@ func (me *AntiddosService) DescribeListBGPInstanceById(ctx context.Context, busi
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
 		if e != nil {
 			err = e
@@ -1424,7 +1423,7 @@ func (me *AntiddosService) DescribeCCLevelList(ctx context.Context, business, in
 	request.Business = &business
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseAntiddosClient().DescribeCCLevelList(request)
 		if e != nil {
 			err = e
@@ -1457,7 +1456,7 @@ func (me *AntiddosService) DeleteCCLevelPolicy(ctx context.Context, instanceId,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1479,7 +1478,7 @@ func (me *AntiddosService) DeleteCCThresholdPolicy(ctx context.Context, instance
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 	return
@@ -1495,18 +1494,18 @@ func (me *AntiddosService) DescribeAntiddosBoundipById(ctx context.Context, id s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.InstanceList) < 1 {
 		return
@@ -1524,18 +1523,18 @@ func (me *AntiddosService) DescribeAntiddosPendingRiskInfoByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribePendingRiskInfo(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil {
 		pendingRiskInfoResponseParams = response.Response
@@ -1552,7 +1551,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewIndexByFilter(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1565,14 +1564,14 @@ func (me *AntiddosService) DescribeAntiddosOverviewIndexByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeOverviewIndex(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	describeOverviewIndexResponseParams = response.Response
 	return
 }
@@ -1585,7 +1584,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewDdosTrendByFilter(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1610,14 +1609,14 @@ func (me *AntiddosService) DescribeAntiddosOverviewDdosTrendByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeOverviewDDoSTrend(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil {
 		describeOverviewDDoSTrendResponseParams = response.Response
@@ -1634,7 +1633,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewDdosEventListByFilter(ctx con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1650,7 +1649,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewDdosEventListByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1664,7 +1663,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewDdosEventListByFilter(ctx con
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.EventList) < 1 {
 			break
@@ -1688,7 +1687,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewCcTrendByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1713,14 +1712,14 @@ func (me *AntiddosService) DescribeAntiddosOverviewCcTrendByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeOverviewCCTrend(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil {
 		overviewCCTrendResponseParams = response.Response
@@ -1737,18 +1736,18 @@ func (me *AntiddosService) DescribeAntiddosDdosBlackWhiteIpListById(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeDDoSBlackWhiteIpList(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	ddosBlackWhiteIpListResponseParams = response.Response
 
@@ -1776,18 +1775,18 @@ func (me *AntiddosService) DeleteAntiddosDdosBlackWhiteIpListById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeleteDDoSBlackWhiteIpList(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1800,7 +1799,7 @@ func (me *AntiddosService) DescribeAntiddosBasicDeviceStatusByFilter(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1816,14 +1815,14 @@ func (me *AntiddosService) DescribeAntiddosBasicDeviceStatusByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeBasicDeviceStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	basicDeviceStatus = response.Response
 	return
@@ -1837,7 +1836,7 @@ func (me *AntiddosService) DescribeAntiddosBgpBizTrendByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1862,14 +1861,14 @@ func (me *AntiddosService) DescribeAntiddosBgpBizTrendByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeBgpBizTrend(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -1887,18 +1886,18 @@ func (me *AntiddosService) DescribeAntiddosListListenerByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeListListener(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	listListener = response.Response
 	return
@@ -1912,7 +1911,7 @@ func (me *AntiddosService) DescribeAntiddosOverviewAttackTrendByFilter(ctx conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1934,14 +1933,14 @@ func (me *AntiddosService) DescribeAntiddosOverviewAttackTrendByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeOverviewAttackTrend(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -1959,11 +1958,11 @@ func (me *AntiddosService) DescribeAntiddosDdosGeoIpBlockConfigById(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1977,7 +1976,7 @@ func (me *AntiddosService) DescribeAntiddosDdosGeoIpBlockConfigById(ctx context.
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ConfigList) < 1 {
 			break
@@ -2002,18 +2001,18 @@ func (me *AntiddosService) DeleteAntiddosDdosGeoIpBlockConfigById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeleteDDoSGeoIPBlockConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2026,11 +2025,11 @@ func (me *AntiddosService) DescribeAntiddosDdosSpeedLimitConfigById(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2044,7 +2043,7 @@ func (me *AntiddosService) DescribeAntiddosDdosSpeedLimitConfigById(ctx context.
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ConfigList) < 1 {
 			break
@@ -2069,18 +2068,18 @@ func (me *AntiddosService) DeleteAntiddosDdosSpeedLimitConfigById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeleteDDoSSpeedLimitConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2094,18 +2093,18 @@ func (me *AntiddosService) DescribeAntiddosDefaultAlarmThresholdById(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeDefaultAlarmThreshold(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DefaultAlarmConfigList) < 1 {
 		return
@@ -2125,18 +2124,18 @@ func (me *AntiddosService) DescribeAntiddosSchedulingDomainUserNameById(ctx cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeListSchedulingDomain(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DomainList) < 1 {
 		return
@@ -2158,18 +2157,18 @@ func (me *AntiddosService) DescribeAntiddosIpAlarmThresholdConfigById(ctx contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DescribeListIPAlarmConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.ConfigList) < 1 {
 		return
@@ -2187,11 +2186,11 @@ func (me *AntiddosService) DescribeAntiddosPacketFilterConfigById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -2205,7 +2204,7 @@ func (me *AntiddosService) DescribeAntiddosPacketFilterConfigById(ctx context.Co
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ConfigList) < 1 {
 			break
@@ -2230,18 +2229,18 @@ func (me *AntiddosService) DeleteAntiddosPacketFilterConfigById(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeletePacketFilterConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2254,11 +2253,11 @@ func (me *AntiddosService) DescribeAntiddosPortAclConfigById(ctx context.Context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2272,7 +2271,7 @@ func (me *AntiddosService) DescribeAntiddosPortAclConfigById(ctx context.Context
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.AclList) < 1 {
 			break
@@ -2297,18 +2296,18 @@ func (me *AntiddosService) DeleteAntiddosPortAclConfigById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeletePortAclConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2325,11 +2324,11 @@ func (me *AntiddosService) DescribeAntiddosCcBlackWhiteIpById(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2343,7 +2342,7 @@ func (me *AntiddosService) DescribeAntiddosCcBlackWhiteIpById(ctx context.Contex
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CcBlackWhiteIpList) < 1 {
 			break
@@ -2368,18 +2367,18 @@ func (me *AntiddosService) DeleteAntiddosCcBlackWhiteIpById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeleteCcBlackWhiteIpList(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2396,11 +2395,11 @@ func (me *AntiddosService) DescribeAntiddosCcPrecisionPolicyById(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2414,7 +2413,7 @@ func (me *AntiddosService) DescribeAntiddosCcPrecisionPolicyById(ctx context.Con
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.PrecisionPolicyList) < 1 {
 			break
@@ -2438,18 +2437,18 @@ func (me *AntiddosService) DeleteAntiddosCcPrecisionPolicyById(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAntiddosClient().DeleteCCPrecisionPolicy(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2464,12 +2463,12 @@ func (me *AntiddosService) DescribeAntiddosBgpInstancesById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseAntiddosClient().DescribeBgpInstances(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -2501,7 +2500,7 @@ func (me *AntiddosService) DescribeAntiddosBgpInstancesByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2526,12 +2525,12 @@ func (me *AntiddosService) DescribeAntiddosBgpInstancesByFilter(ctx context.Cont
 		request.Offset = &offset
 		request.Limit = &limit
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseAntiddosClient().DescribeBgpInstances(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.BGPInstanceList == nil {
@@ -11,7 +11,6 @@ import (
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudAPIGatewayAPI() *schema.Resource {
@@ -1109,7 +1108,7 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().CreateApi(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1891,7 +1890,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().ModifyApi(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apiGateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -97,7 +98,7 @@ func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta int
 			return tccommon.RetryError(err)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		response = result
@@ -226,7 +227,7 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -236,10 +237,10 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := tccommon.BuildTagResourceName("apigateway", "apiAppId", tcClient.Region, apiAppId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -554,10 +554,10 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := tccommon.BuildTagResourceName("apigw", "service", tcClient.Region, serviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -14,7 +14,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewAPIGatewayService(client *connectivity.TencentCloudClient) APIGatewayService {
@@ -28,7 +27,7 @@ type APIGatewayService struct {
 func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string) (accessKeyId string, errRet error) {
 	request := apigateway.NewCreateApiKeyRequest()
 	request.SecretName = &secretName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateApiKey(request)
 	if err != nil {
 		errRet = err
@@ -45,7 +44,7 @@ func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string
 func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewEnableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().EnableApiKey(request)
 	if err != nil {
 		errRet = err
@@ -65,7 +64,7 @@ func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId strin
 func (me *APIGatewayService) DisableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDisableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DisableApiKey(request)
 	if err != nil {
 		errRet = err
@@ -121,7 +120,7 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiKeysStatus(request)
 		if err != nil {
 			errRet = err
@@ -144,7 +143,7 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 func (me *APIGatewayService) DeleteApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDeleteApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApiKey(request)
 	if err != nil {
 		errRet = err
@@ -175,7 +174,7 @@ func (me *APIGatewayService) CreateUsagePlan(ctx context.Context, usagePlanName
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseAPIGatewayClient().CreateUsagePlan(request)
 		if err != nil {
@@ -196,7 +195,7 @@ func (me *APIGatewayService) DescribeUsagePlan(ctx context.Context, usagePlanId
 	request := apigateway.NewDescribeUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeUsagePlan(request)
 	if err != nil {
@@ -219,7 +218,7 @@ func (me *APIGatewayService) DeleteUsagePlan(ctx context.Context, usagePlanId st
 	request := apigateway.NewDeleteUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DeleteUsagePlan(request)
 
@@ -247,7 +246,7 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request := apigateway.NewModifyUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	request.UsagePlanName = &usagePlanName
 	if usagePlanDesc != nil {
 		request.UsagePlanDesc = usagePlanDesc
@@ -255,7 +254,7 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request.MaxRequestNum = &maxRequestNum
 	request.MaxRequestNumPreSec = &maxRequestNumPreSec
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().ModifyUsagePlan(request)
 	if err != nil {
@@ -283,7 +282,7 @@ func (me *APIGatewayService) DescribeUsagePlanEnvironments(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanEnvironments(request)
 		if err != nil {
 			errRet = err
@@ -328,7 +327,7 @@ func (me *APIGatewayService) DescribeUsagePlansStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlansStatus(request)
 		if err != nil {
 			errRet = err
@@ -364,7 +363,7 @@ func (me *APIGatewayService) DescribeIPStrategysStatus(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
 	if err != nil {
 		errRet = err
@@ -396,7 +395,7 @@ func (me *APIGatewayService) DescribeIPStrategies(ctx context.Context, serviceId
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
 		if err != nil {
 			errRet = err
@@ -430,7 +429,7 @@ func (me *APIGatewayService) DescribeServiceSubDomains(ctx context.Context, serv
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
 		if err != nil {
 			errRet = err
@@ -457,7 +456,7 @@ func (me *APIGatewayService) BindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
 
 	if err != nil {
@@ -482,7 +481,7 @@ func (me *APIGatewayService) BindSecretIds(ctx context.Context, usagePlanId stri
 		request.AccessKeyIds = append(request.AccessKeyIds, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
 
 	if err != nil {
@@ -517,7 +516,7 @@ func (me *APIGatewayService) UnBindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
 
 	if err != nil {
@@ -569,7 +568,7 @@ func (me *APIGatewayService) CreateService(ctx context.Context,
 	}
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateService(request)
 
 	if err != nil {
@@ -584,7 +583,7 @@ func (me *APIGatewayService) DescribeService(ctx context.Context, serviceId stri
 	request := apigateway.NewDescribeServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeService(request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
@@ -612,7 +611,7 @@ func (me *APIGatewayService) ModifyService(ctx context.Context,
 	request.ServiceDesc = &serviceDesc
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAPIGatewayClient().ModifyService(request)
 	if err != nil {
 		errRet = err
@@ -627,7 +626,7 @@ func (me *APIGatewayService) DeleteService(ctx context.Context,
 	request := apigateway.NewDeleteServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteService(request)
 	if err != nil {
 		errRet = err
@@ -649,7 +648,7 @@ func (me *APIGatewayService) UnReleaseService(ctx context.Context, serviceId, en
 	request.ServiceId = &serviceId
 	request.EnvironmentName = &environment
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnReleaseService(request)
 	if err != nil {
 		errRet = err
@@ -677,7 +676,7 @@ func (me *APIGatewayService) DescribeServiceUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceUsagePlan(request)
 		if err != nil {
 			errRet = err
@@ -709,7 +708,7 @@ func (me *APIGatewayService) DescribeApiUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlan(request)
 		if err != nil {
 			errRet = err
@@ -741,7 +740,7 @@ func (me *APIGatewayService) DescribeApiUsagePlanSecretIds(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanSecretIds(request)
 		if err != nil {
 			errRet = err
@@ -776,7 +775,7 @@ func (me *APIGatewayService) BindEnvironment(ctx context.Context,
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseAPIGatewayClient().BindEnvironment(request)
 		if err != nil {
@@ -809,18 +808,18 @@ func (me *APIGatewayService) UnBindSecretIds(ctx context.Context, usagePlanId st
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -839,7 +838,7 @@ func (me *APIGatewayService) UnBindEnvironment(ctx context.Context,
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, errRet := me.client.UseAPIGatewayClient().UnBindEnvironment(request)
 		if errRet != nil {
@@ -868,7 +867,7 @@ func (me *APIGatewayService) DescribeApi(ctx context.Context,
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeApi(request)
 	if err != nil {
@@ -895,7 +894,7 @@ func (me *APIGatewayService) DeleteApi(ctx context.Context, serviceId,
 	request := apigateway.NewDeleteApiRequest()
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApi(request)
 	if err != nil {
 		errRet = err
@@ -940,7 +939,7 @@ func (me *APIGatewayService) DescribeServicesStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServicesStatus(request)
 		if err != nil {
 			errRet = err
@@ -988,7 +987,7 @@ func (me *APIGatewayService) DescribeApisStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApisStatus(request)
 		if err != nil {
 			errRet = err
@@ -1030,7 +1029,7 @@ func (me *APIGatewayService) DescribeServiceEnvironmentStrategyList(ctx context.
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeServiceEnvironmentStrategy(request)
 			if err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
@@ -1091,7 +1090,7 @@ func (me *APIGatewayService) DescribeApiEnvironmentStrategyList(ctx context.Cont
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeApiEnvironmentStrategy(request)
 			if err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
@@ -1137,7 +1136,7 @@ func (me *APIGatewayService) ModifyApiEnvironmentStrategy(ctx context.Context,
 	request.ApiIds = append(request.ApiIds, helper.Strings(apiIDs)...)
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifyApiEnvironmentStrategy(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1175,7 +1174,7 @@ func (me *APIGatewayService) ModifyServiceEnvironmentStrategy(ctx context.Contex
 	request.EnvironmentNames = append(request.EnvironmentNames, helper.Strings(environmentName)...)
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifyServiceEnvironmentStrategy(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1226,7 +1225,7 @@ func (me *APIGatewayService) BindSubDomainService(ctx context.Context,
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseAPIGatewayClient().BindSubDomain(request)
 		if err != nil {
 			if ee, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -1262,7 +1261,7 @@ func (me *APIGatewayService) DescribeServiceSubDomainsService(ctx context.Contex
 		request.Limit = &limit
 		request.Offset = &offset
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
 			if err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
@@ -1298,7 +1297,7 @@ func (me *APIGatewayService) DescribeServiceSubDomainMappings(ctx context.Contex
 	request.SubDomain = &subDomain
 
 	if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomainMappings(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -1349,7 +1348,7 @@ func (me *APIGatewayService) ModifySubDomainService(ctx context.Context,
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ModifySubDomain(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1384,7 +1383,7 @@ func (me *APIGatewayService) UnBindSubDomainService(ctx context.Context,
 	request.SubDomain = &subDomain
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().UnBindSubDomain(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1415,7 +1414,7 @@ func (me *APIGatewayService) CreateIPStrategy(ctx context.Context,
 	request.StrategyType = &strategyType
 	request.StrategyData = &strategyData
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().CreateIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1435,7 +1434,7 @@ func (me *APIGatewayService) DescribeIPStrategyHas(ctx context.Context,
 	request := apigateway.NewDescribeIPStrategysStatusRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.Code == SERVICE_ERR_CODE {
@@ -1477,7 +1476,7 @@ func (me *APIGatewayService) DescribeIPStrategyStatus(ctx context.Context, servi
 		for {
 			request.Limit = &limit
 			request.Offset = &offset
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
 			if err != nil {
 				errRet = err
@@ -1507,7 +1506,7 @@ func (me *APIGatewayService) UpdateIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 	request.StrategyData = &strategyData
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().ModifyIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1529,7 +1528,7 @@ func (me *APIGatewayService) DeleteIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1555,7 +1554,7 @@ func (me *APIGatewayService) CreateStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.BindApiIds = bindarr
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().BindIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1578,7 +1577,7 @@ func (me *APIGatewayService) DeleteStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.UnBindApiIds = unBindarr
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().UnBindIPStrategy(request)
 	if err != nil {
 		errRet = err
@@ -1623,7 +1622,7 @@ func (me *APIGatewayService) ReleaseService(ctx context.Context,
 	request.ReleaseDesc = &releaseDesc
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseAPIGatewayClient().ReleaseService(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1650,7 +1649,7 @@ func (me *APIGatewayService) DescribeServiceEnvironmentReleaseHistory(ctx contex
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeServiceEnvironmentReleaseHistory(request)
 		if err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
@@ -1683,18 +1682,18 @@ func (me *APIGatewayService) DescribeApiGatewayPluginById(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribePlugins(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Result.PluginSet) < 1 {
 		return
@@ -1712,18 +1711,18 @@ func (me *APIGatewayService) DeleteApiGatewayPluginById(ctx context.Context, plu
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DeletePlugin(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1736,18 +1735,18 @@ func (me *APIGatewayService) DescribeApiGatewayPluginAttachmentById(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribePluginApis(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Result.AttachedApis) < 1 {
 		return
@@ -1773,18 +1772,18 @@ func (me *APIGatewayService) DeleteApiGatewayPluginAttachmentById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DetachPlugin(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1798,22 +1797,22 @@ func (me *APIGatewayService) DescribeApiDoc(ctx context.Context, apiDocId string
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.ApiDocId = &apiDocId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeAPIDocDetail(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response == nil {
 		return
 	}
@@ -1831,7 +1830,7 @@ func (me *APIGatewayService) DescribeApiDocList(ctx context.Context) (apiDoc []*
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1841,17 +1840,17 @@ func (me *APIGatewayService) DescribeApiDocList(ctx context.Context) (apiDoc []*
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeAPIDocs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || *response.Response.Result.TotalCount == 0 {
 			break
@@ -1875,11 +1874,11 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIDocById(ctx context.Context, api
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteAPIDoc(request)
 	if err != nil {
 		errRet = err
@@ -1887,7 +1886,7 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIDocById(ctx context.Context, api
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -1900,7 +1899,7 @@ func (me *APIGatewayService) DescribeApiApp(ctx context.Context, apiAppId string
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1911,17 +1910,17 @@ func (me *APIGatewayService) DescribeApiApp(ctx context.Context, apiAppId string
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response == nil || *response.Response.Result.TotalCount == 0 {
 		return
 	}
@@ -1939,7 +1938,7 @@ func (me *APIGatewayService) DescribeApiAppList(ctx context.Context, apiAppId, a
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1966,16 +1965,16 @@ func (me *APIGatewayService) DescribeApiAppList(ctx context.Context, apiAppId, a
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || *response.Response.Result.TotalCount == 0 {
 			break
@@ -1997,11 +1996,11 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIAppById(ctx context.Context, api
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAPIGatewayClient().DeleteApiApp(request)
 	if err != nil {
 		errRet = err
@@ -2009,7 +2008,7 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIAppById(ctx context.Context, api
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -2035,18 +2034,18 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppAttachmentById(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeApiAppBindApisStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Result.ApiAppApiSet) < 1 {
 		return
@@ -2067,11 +2066,11 @@ func (me *APIGatewayService) DeleteAPIGatewayApiAppAttachmentById(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().UnbindApiApp(request)
 	if err != nil {
@@ -2079,7 +2078,7 @@ func (me *APIGatewayService) DeleteAPIGatewayApiAppAttachmentById(ctx context.Co
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2099,11 +2098,11 @@ func (me *APIGatewayService) DescribeApigatewayUpstreamById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeUpstreams(request)
 	if err != nil {
@@ -2111,7 +2110,7 @@ func (me *APIGatewayService) DescribeApigatewayUpstreamById(ctx context.Context,
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Result.UpstreamSet) < 1 {
 		return
@@ -2129,11 +2128,11 @@ func (me *APIGatewayService) DeleteApigatewayUpstreamById(ctx context.Context, u
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DeleteUpstream(request)
 	if err != nil {
@@ -2141,7 +2140,7 @@ func (me *APIGatewayService) DeleteApigatewayUpstreamById(ctx context.Context, u
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2154,7 +2153,7 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2170,7 +2169,7 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -2185,7 +2184,7 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.ApiSet) < 1 {
 			break
@@ -2209,7 +2208,7 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2222,7 +2221,7 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2237,7 +2236,7 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.BindApiSet) < 1 {
 			break
@@ -2261,7 +2260,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2271,7 +2270,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -2287,7 +2286,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.ApiUsagePlanList) < 1 {
 			break
@@ -2312,7 +2311,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppServiceByFilter(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2325,7 +2324,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppServiceByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeServiceForApiApp(request)
 	if err != nil {
@@ -2333,7 +2332,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppServiceByFilter(ctx context
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -2352,7 +2351,7 @@ func (me *APIGatewayService) DescribeApiGatewayBindApiAppsStatusByFilter(ctx con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2370,7 +2369,7 @@ func (me *APIGatewayService) DescribeApiGatewayBindApiAppsStatusByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -2385,7 +2384,7 @@ func (me *APIGatewayService) DescribeApiGatewayBindApiAppsStatusByFilter(ctx con
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.ApiAppApiSet) < 1 {
 			break
@@ -2410,7 +2409,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiAppApiByFilter(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2428,7 +2427,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiAppApiByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeApiForApiApp(request)
 	if err != nil {
@@ -2436,7 +2435,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiAppApiByFilter(ctx context.Con
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -2454,7 +2453,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiPluginsByFilter(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2472,7 +2471,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiPluginsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -2487,7 +2486,7 @@ func (me *APIGatewayService) DescribeApiGatewayApiPluginsByFilter(ctx context.Co
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.PluginSummary) < 1 {
 			break
@@ -2513,11 +2512,11 @@ func (me *APIGatewayService) DescribeApiGatewayImportOpenApiById(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DescribeApi(request)
 	if err != nil {
@@ -2525,7 +2524,7 @@ func (me *APIGatewayService) DescribeApiGatewayImportOpenApiById(ctx context.Con
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -2544,11 +2543,11 @@ func (me *APIGatewayService) DeleteApiGatewayImportOpenApiById(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAPIGatewayClient().DeleteApi(request)
 	if err != nil {
@@ -2556,7 +2555,7 @@ func (me *APIGatewayService) DeleteApiGatewayImportOpenApiById(ctx context.Conte
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2569,7 +2568,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceReleaseVersionsByFilter(ct
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2579,7 +2578,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceReleaseVersionsByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2594,7 +2593,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceReleaseVersionsByFilter(ct
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.VersionList) < 1 {
 			break
@@ -2619,7 +2618,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceEnvironmentListByFilter(ct
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2629,7 +2628,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceEnvironmentListByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2644,7 +2643,7 @@ func (me *APIGatewayService) DescribeApiGatewayServiceEnvironmentListByFilter(ct
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Result.EnvironmentList) < 1 {
 			break
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apm/v20210622"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -369,7 +370,7 @@ func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interfac
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -591,7 +592,7 @@ func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interfac
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success,  configRequest body [%s], response body [%s]\n", logId, configRequest.GetAction(), configRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success,  configRequest body [%s], response body [%s]\n", logId, configRequest.GetAction(), connectivity.RedactJSON(configRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -1039,7 +1040,7 @@ func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interfac
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
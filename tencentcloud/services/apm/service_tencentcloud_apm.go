@@ -12,7 +12,6 @@ import (
 	apm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apm/v20210622"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ApmService struct {
@@ -28,17 +27,17 @@ func (me *ApmService) DescribeApmInstanceById(ctx context.Context, instanceId st
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmInstances(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.Instances == nil || len(result.Response.Instances) < 1 {
@@ -67,17 +66,17 @@ func (me *ApmService) DescribeApmAgentById(ctx context.Context, instanceId strin
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmAgent(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -105,17 +104,17 @@ func (me *ApmService) DeleteApmInstanceById(ctx context.Context, instanceId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().TerminateApmInstance(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -138,17 +137,17 @@ func (me *ApmService) DescribeApmSampleConfigById(ctx context.Context, instanceI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmSampleConfig(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.ApmSampleConfigs == nil || len(result.Response.ApmSampleConfigs) == 0 {
@@ -178,17 +177,17 @@ func (me *ApmService) DescribeApmApplicationConfigById(ctx context.Context, inst
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmApplicationConfig(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.ApmAppConfig == nil {
@@ -218,17 +217,17 @@ func (me *ApmService) DescribeApmAssociationById(ctx context.Context, instanceId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmAssociation(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.ApmAssociation == nil {
@@ -257,17 +256,17 @@ func (me *ApmService) DescribeApmPrometheusRuleById(ctx context.Context, instanc
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmPrometheusRule(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.ApmPrometheusRules == nil || len(result.Response.ApmPrometheusRules) == 0 {
@@ -328,17 +327,17 @@ func (me *ApmService) DescribeApmInstances(ctx context.Context, params map[strin
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseApmClient().DescribeApmInstances(request)
 		if err != nil {
 			return tccommon.RetryError(err)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		response = result
@@ -13,6 +13,7 @@ import (
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -166,7 +167,7 @@ func resourceTencentCloudAsLifecycleHookCreate(d *schema.ResourceData, meta inte
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().CreateLifecycleHook(request)
 		if err != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if strings.Contains(e.GetCode(), "LimitExceeded.QuotaNotEnough") {
 					return resource.RetryableError(err)
@@ -176,7 +177,7 @@ func resourceTencentCloudAsLifecycleHookCreate(d *schema.ResourceData, meta inte
 			return tccommon.RetryError(err)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		if response == nil || response.Response == nil || response.Response.LifecycleHookId == nil {
 			return resource.NonRetryableError(fmt.Errorf("AS LifecycleHook not exists"))
 		}
@@ -314,11 +315,11 @@ func resourceTencentCloudAsLifecycleHookUpdate(d *schema.ResourceData, meta inte
 	response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().UpgradeLifecycleHook(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
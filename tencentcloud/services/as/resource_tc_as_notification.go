@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -70,11 +71,11 @@ func resourceTencentCloudAsNotificationCreate(d *schema.ResourceData, meta inter
 	response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().CreateNotificationConfiguration(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.AutoScalingNotificationId == nil {
 		return fmt.Errorf("scaling policy id is nil")
@@ -140,11 +141,11 @@ func resourceTencentCloudAsNotificationUpdate(d *schema.ResourceData, meta inter
 	response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().ModifyNotificationConfiguration(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
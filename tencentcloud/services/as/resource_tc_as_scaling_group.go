@@ -13,8 +13,8 @@ import (
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudAsScalingGroup() *schema.Resource {
@@ -404,17 +404,17 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 
 	var id string
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().CreateAutoScalingGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err)
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.AutoScalingGroupId == nil {
 			err = fmt.Errorf("Create auto scaling group failed, Auto scaling group id is nil.")
@@ -699,16 +699,16 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 	if len(updateAttrs) > 0 {
 		if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			response, err := client.UseAsClient().ModifyAutoScalingGroup(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), err.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 				return tccommon.RetryError(err)
 			}
 
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 			return nil
 		}); err != nil {
@@ -759,17 +759,17 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 	if len(updateAttrs) > 0 {
 		if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(balancerRequest.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(balancerRequest.GetAction())
 
 			balancerResponse, err := client.UseAsClient().ModifyLoadBalancers(balancerRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, balancerRequest.GetAction(), balancerRequest.ToJsonString(), err.Error())
+					logId, balancerRequest.GetAction(), connectivity.RedactJSON(balancerRequest.ToJsonString()), err.Error())
 				return tccommon.RetryError(err)
 			}
 
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, balancerRequest.GetAction(), balancerRequest.ToJsonString(), balancerResponse.ToJsonString())
+				logId, balancerRequest.GetAction(), connectivity.RedactJSON(balancerRequest.ToJsonString()), connectivity.RedactJSON(balancerResponse.ToJsonString()))
 
 			return nil
 		}); err != nil {
@@ -777,8 +777,8 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldValue, newValue := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		resourceName := tccommon.BuildTagResourceName("as", "auto-scaling-group", region, d.Id())
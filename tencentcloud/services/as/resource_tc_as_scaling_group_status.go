@@ -5,6 +5,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -111,7 +112,7 @@ func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableAsRequest.GetAction(), enableAsRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableAsRequest.GetAction(), connectivity.RedactJSON(enableAsRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -126,7 +127,7 @@ func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableAsRequest.GetAction(), enableAsRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableAsRequest.GetAction(), connectivity.RedactJSON(enableAsRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -13,7 +13,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewAsService(client *connectivity.TencentCloudClient) AsService {
@@ -28,11 +27,11 @@ func (me *AsService) DescribeLaunchConfigurationById(ctx context.Context, config
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeLaunchConfigurationsRequest()
 	request.LaunchConfigurationIds = []*string{&configurationId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -72,11 +71,11 @@ func (me *AsService) DescribeLaunchConfigurationByFilter(ctx context.Context, co
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
@@ -98,11 +97,11 @@ func (me *AsService) DeleteLaunchConfiguration(ctx context.Context, configuratio
 	request.LaunchConfigurationId = &configurationId
 
 	err := resource.Retry(4*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, e := me.client.UseAsClient().DeleteLaunchConfiguration(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		return nil
@@ -118,11 +117,11 @@ func (me *AsService) DescribeAutoScalingGroupById(ctx context.Context, scalingGr
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeAutoScalingGroupsRequest()
 	request.AutoScalingGroupIds = []*string{&scalingGroupId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -182,11 +181,11 @@ func (me *AsService) DescribeAutoScalingGroupByFilter(
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
@@ -210,11 +209,11 @@ func (me *AsService) ClearScalingGroupInstance(ctx context.Context, scalingGroup
 	request.MinSize = helper.IntUint64(0)
 	request.MaxSize = helper.IntUint64(0)
 	request.DesiredCapacity = helper.IntUint64(0)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -224,11 +223,11 @@ func (me *AsService) DeleteScalingGroup(ctx context.Context, scalingGroupId stri
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDeleteAutoScalingGroupRequest()
 	request.AutoScalingGroupId = &scalingGroupId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteAutoScalingGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -242,11 +241,11 @@ func (me *AsService) AttachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().AttachInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	activityId := *response.Response.ActivityId
@@ -274,11 +273,11 @@ func (me *AsService) DescribeActivityById(ctx context.Context, activityId string
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeAutoScalingActivitiesRequest()
 	request.ActivityIds = []*string{&activityId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingActivities(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -297,11 +296,11 @@ func (me *AsService) DetachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DetachInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	activityId := *response.Response.ActivityId
@@ -334,11 +333,11 @@ func (me *AsService) DescribeAutoScalingAttachment(ctx context.Context, scalingG
 			Values: []*string{&scalingGroupId},
 		},
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -356,11 +355,11 @@ func (me *AsService) DescribeScalingPolicyById(ctx context.Context, scalingPolic
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeScalingPoliciesRequest()
 	request.AutoScalingPolicyIds = []*string{&scalingPolicyId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -404,11 +403,11 @@ func (me *AsService) DescribeScalingPolicyByFilter(ctx context.Context, policyId
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
@@ -428,11 +427,11 @@ func (me *AsService) DeleteScalingPolicy(ctx context.Context, scalingPolicyId st
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDeleteScalingPolicyRequest()
 	request.AutoScalingPolicyId = &scalingPolicyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteScalingPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -442,7 +441,7 @@ func (me *AsService) DescribeScheduledActionById(ctx context.Context, scheduledA
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeScheduledActionsRequest()
 	request.ScheduledActionIds = []*string{&scheduledActionId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeScheduledActions(request)
 	if err != nil {
 		sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -451,7 +450,7 @@ func (me *AsService) DescribeScheduledActionById(ctx context.Context, scheduledA
 			return
 		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -469,11 +468,11 @@ func (me *AsService) ModifyAutoScalingGroup(ctx context.Context, request *as.Mod
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
 
 	if err != nil {
@@ -482,7 +481,7 @@ func (me *AsService) ModifyAutoScalingGroup(ctx context.Context, request *as.Mod
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -491,11 +490,11 @@ func (me *AsService) DeleteScheduledAction(ctx context.Context, scheduledActonId
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDeleteScheduledActionRequest()
 	request.ScheduledActionId = &scheduledActonId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteScheduledAction(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -505,11 +504,11 @@ func (me *AsService) DescribeLifecycleHookById(ctx context.Context, lifecycleHoo
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeLifecycleHooksRequest()
 	request.LifecycleHookIds = []*string{&lifecycleHookId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeLifecycleHooks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -525,11 +524,11 @@ func (me *AsService) DeleteLifecycleHook(ctx context.Context, lifecycleHookId st
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDeleteLifecycleHookRequest()
 	request.LifecycleHookId = &lifecycleHookId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteLifecycleHook(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -539,11 +538,11 @@ func (me *AsService) DescribeNotificationById(ctx context.Context, notificationI
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDescribeNotificationConfigurationsRequest()
 	request.AutoScalingNotificationIds = []*string{&notificationId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeNotificationConfigurations(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -559,11 +558,11 @@ func (me *AsService) DeleteNotification(ctx context.Context, notificationId stri
 	logId := tccommon.GetLogId(ctx)
 	request := as.NewDeleteNotificationConfigurationRequest()
 	request.AutoScalingNotificationId = &notificationId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteNotificationConfiguration(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -577,7 +576,7 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -590,7 +589,7 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -604,7 +603,7 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.AutoScalingInstanceSet) < 1 {
 			break
@@ -664,7 +663,7 @@ func (me *AsService) DescribeAsAdvices(ctx context.Context, param map[string]int
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -674,14 +673,14 @@ func (me *AsService) DescribeAsAdvices(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAsClient().DescribeAutoScalingAdvices(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	advices = response.Response.AutoScalingAdviceSet
 
@@ -696,18 +695,18 @@ func (me *AsService) DescribeAsLimits(ctx context.Context) (limits *as.DescribeA
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAsClient().DescribeAccountLimits(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	limits = response.Response
 
@@ -722,7 +721,7 @@ func (me *AsService) DescribeAsLastActivity(ctx context.Context, param map[strin
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -736,14 +735,14 @@ func (me *AsService) DescribeAsLastActivity(ctx context.Context, param map[strin
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingGroupLastActivities(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	lastActivity = response.Response.ActivitySet
 	return
 }
@@ -755,12 +754,12 @@ func (me *AsService) DescribeAsLoadBalancerById(ctx context.Context, autoScaling
 	response := as.NewDescribeAutoScalingGroupsResponse()
 	request.AutoScalingGroupIds = []*string{&autoScalingGroupId}
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		response = result
@@ -788,18 +787,18 @@ func (me *AsService) DeleteAsLoadBalancerById(ctx context.Context, autoScalingGr
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseAsClient().ModifyLoadBalancers(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -10,6 +10,7 @@ import (
 	bhv20230418 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/bh/v20230418"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -237,7 +238,7 @@ func resourceTencentCloudBhResourceCreate(d *schema.ResourceData, meta interface
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -308,7 +309,7 @@ func resourceTencentCloudBhResourceCreate(d *schema.ResourceData, meta interface
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, deployReq.GetAction(), deployReq.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, deployReq.GetAction(), connectivity.RedactJSON(deployReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -327,7 +328,7 @@ func resourceTencentCloudBhResourceCreate(d *schema.ResourceData, meta interface
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
@@ -490,7 +491,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -509,7 +510,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
@@ -556,7 +557,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -575,7 +576,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
@@ -606,7 +607,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -625,7 +626,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
@@ -663,7 +664,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -682,7 +683,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
@@ -713,7 +714,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -732,7 +733,7 @@ func resourceTencentCloudBhResourceUpdate(d *schema.ResourceData, meta interface
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), connectivity.RedactJSON(waitReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					if result == nil || result.Response == nil || result.Response.ResourceSet == nil || len(result.Response.ResourceSet) == 0 {
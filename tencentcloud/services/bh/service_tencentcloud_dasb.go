@@ -12,7 +12,6 @@ import (
 	dasb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dasb/v20191018"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DasbService struct {
@@ -27,11 +26,11 @@ func (me *DasbService) DescribeDasbAclById(ctx context.Context, aclId string) (a
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeAcls(request)
 	if err != nil {
@@ -39,7 +38,7 @@ func (me *DasbService) DescribeDasbAclById(ctx context.Context, aclId string) (a
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.AclSet) != 1 {
 		return
@@ -58,11 +57,11 @@ func (me *DasbService) DeleteDasbAclById(ctx context.Context, aclId string) (err
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteAcls(request)
 	if err != nil {
@@ -70,7 +69,7 @@ func (me *DasbService) DeleteDasbAclById(ctx context.Context, aclId string) (err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -84,11 +83,11 @@ func (me *DasbService) DescribeDasbCmdTemplateById(ctx context.Context, template
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeCmdTemplates(request)
 	if err != nil {
@@ -96,7 +95,7 @@ func (me *DasbService) DescribeDasbCmdTemplateById(ctx context.Context, template
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.CmdTemplateSet) != 1 {
 		return
@@ -115,11 +114,11 @@ func (me *DasbService) DeleteDasbCmdTemplateById(ctx context.Context, templateId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteCmdTemplates(request)
 	if err != nil {
@@ -127,7 +126,7 @@ func (me *DasbService) DeleteDasbCmdTemplateById(ctx context.Context, templateId
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -141,18 +140,18 @@ func (me *DasbService) DescribeDasbDeviceGroupById(ctx context.Context, deviceGr
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeDeviceGroups(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.GroupSet) != 1 {
 		return
@@ -171,11 +170,11 @@ func (me *DasbService) DeleteDasbDeviceGroupById(ctx context.Context, deviceGrou
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteDeviceGroups(request)
 	if err != nil {
@@ -183,7 +182,7 @@ func (me *DasbService) DeleteDasbDeviceGroupById(ctx context.Context, deviceGrou
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -197,11 +196,11 @@ func (me *DasbService) DescribeDasbUserById(ctx context.Context, userId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeUsers(request)
 	if err != nil {
@@ -209,7 +208,7 @@ func (me *DasbService) DescribeDasbUserById(ctx context.Context, userId string)
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.UserSet) != 1 {
 		return
@@ -228,11 +227,11 @@ func (me *DasbService) DeleteDasbUserById(ctx context.Context, userId string) (e
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteUsers(request)
 	if err != nil {
@@ -240,7 +239,7 @@ func (me *DasbService) DeleteDasbUserById(ctx context.Context, userId string) (e
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -254,11 +253,11 @@ func (me *DasbService) DescribeDasbDeviceAccountById(ctx context.Context, device
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeDeviceAccounts(request)
 	if err != nil {
@@ -266,7 +265,7 @@ func (me *DasbService) DescribeDasbDeviceAccountById(ctx context.Context, device
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.DeviceAccountSet) != 1 {
 		return
@@ -285,11 +284,11 @@ func (me *DasbService) DeleteDasbDeviceAccountById(ctx context.Context, deviceAc
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteDeviceAccounts(request)
 	if err != nil {
@@ -297,7 +296,7 @@ func (me *DasbService) DeleteDasbDeviceAccountById(ctx context.Context, deviceAc
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -312,11 +311,11 @@ func (me *DasbService) DescribeDasbDeviceGroupMembersById(ctx context.Context, d
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeDeviceGroupMembers(request)
 	if err != nil {
@@ -324,7 +323,7 @@ func (me *DasbService) DescribeDasbDeviceGroupMembersById(ctx context.Context, d
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.DeviceSet) < 1 {
 		return
@@ -356,11 +355,11 @@ func (me *DasbService) DeleteDasbDeviceGroupMembersById(ctx context.Context, dev
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteDeviceGroupMembers(request)
 	if err != nil {
@@ -368,7 +367,7 @@ func (me *DasbService) DeleteDasbDeviceGroupMembersById(ctx context.Context, dev
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -383,11 +382,11 @@ func (me *DasbService) DescribeDasbUserGroupMembersById(ctx context.Context, use
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeUserGroupMembers(request)
 	if err != nil {
@@ -395,7 +394,7 @@ func (me *DasbService) DescribeDasbUserGroupMembersById(ctx context.Context, use
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.UserSet) < 1 {
 		return
@@ -427,11 +426,11 @@ func (me *DasbService) DeleteDasbUserGroupMembersById(ctx context.Context, userG
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteUserGroupMembers(request)
 	if err != nil {
@@ -439,7 +438,7 @@ func (me *DasbService) DeleteDasbUserGroupMembersById(ctx context.Context, userG
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -452,11 +451,11 @@ func (me *DasbService) DescribeDasbResourceById(ctx context.Context, resourceId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeResources(request)
 	if err != nil {
@@ -464,7 +463,7 @@ func (me *DasbService) DescribeDasbResourceById(ctx context.Context, resourceId
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.ResourceSet) != 1 {
 		return
@@ -483,11 +482,11 @@ func (me *DasbService) DescribeDasbDeviceById(ctx context.Context, deviceId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeDevices(request)
 	if err != nil {
@@ -495,7 +494,7 @@ func (me *DasbService) DescribeDasbDeviceById(ctx context.Context, deviceId stri
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.DeviceSet) != 1 {
 		return
@@ -513,11 +512,11 @@ func (me *DasbService) DescribeDasbDeviceByResourceId(ctx context.Context, resou
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -533,7 +532,7 @@ func (me *DasbService) DescribeDasbDeviceByResourceId(ctx context.Context, resou
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.DeviceSet) < 1 {
 			break
@@ -559,11 +558,11 @@ func (me *DasbService) DeleteDasbDeviceById(ctx context.Context, deviceId string
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteDevices(request)
 	if err != nil {
@@ -571,7 +570,7 @@ func (me *DasbService) DeleteDasbDeviceById(ctx context.Context, deviceId string
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -585,11 +584,11 @@ func (me *DasbService) DescribeDasbUserGroupById(ctx context.Context, userGroupI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DescribeUserGroups(request)
 	if err != nil {
@@ -597,7 +596,7 @@ func (me *DasbService) DescribeDasbUserGroupById(ctx context.Context, userGroupI
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.GroupSet) != 1 {
 		return
@@ -616,11 +615,11 @@ func (me *DasbService) DeleteDasbUserGroupById(ctx context.Context, userGroupId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().DeleteUserGroups(request)
 	if err != nil {
@@ -628,7 +627,7 @@ func (me *DasbService) DeleteDasbUserGroupById(ctx context.Context, userGroupId
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -642,11 +641,11 @@ func (me *DasbService) DeleteDasbBindDeviceAccountPrivateKeyById(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().ResetDeviceAccountPrivateKey(request)
 	if err != nil {
@@ -654,7 +653,7 @@ func (me *DasbService) DeleteDasbBindDeviceAccountPrivateKeyById(ctx context.Con
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -668,11 +667,11 @@ func (me *DasbService) DeleteDasbBindDeviceAccountPasswordById(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseDasbClient().ResetDeviceAccountPassword(request)
 	if err != nil {
@@ -680,7 +679,7 @@ func (me *DasbService) DeleteDasbBindDeviceAccountPasswordById(ctx context.Conte
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -9,7 +9,6 @@ import (
 	bi "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/bi/v20220105"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type BiService struct {
@@ -24,11 +23,11 @@ func (me *BiService) DescribeBiDatasourceCloudById(ctx context.Context, projectI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -42,7 +41,7 @@ func (me *BiService) DescribeBiDatasourceCloudById(ctx context.Context, projectI
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -72,18 +71,18 @@ func (me *BiService) DeleteBiDatasourceCloudById(ctx context.Context, projectId,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DeleteDatasource(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -96,18 +95,18 @@ func (me *BiService) DescribeBiProjectById(ctx context.Context, projectId uint64
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DescribeProjectInfo(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	project = response.Response.Data
 	return
@@ -121,18 +120,18 @@ func (me *BiService) DeleteBiProjectById(ctx context.Context, projectId uint64)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DeleteProject(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -144,11 +143,11 @@ func (me *BiService) DescribeBiUserRoleById(ctx context.Context, userId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -162,7 +161,7 @@ func (me *BiService) DescribeBiUserRoleById(ctx context.Context, userId string)
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -191,18 +190,18 @@ func (me *BiService) DeleteBiUserRoleById(ctx context.Context, userId string) (e
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DeleteUserRole(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -215,11 +214,11 @@ func (me *BiService) DescribeBiProjectUserRoleById(ctx context.Context, projectI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -233,7 +232,7 @@ func (me *BiService) DescribeBiProjectUserRoleById(ctx context.Context, projectI
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -263,18 +262,18 @@ func (me *BiService) DeleteBiProjectUserRoleById(ctx context.Context, projectId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DeleteUserRoleProject(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -287,7 +286,7 @@ func (me *BiService) DescribeBiProjectByFilter(ctx context.Context, param map[st
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -306,7 +305,7 @@ func (me *BiService) DescribeBiProjectByFilter(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -320,7 +319,7 @@ func (me *BiService) DescribeBiProjectByFilter(ctx context.Context, param map[st
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -344,11 +343,11 @@ func (me *BiService) DescribeBiDatasourceById(ctx context.Context, projectId uin
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -362,7 +361,7 @@ func (me *BiService) DescribeBiDatasourceById(ctx context.Context, projectId uin
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -392,18 +391,18 @@ func (me *BiService) DeleteBiDatasourceById(ctx context.Context, projectId uint6
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseBiClient().DeleteDatasource(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -416,7 +415,7 @@ func (me *BiService) DescribeBiUserProjectByFilter(ctx context.Context, param ma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -429,7 +428,7 @@ func (me *BiService) DescribeBiUserProjectByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -443,7 +442,7 @@ func (me *BiService) DescribeBiUserProjectByFilter(ctx context.Context, param ma
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Data.List) < 1 {
 			break
@@ -0,0 +1,271 @@
+package cam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const CAM_POLICY_DOCUMENT_VERSION = "2.0"
+
+// NormalizeCamPolicyDocument validates a CAM policy document and returns it in the canonical
+// form CAM itself returns: lower-case keys and effects, `action`/`resource`/`principal` values
+// as sorted arrays without duplicates, and statements in a stable order.
+func NormalizeCamPolicyDocument(document string) (string, error) {
+	var raw interface{}
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return "", fmt.Errorf("policy document is not valid JSON: %s", err)
+	}
+
+	doc, ok := raw.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("policy document must be a JSON object")
+	}
+
+	doc, err := lowerCaseKeys(doc, "", "version", "statement")
+	if err != nil {
+		return "", err
+	}
+
+	if version, ok := doc["version"].(string); !ok || version != CAM_POLICY_DOCUMENT_VERSION {
+		return "", fmt.Errorf("`version` is required and must be `%s`", CAM_POLICY_DOCUMENT_VERSION)
+	}
+
+	var statements []interface{}
+	switch v := doc["statement"].(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	if len(statements) == 0 {
+		return "", fmt.Errorf("`statement` is required and must be a non-empty array of objects")
+	}
+
+	type keyedStatement struct {
+		key       string
+		statement map[string]interface{}
+	}
+
+	keyed := make([]keyedStatement, 0, len(statements))
+	seen := make(map[string]struct{}, len(statements))
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("statement[%d] must be an object", i)
+		}
+
+		statement, err := normalizeCamPolicyStatement(statement, fmt.Sprintf("statement[%d]", i))
+		if err != nil {
+			return "", err
+		}
+
+		key, err := marshalCamPolicy(statement)
+		if err != nil {
+			return "", err
+		}
+
+		// identical statements are merged
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		keyed = append(keyed, keyedStatement{key: key, statement: statement})
+	}
+
+	sort.Slice(keyed, func(i, j int) bool { return keyed[i].key < keyed[j].key })
+
+	normalized := make([]interface{}, 0, len(keyed))
+	for _, v := range keyed {
+		normalized = append(normalized, v.statement)
+	}
+
+	doc["statement"] = normalized
+	return marshalCamPolicy(doc)
+}
+
+func normalizeCamPolicyStatement(statement map[string]interface{}, path string) (map[string]interface{}, error) {
+	statement, err := lowerCaseKeys(statement, path+".", "sid", "effect", "action", "resource", "condition", "principal")
+	if err != nil {
+		return nil, err
+	}
+
+	effect, _ := statement["effect"].(string)
+	effect = strings.ToLower(effect)
+	if effect != "allow" && effect != "deny" {
+		return nil, fmt.Errorf("%s: `effect` must be `allow` or `deny`, got `%v`", path, statement["effect"])
+	}
+	statement["effect"] = effect
+
+	actions, err := stringOrStringArray(statement["action"], path+".action")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("%s: `action` is required", path)
+	}
+
+	for _, action := range actions {
+		if action == "*" {
+			continue
+		}
+
+		idx := strings.Index(action, ":")
+		if idx <= 0 || idx == len(action)-1 || strings.Count(action, ":") != 1 {
+			return nil, fmt.Errorf("%s: action `%s` must be `*` or in the form `service:Action`", path, action)
+		}
+	}
+	statement["action"] = actions
+
+	if v, ok := statement["resource"]; ok {
+		resources, err := stringOrStringArray(v, path+".resource")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			if resource == "*" {
+				continue
+			}
+
+			if err := validateQcsResource(resource); err != nil {
+				return nil, fmt.Errorf("%s: %s", path, err)
+			}
+		}
+		statement["resource"] = resources
+	}
+
+	if v, ok := statement["principal"]; ok {
+		principal, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: `principal` must be an object", path)
+		}
+
+		normalizedPrincipal := make(map[string]interface{}, len(principal))
+		for k, pv := range principal {
+			key := strings.ToLower(k)
+			values, err := stringOrStringArray(pv, path+".principal."+key)
+			if err != nil {
+				return nil, err
+			}
+
+			if key == "qcs" {
+				for _, value := range values {
+					if !strings.HasPrefix(value, "qcs::cam::") {
+						return nil, fmt.Errorf("%s: principal `%s` must start with `qcs::cam::`", path, value)
+					}
+				}
+			}
+			normalizedPrincipal[key] = values
+		}
+		statement["principal"] = normalizedPrincipal
+	}
+
+	if _, ok := statement["resource"]; !ok {
+		if _, ok := statement["principal"]; !ok {
+			return nil, fmt.Errorf("%s: one of `resource` or `principal` is required", path)
+		}
+	}
+
+	return statement, nil
+}
+
+// validateQcsResource checks the six-segment `qcs:project:service:region:account:resource` syntax.
+func validateQcsResource(resource string) error {
+	segments := strings.SplitN(resource, ":", 6)
+	if len(segments) != 6 || segments[0] != "qcs" {
+		return fmt.Errorf("resource `%s` must be `*` or in the form `qcs:project:service:region:account:resource`", resource)
+	}
+
+	if segments[2] == "" {
+		return fmt.Errorf("resource `%s` is missing the service segment", resource)
+	}
+
+	if segments[5] == "" {
+		return fmt.Errorf("resource `%s` is missing the resource segment", resource)
+	}
+
+	return nil
+}
+
+func lowerCaseKeys(m map[string]interface{}, path string, allowed ...string) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		key := strings.ToLower(k)
+		found := false
+		for _, a := range allowed {
+			if key == a {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unsupported element `%s%s`, supported elements are: %s", path, k, strings.Join(allowed, ", "))
+		}
+
+		if _, ok := result[key]; ok {
+			return nil, fmt.Errorf("duplicated element `%s%s`", path, key)
+		}
+
+		result[key] = v
+	}
+
+	return result, nil
+}
+
+func stringOrStringArray(v interface{}, path string) ([]string, error) {
+	var values []string
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		values = []string{value}
+	case []interface{}:
+		for _, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("`%s` must be a string or an array of strings", path)
+			}
+			values = append(values, s)
+		}
+	default:
+		return nil, fmt.Errorf("`%s` must be a string or an array of strings", path)
+	}
+
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, s := range values {
+		if s == "" {
+			return nil, fmt.Errorf("`%s` must not contain empty strings", path)
+		}
+
+		if _, ok := seen[s]; ok {
+			continue
+		}
+
+		seen[s] = struct{}{}
+		result = append(result, s)
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+func marshalCamPolicy(v interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
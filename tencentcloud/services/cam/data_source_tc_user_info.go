@@ -13,8 +13,8 @@ import (
 
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -69,7 +69,7 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 	request := cam.NewGetUserAppIdRequest()
 	response := cam.NewGetUserAppIdResponse()
 
-	ratelimit.Check(request.GetAction())
+	meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		result, e := client.UseCamClient().GetUserAppId(request)
@@ -82,12 +82,12 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return fmt.Errorf("get user appid error: empty response")
@@ -0,0 +1,56 @@
+package cam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CamPolicyNormalizeFunction{}
+
+// NewCamPolicyNormalizeFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewCamPolicyNormalizeFunction() function.Function {
+	return &CamPolicyNormalizeFunction{}
+}
+
+// CamPolicyNormalizeFunction implements function.Function for
+// provider::tencentcloud::cam_policy_normalize.
+type CamPolicyNormalizeFunction struct{}
+
+func (f *CamPolicyNormalizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cam_policy_normalize"
+}
+
+func (f *CamPolicyNormalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a CAM policy document and return it in canonical form.",
+		Description: "Validates a CAM policy document and returns it in the canonical form returned by CAM: " +
+			"lower-case elements and effects, `action`, `resource` and `principal` values as sorted arrays " +
+			"and statements in a stable order, so that it can be passed to `document` without perpetual diffs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "document",
+				Description: "The CAM policy document in JSON.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = req.Arguments.Get(ctx, &document)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := NormalizeCamPolicyDocument(document)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid CAM policy document: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
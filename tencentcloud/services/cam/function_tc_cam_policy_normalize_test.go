@@ -0,0 +1,63 @@
+package cam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccam "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cam"
+)
+
+// TestCamPolicyNormalizeFunction_Run validates that element names, effects,
+// string-or-array values and statement ordering are canonicalized.
+func TestCamPolicyNormalizeFunction_Run(t *testing.T) {
+	document := `{
+  "Version": "2.0",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "cos:DeleteBucket",
+      "Resource": "qcs::cos:ap-guangzhou:uid/1250000000:examplebucket-1250000000/*"
+    },
+    {
+      "effect": "allow",
+      "action": ["cos:PutObject", "cos:GetObject", "cos:GetObject"],
+      "resource": ["*"]
+    }
+  ]
+}`
+	want := `{"statement":[` +
+		`{"action":["cos:DeleteBucket"],"effect":"deny","resource":["qcs::cos:ap-guangzhou:uid/1250000000:examplebucket-1250000000/*"]},` +
+		`{"action":["cos:GetObject","cos:PutObject"],"effect":"allow","resource":["*"]}` +
+		`],"version":"2.0"}`
+
+	f := svccam.NewCamPolicyNormalizeFunction()
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(document)})}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	f.Run(t.Context(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Run returned error: %s", resp.Error)
+	}
+	if !resp.Result.Value().Equal(types.StringValue(want)) {
+		t.Errorf("Run result = %s, want %s", resp.Result.Value(), want)
+	}
+}
+
+// TestCamPolicyNormalizeFunction_Principal validates that role trust policies
+// keep their principal with array values.
+func TestCamPolicyNormalizeFunction_Principal(t *testing.T) {
+	document := `{"version":"2.0","statement":[{"action":"name/sts:AssumeRole","effect":"allow","principal":{"qcs":"qcs::cam::uin/100000000001:root"}}]}`
+	want := `{"statement":[{"action":["name/sts:AssumeRole"],"effect":"allow","principal":{"qcs":["qcs::cam::uin/100000000001:root"]}}],"version":"2.0"}`
+
+	normalized, err := svccam.NormalizeCamPolicyDocument(document)
+	if err != nil {
+		t.Fatalf("NormalizeCamPolicyDocument returned error: %s", err)
+	}
+	if normalized != want {
+		t.Errorf("NormalizeCamPolicyDocument = %s, want %s", normalized, want)
+	}
+}
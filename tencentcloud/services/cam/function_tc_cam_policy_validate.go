@@ -0,0 +1,54 @@
+package cam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CamPolicyValidateFunction{}
+
+// NewCamPolicyValidateFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewCamPolicyValidateFunction() function.Function {
+	return &CamPolicyValidateFunction{}
+}
+
+// CamPolicyValidateFunction implements function.Function for
+// provider::tencentcloud::cam_policy_validate.
+type CamPolicyValidateFunction struct{}
+
+func (f *CamPolicyValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cam_policy_validate"
+}
+
+func (f *CamPolicyValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a CAM policy document.",
+		Description: "Returns `true` when the CAM policy document is valid, otherwise fails at plan time with a message " +
+			"pointing at the malformed `statement`, `effect`, `action`, `resource` or `principal` element.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "document",
+				Description: "The CAM policy document in JSON.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *CamPolicyValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = req.Arguments.Get(ctx, &document)
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := NormalizeCamPolicyDocument(document); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid CAM policy document: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, true)
+}
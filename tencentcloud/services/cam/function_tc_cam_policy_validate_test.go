@@ -0,0 +1,80 @@
+package cam_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccam "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cam"
+)
+
+// TestCamPolicyValidateFunction_Run validates that malformed documents fail
+// with a message pointing at the offending element.
+func TestCamPolicyValidateFunction_Run(t *testing.T) {
+	cases := map[string]struct {
+		document string
+		wantErr  string
+	}{
+		"valid": {
+			document: `{"version":"2.0","statement":[{"effect":"allow","action":["cvm:*"],"resource":["*"]}]}`,
+		},
+		"invalid json": {
+			document: `{"version":"2.0",`,
+			wantErr:  "not valid JSON",
+		},
+		"missing version": {
+			document: `{"statement":[{"effect":"allow","action":["cvm:*"],"resource":["*"]}]}`,
+			wantErr:  "`version` is required",
+		},
+		"bad effect": {
+			document: `{"version":"2.0","statement":[{"effect":"permit","action":["cvm:*"],"resource":["*"]}]}`,
+			wantErr:  "statement[0]: `effect` must be `allow` or `deny`",
+		},
+		"bad action": {
+			document: `{"version":"2.0","statement":[{"effect":"allow","action":["DescribeInstances"],"resource":["*"]}]}`,
+			wantErr:  "statement[0]: action `DescribeInstances`",
+		},
+		"bad resource": {
+			document: `{"version":"2.0","statement":[{"effect":"allow","action":["cvm:*"],"resource":["qcs::cvm:ap-guangzhou"]}]}`,
+			wantErr:  "statement[0]: resource `qcs::cvm:ap-guangzhou`",
+		},
+		"bad principal": {
+			document: `{"version":"2.0","statement":[{"effect":"allow","action":["name/sts:AssumeRole"],"principal":{"qcs":["uin/100000000001"]}}]}`,
+			wantErr:  "statement[0]: principal `uin/100000000001`",
+		},
+		"unsupported element": {
+			document: `{"version":"2.0","statement":[{"effect":"allow","action":["cvm:*"],"resources":["*"]}]}`,
+			wantErr:  "unsupported element `statement[0].resources`",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := svccam.NewCamPolicyValidateFunction()
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(c.document)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if c.wantErr == "" {
+				if resp.Error != nil {
+					t.Fatalf("Run returned error: %s", resp.Error)
+				}
+				if !resp.Result.Value().Equal(types.BoolValue(true)) {
+					t.Errorf("Run result = %s, want true", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error == nil {
+				t.Fatalf("Run should return error containing %q", c.wantErr)
+			}
+			if !strings.Contains(resp.Error.Text, c.wantErr) {
+				t.Errorf("Run error = %q, want it to contain %q", resp.Error.Text, c.wantErr)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -139,11 +140,11 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.RoleId == nil {
@@ -278,11 +279,11 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, mDescRequest.GetAction(), mDescRequest.ToJsonString(), e.Error())
+					logId, mDescRequest.GetAction(), connectivity.RedactJSON(mDescRequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, mDescRequest.GetAction(), mDescRequest.ToJsonString(), response.ToJsonString())
+					logId, mDescRequest.GetAction(), connectivity.RedactJSON(mDescRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -311,11 +312,11 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, mDocRequest.GetAction(), mDocRequest.ToJsonString(), e.Error())
+					logId, mDocRequest.GetAction(), connectivity.RedactJSON(mDocRequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, mDocRequest.GetAction(), mDocRequest.ToJsonString(), response.ToJsonString())
+					logId, mDocRequest.GetAction(), connectivity.RedactJSON(mDocRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -329,8 +330,8 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		resourceName := tccommon.BuildTagResourceName("cam", "role", "", roleId)
@@ -360,11 +361,11 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, consoleLoginRequest.GetAction(), consoleLoginRequest.ToJsonString(), e.Error())
+					logId, consoleLoginRequest.GetAction(), connectivity.RedactJSON(consoleLoginRequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, consoleLoginRequest.GetAction(), consoleLoginRequest.ToJsonString(), response.ToJsonString())
+					logId, consoleLoginRequest.GetAction(), connectivity.RedactJSON(consoleLoginRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -386,11 +387,11 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 			response, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCamClient().UpdateRoleSessionDuration(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), e.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 
 			return nil
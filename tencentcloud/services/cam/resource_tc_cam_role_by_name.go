@@ -17,6 +17,7 @@ import (
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -124,11 +125,11 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -264,11 +265,11 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, mDescRequest.GetAction(), mDescRequest.ToJsonString(), e.Error())
+					logId, mDescRequest.GetAction(), connectivity.RedactJSON(mDescRequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, mDescRequest.GetAction(), mDescRequest.ToJsonString(), response.ToJsonString())
+					logId, mDescRequest.GetAction(), connectivity.RedactJSON(mDescRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -297,11 +298,11 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, mDocRequest.GetAction(), mDocRequest.ToJsonString(), e.Error())
+					logId, mDocRequest.GetAction(), connectivity.RedactJSON(mDocRequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, mDocRequest.GetAction(), mDocRequest.ToJsonString(), response.ToJsonString())
+					logId, mDocRequest.GetAction(), connectivity.RedactJSON(mDocRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -315,8 +316,8 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		var instance *cam.RoleInfo
@@ -15,6 +15,7 @@ import (
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -179,7 +180,7 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCamClient().AddUser(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				if strings.Contains(errCode, "SubUserNameInUse") {
@@ -189,7 +190,7 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
@@ -361,11 +362,11 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), e.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			}
 			return nil
 		})
@@ -376,7 +377,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	//tag
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		camService := CamService{
 			client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 		}
@@ -400,7 +401,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		oldInterface, newInterface := d.GetChange("tags")
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		region := meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region
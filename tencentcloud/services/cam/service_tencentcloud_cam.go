@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewCamService(client *connectivity.TencentCloudClient) CamService {
@@ -37,16 +36,16 @@ func (me *CamService) DescribeRoleById(ctx context.Context, roleId string) (camI
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().DescribeRoleList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -79,16 +78,16 @@ func (me *CamService) DescribeRolesByFilter(ctx context.Context, params map[stri
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().DescribeRoleList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -125,15 +124,15 @@ func (me *CamService) DeleteRoleById(ctx context.Context, roleId string) error {
 	logId := tccommon.GetLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleId = &roleId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DeleteRole(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -143,15 +142,15 @@ func (me *CamService) DeleteRoleByName(ctx context.Context, roleName string) err
 	logId := tccommon.GetLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleName = &roleName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DeleteRole(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -181,11 +180,11 @@ func (me *CamService) DescribeRolePolicyAttachmentByName(ctx context.Context, ro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleName = &roleName
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -197,7 +196,7 @@ func (me *CamService) DescribeRolePolicyAttachmentByName(ctx context.Context, ro
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -235,11 +234,11 @@ func (me *CamService) DescribeRolePolicyAttachmentById(ctx context.Context, role
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -251,7 +250,7 @@ func (me *CamService) DescribeRolePolicyAttachmentById(ctx context.Context, role
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -285,11 +284,11 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -301,7 +300,7 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -337,15 +336,15 @@ func (me *CamService) DeleteRolePolicyAttachmentByName(ctx context.Context, role
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleName = &roleName
 	request.PolicyName = &policyName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachRolePolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -358,15 +357,15 @@ func (me *CamService) DeleteRolePolicyAttachmentById(ctx context.Context, rolePo
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleId = &roleId
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachRolePolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -394,11 +393,11 @@ func (me *CamService) DescribeUserPolicyAttachmentById(ctx context.Context, user
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -410,7 +409,7 @@ func (me *CamService) DescribeUserPolicyAttachmentById(ctx context.Context, user
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -452,11 +451,11 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -468,7 +467,7 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -518,15 +517,15 @@ func (me *CamService) AddUserPolicyAttachment(ctx context.Context, userId string
 	request := cam.NewAttachUserPolicyRequest()
 	request.AttachUin = uin
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().AttachUserPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -548,15 +547,15 @@ func (me *CamService) DeleteUserPolicyAttachmentById(ctx context.Context, userPo
 	request := cam.NewDetachUserPolicyRequest()
 	request.DetachUin = uin
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachUserPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -581,11 +580,11 @@ func (me *CamService) DescribeGroupPolicyAttachmentById(ctx context.Context, gro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -597,7 +596,7 @@ func (me *CamService) DescribeGroupPolicyAttachmentById(ctx context.Context, gro
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -636,11 +635,11 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -652,7 +651,7 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -701,15 +700,15 @@ func (me *CamService) AddGroupPolicyAttachment(ctx context.Context, groupId stri
 	request := cam.NewAttachGroupPolicyRequest()
 	request.AttachGroupId = &groupIdInt64
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().AttachGroupPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -728,15 +727,15 @@ func (me *CamService) DeleteGroupPolicyAttachmentById(ctx context.Context, group
 	request := cam.NewDetachGroupPolicyRequest()
 	request.DetachGroupId = &groupIdInt64
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachGroupPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -811,7 +810,7 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
@@ -865,16 +864,16 @@ func (me *CamService) DescribeUserById(ctx context.Context, userId string) (resp
 	logId := tccommon.GetLogId(ctx)
 	request := cam.NewGetUserRequest()
 	request.Name = &userId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().GetUser(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -884,11 +883,11 @@ func (me *CamService) DescribeUsersByFilter(ctx context.Context, params map[stri
 
 	result = make([]*cam.SubAccountInfo, 0)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().ListUsers(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			errCode := ee.GetCode()
 			//check if read empty
@@ -900,7 +899,7 @@ func (me *CamService) DescribeUsersByFilter(ctx context.Context, params map[stri
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	for _, user := range response.Response.Data {
 		if params["name"] != nil {
@@ -987,11 +986,11 @@ func (me *CamService) DescribeGroupById(ctx context.Context, groupId string) (ca
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request.GroupId = &groupIdInt64
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().GetGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			errCode := ee.GetCode()
 			//check if read empty
@@ -1003,7 +1002,7 @@ func (me *CamService) DescribeGroupById(ctx context.Context, groupId string) (ca
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	camInstance = response
 	return
 }
@@ -1017,11 +1016,11 @@ func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[str
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
 				//check if read empty
@@ -1033,7 +1032,7 @@ func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[str
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.GroupInfo) < 1 {
 			break
@@ -1151,7 +1150,7 @@ func (me *CamService) DescribeSAMLProvidersByFilter(ctx context.Context, params
 		}
 	}
 	providers = make([]*cam.SAMLProviderInfo, 0)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().ListSAMLProviders(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SAML provider failed, reason:%s\n", logId, err.Error())
@@ -1230,13 +1229,13 @@ func (me *CamService) DescribeCamServiceLinkedRole(ctx context.Context, roleId s
 
 	request.RoleId = &roleId
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().GetRole(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1262,13 +1261,13 @@ func (me *CamService) DeleteCamServiceLinkedRoleById(ctx context.Context, roleId
 
 	request.RoleName = &roleId
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DeleteServiceLinkedRole(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1292,7 +1291,7 @@ func (me *CamService) DescribeCamServiceLinkedRoleDeleteStatus(ctx context.Conte
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.DeletionTaskId = &deletionTaskId
@@ -1300,12 +1299,12 @@ func (me *CamService) DescribeCamServiceLinkedRoleDeleteStatus(ctx context.Conte
 	response, err := me.client.UseCamClient().GetServiceLinkedRoleDeletionStatus(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1317,13 +1316,13 @@ func (me *CamService) DescribeCamUserSamlConfigById(ctx context.Context) (userSa
 	response := cam.NewDescribeUserSAMLConfigResponse()
 
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DescribeUserSAMLConfig(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1344,13 +1343,13 @@ func (me *CamService) DeleteCamUserSamlConfigById(ctx context.Context) (errRet e
 	request.Operate = helper.String("disable")
 
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().UpdateUserSAMLConfig(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1364,13 +1363,13 @@ func (me *CamService) DescribeCamMfaFlagById(ctx context.Context, id uint64) (lo
 	response := cam.NewDescribeSafeAuthFlagCollResponse()
 	request.SubUin = &id
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DescribeSafeAuthFlagColl(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1396,13 +1395,13 @@ func (me *CamService) DescribeCamAccessKeyById(ctx context.Context, targetUin ui
 	response := cam.NewListAccessKeysResponse()
 	request.TargetUin = &targetUin
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().ListAccessKeys(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1431,13 +1430,13 @@ func (me *CamService) DeleteCamAccessKeyById(ctx context.Context, uin, accessKey
 	request.AccessKeyId = &accessKeyId
 	request.TargetUin = helper.StrToUint64Point(uin)
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DeleteAccessKey(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1451,13 +1450,13 @@ func (me *CamService) DescribeCamUserPermissionBoundaryById(ctx context.Context,
 	response := cam.NewGetUserPermissionBoundaryResponse()
 	request.TargetUin = helper.StrToInt64Point(targetUin)
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().GetUserPermissionBoundary(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1481,13 +1480,13 @@ func (me *CamService) DeleteCamUserPermissionBoundaryById(ctx context.Context, t
 	request := cam.NewDeleteUserPermissionsBoundaryRequest()
 	request.TargetUin = helper.StrToInt64Point(targetUin)
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DeleteUserPermissionsBoundary(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1503,13 +1502,13 @@ func (me *CamService) DescribeCamPolicyVersionById(ctx context.Context, policyId
 	request.VersionId = &versionId
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().GetPolicyVersion(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1535,13 +1534,13 @@ func (me *CamService) DeleteCamPolicyVersionById(ctx context.Context, policyId u
 	request.VersionId = []*uint64{helper.Uint64(versionId)}
 
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DeletePolicyVersion(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1556,7 +1555,7 @@ func (me *CamService) DescribeCamListEntitiesForPolicyByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1572,7 +1571,7 @@ func (me *CamService) DescribeCamListEntitiesForPolicyByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	pageStart := uint64(1)
 	rp := uint64(PAGE_ITEM) //to save in extension
@@ -1580,16 +1579,16 @@ func (me *CamService) DescribeCamListEntitiesForPolicyByFilter(ctx context.Conte
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListEntitiesForPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.List) < 1 {
 			break
@@ -1612,7 +1611,7 @@ func (me *CamService) DescribeCamListAttachedUserPolicyByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1637,13 +1636,13 @@ func (me *CamService) DescribeCamListAttachedUserPolicyByFilter(ctx context.Cont
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserAllPolicies(request)
 		if err != nil {
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.PolicyList) < 1 {
 			break
@@ -1671,13 +1670,13 @@ func (me *CamService) DescribeCamTagRoleById(ctx context.Context, roleName, role
 	}
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().GetRole(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1705,13 +1704,13 @@ func (me *CamService) DeleteCamTagRoleById(ctx context.Context, roleName, roleId
 	}
 	request.TagKeys = keys
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().UntagRole(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1725,13 +1724,13 @@ func (me *CamService) DescribeCamRolePermissionBoundaryAttachmentById(ctx contex
 	response := cam.NewGetRolePermissionBoundaryResponse()
 	request.RoleId = &roleId
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().GetRolePermissionBoundary(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -1762,13 +1761,13 @@ func (me *CamService) DeleteCamRolePermissionBoundaryAttachmentById(ctx context.
 	}
 
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().DeleteRolePermissionsBoundary(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -1783,7 +1782,7 @@ func (me *CamService) DescribeCamSecretLastUsedTimeByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1793,14 +1792,14 @@ func (me *CamService) DescribeCamSecretLastUsedTimeByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCamClient().GetSecurityLastUsed(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || len(response.Response.SecretIdLastUsedRows) < 1 {
 		return
@@ -1817,7 +1816,7 @@ func (me *CamService) DescribeCamPolicyGrantingServiceAccessByFilter(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1836,14 +1835,14 @@ func (me *CamService) DescribeCamPolicyGrantingServiceAccessByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCamClient().ListPoliciesGrantingServiceAccess(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || len(response.Response.List) < 1 {
 		return
@@ -1860,13 +1859,13 @@ func (me *CamService) DescribeCamSetPolicyVersionById(ctx context.Context, polic
 	request.PolicyId = helper.StrToUint64Point(policyId)
 
 	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCamClient().ListPolicyVersions(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		response = result
 		return nil
@@ -1896,18 +1895,18 @@ func (me *CamService) DescribeCamAccountSummaryByFilter(ctx context.Context) (Ac
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCamClient().GetAccountSummary(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -1924,7 +1923,7 @@ func (me *CamService) DescribeCamGroupUserAccountByFilter(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1937,7 +1936,7 @@ func (me *CamService) DescribeCamGroupUserAccountByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	pageStart := uint64(1)
 	rp := uint64(PAGE_ITEM) //to save in extension
@@ -1950,7 +1949,7 @@ func (me *CamService) DescribeCamGroupUserAccountByFilter(ctx context.Context, p
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.GroupInfo) < 1 {
 			break
@@ -1974,7 +1973,7 @@ func (me *CamService) DescribeCamSubAccountsByFilter(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1984,14 +1983,14 @@ func (me *CamService) DescribeCamSubAccountsByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCamV20190116Client().DescribeSubAccounts(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.SubAccounts) < 1 {
 		return
@@ -2009,7 +2008,7 @@ func (me *CamService) DescribeCamRoleDetailByFilter(ctx context.Context, param m
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2022,14 +2021,14 @@ func (me *CamService) DescribeCamRoleDetailByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCamV20190116Client().GetRole(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -2047,7 +2046,7 @@ func (me *CamService) DescribeCamMessageReceiverById(ctx context.Context, name s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2062,12 +2061,12 @@ func (me *CamService) DescribeCamMessageReceiverById(ctx context.Context, name s
 		request.Limit = &limit
 
 		errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseCamV20190116Client().ListReceiver(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -2113,7 +2112,7 @@ func (me *CamService) DescribeCamPolicyDetailByFilter(ctx context.Context, param
 		request.PolicyId = v.(*uint64)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCamClient().GetPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s GetPolicy failed, reason: %s\n", logId, err.Error())
@@ -65,12 +65,12 @@ func ResourceTencentCloudCbsCopySnapshotCrossRegionAttachment() *schema.Resource
 // provider's default region.
 func cbsClientWithRegion(meta interface{}, region string) *cbs.Client {
 	conn := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
-	cpf := conn.NewClientProfile(300)
-	client, _ := cbs.NewClient(conn.Credential, region, cpf)
+	cpf := conn.NewServiceClientProfile("cbs", 300)
+	client, _ := cbs.NewClient(conn.GetCredential(), region, cpf)
 	// Attach the LogRoundTripper so that requests issued by this region-specific client
 	// (e.g. DescribeSnapshots / DeleteSnapshots) are printed in the SDK debug log,
 	// consistent with clients created via UseCbsClient().
-	client.WithHttpTransport(&connectivity.LogRoundTripper{})
+	client.WithHttpTransport(conn.NewLogRoundTripper("cbs"))
 	return client
 }
 
@@ -102,7 +102,7 @@ func resourceTencentCloudCbsCopySnapshotCrossRegionAttachmentCreate(d *schema.Re
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -301,7 +301,7 @@ func resourceTencentCloudCbsCopySnapshotCrossRegionAttachmentDelete(d *schema.Re
 				log.Printf("[CRITAL]%s logId=%s d.Id()=%s DeleteSnapshots return nil Response", logId, logId, d.Id())
 				return resource.NonRetryableError(fmt.Errorf("DeleteSnapshots return nil Response"))
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -22,10 +23,11 @@ func ResourceTencentCloudCbsStorage() *schema.Resource {
 		Update: resourceTencentCloudCbsStorageUpdate,
 		Delete: resourceTencentCloudCbsStorageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: tccommon.ImportStateWithRegion(schema.ImportStatePassthroughContext),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"storage_type": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -161,6 +163,8 @@ func ResourceTencentCloudCbsStorage() *schema.Resource {
 func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_storage.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -242,7 +246,7 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 		response, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCbsClient().CreateDisks(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e, tccommon.InternalError)
 		}
 
@@ -304,6 +308,9 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_storage.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -373,6 +380,8 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_storage.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -575,8 +584,8 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldValue, newValue := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
@@ -660,6 +669,8 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_storage.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -16,7 +16,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewCbsService(client *connectivity.TencentCloudClient) CbsService {
@@ -52,20 +51,20 @@ func (me *CbsService) DescribeDiskById(ctx context.Context, diskId string) (disk
 	request := cbs.NewDescribeDisksRequest()
 	request.DiskIds = common.StringPtrs([]string{diskId})
 	request.Limit = helper.IntUint64(100)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = diskId
 	response, err := me.client.UseCbsClient(iacExtInfo).DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if err != nil {
 		errRet = err
@@ -84,7 +83,7 @@ func (me *CbsService) DescribeDiskList(ctx context.Context, diskIds []*string) (
 	request := cbs.NewDescribeDisksRequest()
 	request.DiskIds = diskIds
 	request.Limit = helper.IntUint64(100)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var iacExtInfo connectivity.IacExtInfo
 	tmpList := make([]string, len(diskIds))
@@ -95,12 +94,12 @@ func (me *CbsService) DescribeDiskList(ctx context.Context, diskIds []*string) (
 	response, err := me.client.UseCbsClient(iacExtInfo).DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DiskSet) > 0 {
 		disk = response.Response.DiskSet
@@ -131,16 +130,16 @@ func (me *CbsService) DescribeDisksByFilter(ctx context.Context, params map[stri
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeDisks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.DiskSet) < 1 {
 			break
@@ -176,7 +175,7 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 	response, err := me.client.UseCbsClient().DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -217,16 +216,16 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 			request.Offset = helper.IntUint64(offset)
 			request.Limit = helper.IntUint64(limit)
 
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err := me.client.UseCbsClient().DescribeDisks(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), err.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 				errRet = err
 				return
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 			diskSetList[value] = response.Response.DiskSet
 
@@ -259,15 +258,15 @@ func (me *CbsService) ModifyDiskAttributes(ctx context.Context, diskType, diskId
 	if burstPerformanceOperation != "" {
 		request.BurstPerformanceOperation = helper.String(burstPerformanceOperation)
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskAttributes(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -282,15 +281,15 @@ func (me *CbsService) DeleteDiskSetByIds(ctx context.Context, diskSetIds string)
 	}
 
 	request.DiskIds = helper.StringsStringsPoint(diskSet)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -298,15 +297,15 @@ func (me *CbsService) DeleteDiskById(ctx context.Context, diskId string) error {
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewTerminateDisksRequest()
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -315,14 +314,14 @@ func (me *CbsService) ResizeDisk(ctx context.Context, diskId string, diskSize in
 	request := cbs.NewResizeDiskRequest()
 	request.DiskId = &diskId
 	request.DiskSize = helper.IntUint64(diskSize)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ResizeDisk(request)
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		storage, e := me.DescribeDiskById(ctx, diskId)
@@ -354,15 +353,15 @@ func (me *CbsService) ModifyThroughputPerformance(ctx context.Context, diskId st
 	request := cbs.NewModifyDiskExtraPerformanceRequest()
 	request.DiskId = &diskId
 	request.ThroughputPerformance = helper.IntUint64(throughputPerformance)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskExtraPerformance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -371,15 +370,15 @@ func (me *CbsService) ApplySnapshot(ctx context.Context, diskId, snapshotId stri
 	request := cbs.NewApplySnapshotRequest()
 	request.DiskId = &diskId
 	request.SnapshotId = &snapshotId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ApplySnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -388,15 +387,15 @@ func (me *CbsService) AttachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewAttachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().AttachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -405,15 +404,15 @@ func (me *CbsService) DetachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewDetachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DetachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -422,16 +421,16 @@ func (me *CbsService) CreateSnapshot(ctx context.Context, diskId, snapshotName s
 	request := cbs.NewCreateSnapshotRequest()
 	request.DiskId = &diskId
 	request.SnapshotName = &snapshotName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().CreateSnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.SnapshotId == nil {
 		errRet = fmt.Errorf("CreateSnapshot response is nil.")
@@ -446,16 +445,16 @@ func (me *CbsService) DescribeSnapshotById(ctx context.Context, snapshotId strin
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewDescribeSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.SnapshotSet) > 0 {
 		snapshot = response.Response.SnapshotSet[0]
@@ -482,7 +481,7 @@ func (me *CbsService) DescribeSnapshotByIds(ctx context.Context, snapshotIdsPara
 		request.Limit = &pageSize
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseCbsClient().DescribeSnapshots(request)
 			if err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
@@ -492,7 +491,7 @@ func (me *CbsService) DescribeSnapshotByIds(ctx context.Context, snapshotIdsPara
 
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
@@ -523,16 +522,16 @@ func (me *CbsService) DescribeSnapshotsByFilter(ctx context.Context, params map[
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.SnapshotSet) < 1 {
 			break
@@ -553,15 +552,15 @@ func (me *CbsService) ModifySnapshotName(ctx context.Context, snapshotId, snapsh
 	request := cbs.NewModifySnapshotAttributeRequest()
 	request.SnapshotId = &snapshotId
 	request.SnapshotName = &snapshotName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifySnapshotAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -569,15 +568,15 @@ func (me *CbsService) DeleteSnapshot(ctx context.Context, snapshotId string) err
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewDeleteSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -585,16 +584,16 @@ func (me *CbsService) DescribeSnapshotPolicyById(ctx context.Context, policyId s
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	request := cbs.NewDescribeAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.AutoSnapshotPolicySet) > 0 {
 		policy = response.Response.AutoSnapshotPolicySet[0]
@@ -620,11 +619,11 @@ func (me *CbsService) DescribeSnapshotPolicy(ctx context.Context, policyId, poli
 		}
 		request.Filters = append(request.Filters, &filter)
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -636,15 +635,15 @@ func (me *CbsService) DeleteSnapshotPolicy(ctx context.Context, policyId string)
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewDeleteAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return nil
 }
 
@@ -660,11 +659,11 @@ func (me *CbsService) AttachSnapshotPolicy(ctx context.Context, diskId string, d
 		request.DiskIds = helper.Strings(diskIds)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().BindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -674,12 +673,12 @@ func (me *CbsService) DescribeAttachedSnapshotPolicy(ctx context.Context, diskId
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewDescribeDiskAssociatedAutoSnapshotPolicyRequest()
 	request.DiskId = &diskId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicy(request)
 	if err != nil {
 		errRet = err
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 
@@ -697,15 +696,15 @@ func (me *CbsService) DescribeAttachedSnapshotPolicyDisksById(ctx context.Contex
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	request := cbs.NewDescribeAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if len(response.Response.AutoSnapshotPolicySet) > 0 {
 		policy = response.Response.AutoSnapshotPolicySet[0]
 	}
@@ -725,11 +724,11 @@ func (me *CbsService) UnattachSnapshotPolicy(ctx context.Context, diskId string,
 		request.DiskIds = helper.Strings(diskIds)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -740,11 +739,11 @@ func (me *CbsService) ModifyDiskChargeType(ctx context.Context, storageId string
 	request := cbs.NewModifyDisksChargeTypeRequest()
 	request.DiskIds = []*string{&storageId}
 	request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period), RenewFlag: &renewFlag}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -756,11 +755,11 @@ func (me *CbsService) ModifyDisksRenewFlag(ctx context.Context, storageId string
 	request.DiskIds = []*string{&storageId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksRenewFlag(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -774,18 +773,18 @@ func (me *CbsService) DescribeCbsDiskBackupById(ctx context.Context, diskBackupI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCbsClient().DescribeDiskBackups(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DiskBackupSet) < 1 {
 		return
@@ -803,18 +802,18 @@ func (me *CbsService) DeleteCbsDiskBackupById(ctx context.Context, diskBackupId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCbsClient().DeleteDiskBackups(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -824,21 +823,21 @@ func (me *CbsService) ModifyDiskBackupQuota(ctx context.Context, diskId string,
 	request := cbs.NewModifyDiskBackupQuotaRequest()
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupQuota = helper.IntUint64(diskBackupQuota)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCbsClient().ModifyDiskBackupQuota(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -848,14 +847,14 @@ func (me *CbsService) CreateDiskBackup(ctx context.Context, diskId, diskBackupNa
 	request := cbs.NewCreateDiskBackupRequest()
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupName = helper.String(diskBackupName)
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCbsClient().CreateDiskBackup(request)
 		if e != nil {
 			if sdkError, ok := e.(*errors.TencentCloudSDKError); ok {
@@ -865,7 +864,7 @@ func (me *CbsService) CreateDiskBackup(ctx context.Context, diskId, diskBackupNa
 			}
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		diskBackupId = *result.Response.DiskBackupId
 		return nil
@@ -886,18 +885,18 @@ func (me *CbsService) DescribeCbsSnapshotSharePermissionById(ctx context.Context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCbsClient().DescribeSnapshotSharePermission(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	snapshotSharePermissions = response.Response.SharePermissionSet
 	return
@@ -909,20 +908,20 @@ func (me *CbsService) ModifySnapshotsSharePermission(ctx context.Context, snapsh
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.SnapshotIds = []*string{&snapshotId}
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ModifySnapshotsSharePermission(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -939,12 +938,12 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.DiskBackupId = helper.String(diskBackupId)
 	request.DiskId = helper.String(diskId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ApplyDiskBackup(request)
@@ -956,7 +955,7 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 			}
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -972,7 +971,7 @@ func (me *CbsService) DescribeDiskConfigQuota(ctx context.Context, cvmInfo map[s
 	request := cbs.NewDescribeDiskConfigQuotaRequest()
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -985,12 +984,12 @@ func (me *CbsService) DescribeDiskConfigQuota(ctx context.Context, cvmInfo map[s
 	request.DiskChargeType = helper.String(cvmInfo["disk_charge_type"].(string))
 	request.DiskUsage = helper.String(cvmInfo["disk_usage"].(string))
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseCbsClient().DescribeDiskConfigQuota(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		diskConfigSet = result.Response.DiskConfigSet
 		return nil
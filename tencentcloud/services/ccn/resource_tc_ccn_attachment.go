@@ -8,11 +8,10 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -282,16 +281,16 @@ func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interf
 		ccnInstance.RouteTableId = &routeTableId
 		request.Instances = []*vpc.CcnInstance{&ccnInstance}
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().ModifyCcnAttachedInstancesAttribute(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), err.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 				return tccommon.RetryError(err)
 			}
 
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			return nil
 		})
 
@@ -15,7 +15,6 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 // Ccn basic information
@@ -135,7 +134,7 @@ func (me *VpcService) DescribeCcns(ctx context.Context, ccnId, name string) (inf
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -164,19 +163,19 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcns(request)
 
 	if err != nil {
 		errRet = err
 		responseStr := ""
 		if response != nil {
-			responseStr = response.ToJsonString()
+			responseStr = connectivity.RedactJSON(response.ToJsonString())
 		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 			logId,
 			request.GetAction(),
-			request.ToJsonString(),
+			connectivity.RedactJSON(request.ToJsonString()),
 			responseStr,
 			errRet.Error())
 		return
@@ -185,8 +184,8 @@ getMoreData:
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 
 	if total < 0 {
 		total = int(*response.Response.TotalCount)
@@ -235,19 +234,19 @@ func (me *VpcService) DescribeCcnRegionBandwidthLimits(ctx context.Context, ccnI
 	infos = make([]CcnBandwidthLimit, 0, 100)
 
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnRegionBandwidthLimits(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -260,8 +259,8 @@ func (me *VpcService) DescribeCcnRegionBandwidthLimits(ctx context.Context, ccnI
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 
 	for _, item := range response.Response.CcnRegionBandwidthLimitSet {
 
@@ -287,19 +286,19 @@ func (me *VpcService) CreateCcn(ctx context.Context, name, description,
 	if instanceMeteringType != "" {
 		request.InstanceMeteringType = &instanceMeteringType
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateCcn(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -312,8 +311,8 @@ func (me *VpcService) CreateCcn(ctx context.Context, name, description,
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.Ccn == nil || response.Response.Ccn.CcnId == nil || *response.Response.Ccn.CcnId == "" {
 		errRet = fmt.Errorf("CreateCcn return empty response.Response.Ccn ")
@@ -337,19 +336,19 @@ func (me *VpcService) DeleteCcn(ctx context.Context, ccnId string) (errRet error
 	logId := tccommon.GetLogId(ctx)
 	request := vpc.NewDeleteCcnRequest()
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteCcn(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -362,8 +361,8 @@ func (me *VpcService) DeleteCcn(ctx context.Context, ccnId string) (errRet error
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -384,19 +383,19 @@ func (me *VpcService) ModifyCcnAttribute(ctx context.Context, ccnId, name, descr
 	request.RouteECMPFlag = &ecmpFlag
 	request.RouteOverlapFlag = &overlapFlag
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyCcnAttribute(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -409,8 +408,8 @@ func (me *VpcService) ModifyCcnAttribute(ctx context.Context, ccnId, name, descr
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -468,12 +467,12 @@ func (me *VpcService) DescribeCcnAttachedInstanceByFilter(ctx context.Context, c
 		request.Limit = &limit
 		request.Offset = &offset
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseVpcClient().DescribeCcnAttachedInstancesWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -523,7 +522,7 @@ func (me *VpcService) DescribeCcnAttachedInstances(ctx context.Context, ccnId st
 
 	request.CcnId = &ccnId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	for {
 		request.Limit = &limit
@@ -547,12 +546,12 @@ func (me *VpcService) DescribeCcnAttachedInstances(ctx context.Context, ccnId st
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -565,8 +564,8 @@ func (me *VpcService) DescribeCcnAttachedInstances(ctx context.Context, ccnId st
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 
 	infos = make([]CcnAttachedInstanceInfo, 0, len(result))
 
@@ -614,14 +613,14 @@ func (me *VpcService) DescribeVpcDescribeCcnRoutesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
 		response, err = me.client.UseVpcClient().DescribeCcnRoutes(request)
 		if err != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), request.ToJsonString(), err)
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			errRet = err
 			return
 		}
@@ -650,19 +649,19 @@ func (me *VpcService) DescribeCcnAttachmentsByInstance(ctx context.Context, inst
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-id"), Values: []*string{&instanceId}})
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-region"), Values: []*string{&instanceRegion}})
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstances(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -675,8 +674,8 @@ func (me *VpcService) DescribeCcnAttachmentsByInstance(ctx context.Context, inst
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 
 	infos = make([]vpc.CcnAttachedInstance, 0, len(response.Response.InstanceSet))
 
@@ -709,19 +708,19 @@ func (me *VpcService) AttachCcnInstances(ctx context.Context, ccnId, instanceReg
 	}
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().AttachCcnInstances(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -734,8 +733,8 @@ func (me *VpcService) AttachCcnInstances(ctx context.Context, ccnId, instanceReg
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -751,19 +750,19 @@ func (me *VpcService) DetachCcnInstances(ctx context.Context, ccnId, instanceReg
 	ccnInstance.InstanceType = &instanceType
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DetachCcnInstances(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -776,8 +775,8 @@ func (me *VpcService) DetachCcnInstances(ctx context.Context, ccnId, instanceReg
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 	return
 
 }
@@ -837,7 +836,7 @@ func (me *VpcService) GetCcnRegionBandwidthLimits(ctx context.Context,
 	request.Limit = &limit
 	request.Offset = &offset
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	for {
 		response, err = me.client.UseVpcClient().GetCcnRegionBandwidthLimits(request)
 		if err != nil {
@@ -880,19 +879,19 @@ func (me *VpcService) SetCcnRegionBandwidthLimits(ctx context.Context, ccnId, re
 	request.CcnRegionBandwidthLimits = []*vpc.CcnRegionBandwidthLimit{&ccnRegionBandwidthLimit}
 
 	request.SetDefaultLimitFlag = helper.Bool(setFlag)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().SetCcnRegionBandwidthLimits(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -905,8 +904,8 @@ func (me *VpcService) SetCcnRegionBandwidthLimits(ctx context.Context, ccnId, re
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 		logId,
 		request.GetAction(),
-		request.ToJsonString(),
-		response.ToJsonString())
+		connectivity.RedactJSON(request.ToJsonString()),
+		connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -929,7 +928,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -939,7 +938,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -953,7 +952,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CcnBandwidthSet) < 1 {
 			break
@@ -977,7 +976,7 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1007,14 +1006,14 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitor(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.CrossBorderFlowMonitorData) < 1 {
 		return
@@ -1033,7 +1032,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1092,7 +1091,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1106,7 +1105,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CrossBorderComplianceSet) < 1 {
 			break
@@ -1194,18 +1193,18 @@ func (me *VpcService) DescribeRouteTableAssociatedInstancesById(ctx context.Cont
 	//
 	//defer func() {
 	//	if errRet != nil {
-	//		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+	//		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 	//	}
 	//}()
 	//
-	//ratelimit.Check(request.GetAction())
+	//me.client.RateLimiter.Check(request.GetAction())
 	//response, err := me.client.UseVpcClient().DescribeRouteTableAssociatedInstances(request)
 	//if err != nil {
 	//	errRet = err
 	//	return
 	//}
 	//
-	//log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	//log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	//if response.Response == nil || len(response.Response.InstanceBindSet) < 1 {
 	//	return
 	//}
@@ -1223,18 +1222,18 @@ func (me *VpcService) DescribeVpcReplaceCcnRouteTableInputPolicysById(ctx contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnRouteTableInputPolicys(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response.Response == nil || len(response.Response.PolicySet) < 1 {
 		return
 	}
@@ -1252,18 +1251,18 @@ func (me *VpcService) DescribeVpcReplaceCcnRouteTableBroadcastPolicysById(ctx co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnRouteTableBroadcastPolicys(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response.Response == nil || len(response.Response.PolicySet) < 1 {
 		return
 	}
@@ -1285,18 +1284,18 @@ func (me *VpcService) DescribeVpcReplaceCcnRouteTableSelectionPolicysById(ctx co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeRouteTableSelectionPolicies(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response.Response == nil || len(response.Response.RouteSelectionPolicySet) == 0 {
 		return
 	}
@@ -1318,18 +1317,18 @@ func (me *VpcService) DescribeVpcCcnRouteTablesById(ctx context.Context, routeTa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeCcnRouteTables(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response.Response == nil || len(response.Response.CcnRouteTableSet) != 1 {
 		return
 	}
@@ -1351,18 +1350,18 @@ func (me *VpcService) DescribeVpcCcnRoutesById(ctx context.Context, ccnId string
 	}
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeCcnRoutes(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil && response.Response != nil && len(response.Response.RouteSet) > 0 {
 		ccnRoutes = response.Response.RouteSet[0]
@@ -1379,7 +1378,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1392,7 +1391,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1406,7 +1405,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CcnSet) < 1 {
 			break
@@ -1430,7 +1429,7 @@ func (me *VpcService) DescribeCcnRouteTableInputPoliciesByFilter(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1446,14 +1445,14 @@ func (me *VpcService) DescribeCcnRouteTableInputPoliciesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCcnV20170312Client().DescribeCcnRouteTableInputPolicys(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.PolicySet) < 1 {
 		return
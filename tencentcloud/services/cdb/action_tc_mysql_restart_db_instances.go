@@ -0,0 +1,138 @@
+package cdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &MysqlRestartDbInstancesAction{}
+	_ action.ActionWithConfigure = &MysqlRestartDbInstancesAction{}
+)
+
+// NewMysqlRestartDbInstancesAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewMysqlRestartDbInstancesAction() action.Action {
+	return &MysqlRestartDbInstancesAction{}
+}
+
+// MysqlRestartDbInstancesAction implements action.Action for
+// tencentcloud_mysql_restart_db_instances. It replaces
+// tencentcloud_mysql_restart_db_instances_operation, which leaves an entry in
+// state after the restart.
+type MysqlRestartDbInstancesAction struct {
+	service *MysqlService
+}
+
+// MysqlRestartDbInstancesActionModel maps the action configuration attributes.
+type MysqlRestartDbInstancesActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+}
+
+func (a *MysqlRestartDbInstancesAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_mysql_restart_db_instances"
+}
+
+func (a *MysqlRestartDbInstancesAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a MySQL instance and waits until the restart task has finished.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance ID in the format: cdb-c1nl9rpv, which is the same as the instance ID displayed on the cloud database console page.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *MysqlRestartDbInstancesAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &MysqlService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *MysqlRestartDbInstancesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_mysql_restart_db_instances.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data MysqlRestartDbInstancesActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	instanceId := data.InstanceId.ValueString()
+
+	var (
+		request  = mysql.NewRestartDBInstancesRequest()
+		response = mysql.NewRestartDBInstancesResponse()
+	)
+	request.InstanceIds = []*string{&instanceId}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting MySQL instance %s", instanceId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := a.service.client.UseMysqlClient().RestartDBInstances(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s restart mysql instance failed, reason:%+v", logId, err)
+		resp.Diagnostics.AddError("Error restarting MySQL instance", err.Error())
+		return
+	}
+
+	if response.Response == nil || response.Response.AsyncRequestId == nil {
+		resp.Diagnostics.AddError("Error restarting MySQL instance", fmt.Sprintf("restart of MySQL instance %s returned no async request ID", instanceId))
+		return
+	}
+
+	asyncRequestId := *response.Response.AsyncRequestId
+	refresh := helper.ProgressRefreshFunc(a.service.MysqlAsyncRequestStateRefreshFunc(asyncRequestId), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restart task %s of MySQL instance %s is %s", asyncRequestId, instanceId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING}, []string{MYSQL_TASK_STATUS_SUCCESS}, tccommon.ReadRetryTimeout, time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for MySQL instance to restart", err.Error())
+	}
+}
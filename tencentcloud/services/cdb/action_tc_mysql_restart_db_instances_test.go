@@ -0,0 +1,82 @@
+package cdb_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svccdb "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cdb"
+)
+
+func TestMysqlRestartDbInstancesAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svccdb.NewMysqlRestartDbInstancesAction(), "tencentcloud_mysql_restart_db_instances", map[string]bool{"instance_id": true})
+}
+
+// invokeMysqlRestartDbInstancesAction invokes the action against an API
+// stand-in answering the actions of responses, and returns the progress
+// messages and the response.
+func invokeMysqlRestartDbInstancesAction(t *testing.T, responses map[string][]string) ([]string, *action.InvokeResponse) {
+	t.Helper()
+
+	server := acctest.NewActionServer(t, responses)
+	return acctest.InvokeAction(t, svccdb.NewMysqlRestartDbInstancesAction(), server.Client("cdb"), map[string]tftypes.Value{
+		"instance_id": tftypes.NewValue(tftypes.String, "cdb-test"),
+	})
+}
+
+// TestMysqlRestartDbInstancesAction_Invoke validates that the action waits for
+// the restart task and reports every state of the task once.
+func TestMysqlRestartDbInstancesAction_Invoke(t *testing.T) {
+	progress, resp := invokeMysqlRestartDbInstancesAction(t, map[string][]string{
+		"RestartDBInstances": {`{"Response":{"AsyncRequestId":"async-1","RequestId":"req-1"}}`},
+		"DescribeAsyncRequestInfo": {
+			`{"Response":{"Status":"RUNNING","Info":"","RequestId":"req-2"}}`,
+			`{"Response":{"Status":"SUCCESS","Info":"","RequestId":"req-3"}}`,
+		},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	want := []string{
+		"Restarting MySQL instance cdb-test",
+		"Restart task async-1 of MySQL instance cdb-test is RUNNING",
+		"Restart task async-1 of MySQL instance cdb-test is SUCCESS",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
+
+// TestMysqlRestartDbInstancesAction_InvokeNoAsyncRequestId validates that a
+// restart response without an async request ID is an error.
+func TestMysqlRestartDbInstancesAction_InvokeNoAsyncRequestId(t *testing.T) {
+	_, resp := invokeMysqlRestartDbInstancesAction(t, map[string][]string{
+		"RestartDBInstances": {`{"Response":{"RequestId":"req-1"}}`},
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic without an async request ID")
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, "async request ID") {
+		t.Errorf("unexpected diagnostic: %s", detail)
+	}
+}
+
+// TestMysqlRestartDbInstancesAction_InvokeTaskFailed validates that a failed
+// restart task is an error.
+func TestMysqlRestartDbInstancesAction_InvokeTaskFailed(t *testing.T) {
+	_, resp := invokeMysqlRestartDbInstancesAction(t, map[string][]string{
+		"RestartDBInstances":       {`{"Response":{"AsyncRequestId":"async-1","RequestId":"req-1"}}`},
+		"DescribeAsyncRequestInfo": {`{"Response":{"Status":"FAILED","Info":"instance is locked","RequestId":"req-2"}}`},
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic for a failed restart task")
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, "instance is locked") {
+		t.Errorf("unexpected diagnostic: %s", detail)
+	}
+}
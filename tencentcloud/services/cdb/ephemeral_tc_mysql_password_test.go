@@ -0,0 +1,118 @@
+package cdb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	svccdb "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cdb"
+)
+
+func TestMysqlPasswordEphemeralResource_Metadata(t *testing.T) {
+	e := svccdb.NewMysqlPasswordEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+
+	e.Metadata(t.Context(), ephemeral.MetadataRequest{}, resp)
+
+	if resp.TypeName != "tencentcloud_mysql_password" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_mysql_password")
+	}
+}
+
+func TestMysqlPasswordEphemeralResource_Schema(t *testing.T) {
+	e := svccdb.NewMysqlPasswordEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+
+	e.Schema(t.Context(), ephemeral.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", resp.Diagnostics)
+	}
+	if attr, ok := resp.Schema.Attributes["length"].(schema.Int64Attribute); !ok || !attr.Optional {
+		t.Errorf("attribute length should be an Optional Int64Attribute")
+	}
+	if attr, ok := resp.Schema.Attributes["password"].(schema.StringAttribute); !ok || !attr.Computed || !attr.Sensitive {
+		t.Errorf("attribute password should be a Computed and Sensitive StringAttribute")
+	}
+}
+
+// TestMysqlPasswordEphemeralResource_Open checks that every generated password
+// is accepted by tccommon.ValidateMysqlPassword.
+func TestMysqlPasswordEphemeralResource_Open(t *testing.T) {
+	ctx := t.Context()
+	e := svccdb.NewMysqlPasswordEphemeralResource()
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	for _, length := range []interface{}{nil, 8, 32, 64} {
+		for i := 0; i < 20; i++ {
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"length":   tftypes.NewValue(tftypes.Number, length),
+						"password": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			}
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+
+			e.Open(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Open returned diagnostics: %v", resp.Diagnostics)
+			}
+
+			var password string
+			resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("password"), &password)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading password returned diagnostics: %v", resp.Diagnostics)
+			}
+			if want, ok := length.(int); ok && len(password) != want {
+				t.Errorf("len(password) = %d, want %d", len(password), want)
+			}
+			if _, errs := tccommon.ValidateMysqlPassword(password, "password"); len(errs) > 0 {
+				t.Errorf("generated password %q is invalid: %v", password, errs)
+			}
+		}
+	}
+}
+
+func TestMysqlPasswordEphemeralResource_OpenInvalidLength(t *testing.T) {
+	ctx := t.Context()
+	e := svccdb.NewMysqlPasswordEphemeralResource()
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"length":   tftypes.NewValue(tftypes.Number, 65),
+				"password": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	e.Open(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a password length of 65")
+	}
+}
@@ -137,3 +137,9 @@ var MYSQL_LOG_TO_CLS_TYPE = []string{
 	MYSQL_LOG_TO_CLS_TYPE_ERROR,
 	MYSQL_LOG_TO_CLS_TYPE_SLOW,
 }
+
+const (
+	MYSQL_PASSWORD_DEFAULT_LENGTH = 16
+	MYSQL_PASSWORD_MIN_LENGTH     = 8
+	MYSQL_PASSWORD_MAX_LENGTH     = 64
+)
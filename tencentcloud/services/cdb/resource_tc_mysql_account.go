@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,10 +44,26 @@ func ResourceTencentCloudMysqlAccount() *schema.Resource {
 			},
 			"password": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				ValidateFunc: tccommon.ValidateMysqlPassword,
+				Description:  "Operation password. Exactly one of `password` and `password_wo` must be set.",
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
 				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
 				ValidateFunc: tccommon.ValidateMysqlPassword,
-				Description:  "Operation password.",
+				Description:  "Write-only operation password, which is never persisted in state. It can be generated by the `tencentcloud_mysql_password` ephemeral resource.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. As write-only values are not stored, change this value to update the password to the current `password_wo`.",
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -77,7 +94,7 @@ func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interfa
 		mysqlId            = d.Get("mysql_id").(string)
 		accountName        = d.Get("name").(string)
 		accountHost        = d.Get("host").(string)
-		accountPassword    = d.Get("password").(string)
+		accountPassword    = helper.GetStringWithWriteOnly(d, "password", "password_wo")
 		accountDescription = d.Get("description").(string)
 		maxUserConnections = int64(d.Get("max_user_connections").(int))
 	)
@@ -232,9 +249,10 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 
 	}
 
-	if d.HasChange("password") {
+	if d.HasChanges("password", "password_wo_version") {
 
-		asyncRequestId, err := mysqlService.ModifyAccountPassword(ctx, mysqlId, accountName, accountHost, d.Get("password").(string))
+		password := helper.GetStringWithWriteOnly(d, "password", "password_wo")
+		asyncRequestId, err := mysqlService.ModifyAccountPassword(ctx, mysqlId, accountName, accountHost, password)
 		if err != nil {
 			return err
 		}
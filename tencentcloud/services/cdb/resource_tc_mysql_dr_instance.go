@@ -13,6 +13,7 @@ import (
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -222,6 +223,7 @@ func ResourceTencentCloudMysqlDrInstance() *schema.Resource {
 				Description: "instance intranet IP.",
 			},
 		},
+		CustomizeDiff: helper.DiffImmutableAfterCreate("master_instance_id", "master_region", "availability_zone"),
 	}
 }
 
@@ -271,11 +273,11 @@ func resourceTencentCloudMysqlDrInstanceCreate(d *schema.ResourceData, meta inte
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMysqlClient().CreateDBInstance(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return err
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		}
 		if len(response.Response.InstanceIds) != 1 {
 			return fmt.Errorf("mysql CreateDBInstance return len(InstanceIds) is not 1,but %d", len(response.Response.InstanceIds))
@@ -290,11 +292,11 @@ func resourceTencentCloudMysqlDrInstanceCreate(d *schema.ResourceData, meta inte
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMysqlClient().CreateDBInstanceHour(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return err
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		}
 		if len(response.Response.InstanceIds) != 1 {
 			return fmt.Errorf("mysql CreateDBInstanceHour return len(InstanceIds) is not 1,but %d", len(response.Response.InstanceIds))
@@ -478,7 +480,7 @@ func resourceTencentCloudMysqlDrInstanceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -510,18 +512,6 @@ func resourceTencentCloudMysqlDrInstanceUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	immutableFields := []string{
-		"master_instance_id",
-		"zone",
-		"master_region",
-	}
-
-	for _, f := range immutableFields {
-		if d.HasChange(f) {
-			return fmt.Errorf("argument `%s` cannot be modified for now", f)
-		}
-	}
-
 	d.Partial(false)
 
 	return resourceTencentCloudMysqlDrInstanceRead(d, meta)
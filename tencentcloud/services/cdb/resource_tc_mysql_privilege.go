@@ -16,7 +16,6 @@ import (
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ResourceTencentCloudMysqlPrivilegeId struct {
@@ -241,7 +240,7 @@ func (me *ResourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 	response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMysqlClient().ModifyAccountPrivileges(request)
 	if err != nil {
 		return err
@@ -363,7 +362,7 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 
 	var response *cdb.DescribeAccountPrivilegesResponse
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMysqlClient().DescribeAccountPrivileges(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,9 +15,10 @@ import (
 
 func ResourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
-		Read:   resourceTencentCloudMysqlRestartDbInstancesOperationRead,
-		Delete: resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
+		DeprecationMessage: "This resource will be deprecated in Terraform TencentCloud provider later version. Please use the `tencentcloud_mysql_restart_db_instances` action instead.",
+		Create:             resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
+		Read:               resourceTencentCloudMysqlRestartDbInstancesOperationRead,
+		Delete:             resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -57,7 +59,7 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
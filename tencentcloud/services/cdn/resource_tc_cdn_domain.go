@@ -15,7 +15,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudCdnDomain() *schema.Resource {
@@ -2933,7 +2932,7 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 	}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		_, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCdnClient().AddCdnDomain(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4810,7 +4809,7 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 
 	if len(updateAttrs) > 0 {
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			_, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCdnClient().UpdateDomainConfig(request)
 			if err != nil {
 				if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4841,8 +4840,8 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := svctag.NewTagService(client)
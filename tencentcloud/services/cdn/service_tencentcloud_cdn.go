@@ -12,7 +12,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewCdnService(client *connectivity.TencentCloudClient) CdnService {
@@ -40,7 +39,7 @@ func (me *CdnService) DescribeDomainsConfigByDomain(ctx context.Context, domain
 	}
 	request.Filters = append(request.Filters, filter)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = domain
 	response, err := me.client.UseCdnClient(iacExtInfo).DescribeDomainsConfig(request)
@@ -51,7 +50,7 @@ func (me *CdnService) DescribeDomainsConfigByDomain(ctx context.Context, domain
 			}
 		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
@@ -66,11 +65,11 @@ func (me *CdnService) UpdateDomainConfig(ctx context.Context, request *cdn.Updat
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().UpdateDomainConfig(request)
 
 	if err != nil {
@@ -79,7 +78,7 @@ func (me *CdnService) UpdateDomainConfig(ctx context.Context, request *cdn.Updat
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -89,11 +88,11 @@ func (me *CdnService) DeleteDomain(ctx context.Context, domain string) error {
 	request := cdn.NewDeleteCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().DeleteCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -104,11 +103,11 @@ func (me *CdnService) StopDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStopCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().StopCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -119,11 +118,11 @@ func (me *CdnService) StartDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStartCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().StartCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	return nil
@@ -156,7 +155,7 @@ func (me *CdnService) DescribeDomainsConfigByFilters(ctx context.Context,
 
 	for {
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseCdnClient().DescribeDomainsConfig(request)
 
 			if err != nil {
@@ -172,7 +171,7 @@ func (me *CdnService) DescribeDomainsConfigByFilters(ctx context.Context,
 
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			errRet = err
 			return
 		}
@@ -194,11 +193,11 @@ func (me *CdnService) VerifyDomainRecord(ctx context.Context, domain string) (re
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().VerifyDomainRecord(request)
 
 	if err != nil {
@@ -210,7 +209,7 @@ func (me *CdnService) VerifyDomainRecord(ctx context.Context, domain string) (re
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -221,13 +220,13 @@ func (me *CdnService) CreateVerifyRecord(ctx context.Context, domain string) (re
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().CreateVerifyRecord(request)
 
 	if err != nil {
@@ -238,7 +237,7 @@ func (me *CdnService) CreateVerifyRecord(ctx context.Context, domain string) (re
 	resp = response.Response
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -248,11 +247,11 @@ func (me *CdnService) DescribePurgeTasks(ctx context.Context, request *cdn.Descr
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().DescribePurgeTasks(request)
 
 	if err != nil {
@@ -265,7 +264,7 @@ func (me *CdnService) DescribePurgeTasks(ctx context.Context, request *cdn.Descr
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -275,11 +274,11 @@ func (me *CdnService) DescribePushTasks(ctx context.Context, request *cdn.Descri
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().DescribePushTasks(request)
 
 	if err != nil {
@@ -292,7 +291,7 @@ func (me *CdnService) DescribePushTasks(ctx context.Context, request *cdn.Descri
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -302,11 +301,11 @@ func (me *CdnService) PurgeUrlsCache(ctx context.Context, request *cdn.PurgeUrls
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().PurgeUrlsCache(request)
 
 	if err != nil {
@@ -319,7 +318,7 @@ func (me *CdnService) PurgeUrlsCache(ctx context.Context, request *cdn.PurgeUrls
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -329,11 +328,11 @@ func (me *CdnService) PushUrlsCache(ctx context.Context, request *cdn.PushUrlsCa
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().PushUrlsCache(request)
 
 	if err != nil {
@@ -346,7 +345,7 @@ func (me *CdnService) PushUrlsCache(ctx context.Context, request *cdn.PushUrlsCa
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -7,7 +7,6 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudClickhouseBackupJobDetail() *schema.Resource {
@@ -102,7 +101,7 @@ func dataSourceTencentCloudClickhouseBackupJobDetailRead(d *schema.ResourceData,
 	var tableContents []*clickhouse.BackupTableContent
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCdwchClient().DescribeBackUpJobDetail(request)
 		if e != nil {
 			return tccommon.RetryError(e)
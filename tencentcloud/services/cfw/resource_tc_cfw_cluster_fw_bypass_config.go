@@ -11,6 +11,7 @@ import (
 	cfwv20190904 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -144,7 +145,7 @@ func resourceTencentCloudCfwClusterFwBypassConfigRead(d *schema.ResourceData, me
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -257,7 +258,7 @@ func resourceTencentCloudCfwClusterFwBypassConfigUpdate(d *schema.ResourceData,
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -300,7 +301,7 @@ func resourceTencentCloudCfwClusterFwBypassConfigUpdate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if result == nil || result.Response == nil || result.Response.Data == nil {
 			return resource.NonRetryableError(fmt.Errorf("describe cfw_cluster_fw_bypass_config failed, response is nil"))
@@ -191,7 +191,7 @@ func (me *CiService) CloseCiOriginalImageProtectionById(ctx context.Context, buc
 		}
 	}()
 
-	_, err := tccommon.RetryWithContext(ctx, tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.CloseOriginProtect(ctx)
 	})
 
@@ -213,7 +213,7 @@ func (me *CiService) OpenCiOriginalImageProtectionById(ctx context.Context, buck
 		}
 	}()
 
-	_, err := tccommon.RetryWithContext(ctx, tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.OpenOriginProtect(ctx)
 	})
 
@@ -236,7 +236,7 @@ func (me *CiService) GetCiOriginalImageProtectionById(ctx context.Context, bucke
 		}
 	}()
 
-	resRaw, err := tccommon.RetryWithContext(ctx, tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UsePicClient(bucket).CI.GetOriginProtect(ctx)
 		return res, err
 	})
@@ -259,7 +259,7 @@ func (me *CiService) CloseCiGuetzliById(ctx context.Context, bucket string) (err
 		}
 	}()
 
-	_, err := tccommon.RetryWithContext(ctx, tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.DeleteGuetzli(ctx)
 	})
 
@@ -281,7 +281,7 @@ func (me *CiService) OpenCiGuetzliById(ctx context.Context, bucket string) (errR
 		}
 	}()
 
-	_, err := tccommon.RetryWithContext(ctx, tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	_, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.WriteRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		return me.client.UsePicClient(bucket).CI.PutGuetzli(ctx)
 	})
 
@@ -304,7 +304,7 @@ func (me *CiService) GetCiGuetzliById(ctx context.Context, bucket string) (*cos.
 		}
 	}()
 
-	resRaw, err := tccommon.RetryWithContext(ctx, tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UsePicClient(bucket).CI.GetGuetzli(ctx)
 		return res, err
 	})
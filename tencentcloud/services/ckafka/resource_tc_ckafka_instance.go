@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -879,7 +880,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 			_, err := service.client.UseCkafkaClient().InstanceScalingDown(request)
 			if err != nil {
 				return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
-					request.GetAction(), request.ToJsonString(), err.Error())
+					request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			}
 		} else if chargeType == CKAFKA_CHARGE_TYPE_PREPAID {
 			request := ckafka.NewModifyInstancePreRequest()
@@ -898,7 +899,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 			_, err := service.client.UseCkafkaClient().ModifyInstancePre(request)
 			if err != nil {
 				return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
-					request.GetAction(), request.ToJsonString(), err.Error())
+					request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			}
 		} else {
 			return fmt.Errorf("invalid `charge_type` value")
@@ -14,7 +14,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewCkafkaService(client *connectivity.TencentCloudClient) CkafkaService {
@@ -36,7 +35,7 @@ func (me *CkafkaService) CheckCkafkaInstanceReady(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.InstanceId = &instanceId
@@ -65,11 +64,11 @@ func (me *CkafkaService) CheckCkafkaInstanceReady(ctx context.Context,
 func (me *CkafkaService) ModifyCkafkaInstanceAttributes(ctx context.Context,
 	request *ckafka.ModifyInstanceAttributesRequest) (errRet error) {
 	logId := tccommon.GetLogId(ctx)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseCkafkaClient().ModifyInstanceAttributes(request)
 	if err != nil {
 		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
-			request.GetAction(), request.ToJsonString(), err.Error())
+			request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return
 }
@@ -84,7 +83,7 @@ func (me *CkafkaService) DescribeCkafkaInstanceById(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.InstanceId = &instanceId
@@ -130,7 +129,7 @@ func (me *CkafkaService) CreateUser(ctx context.Context, instanceId, user, passw
 		return err
 	}
 	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return nil
 }
@@ -178,12 +177,12 @@ func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string
 		request.Limit = &limit
 
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseCkafkaClient().DescribeUser(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -195,7 +194,7 @@ func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string
 		})
 
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 
@@ -244,7 +243,7 @@ func (me *CkafkaService) ModifyPassword(ctx context.Context, instanceId, user, o
 		return err
 	}
 	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return nil
 }
@@ -277,7 +276,7 @@ func (me *CkafkaService) DeleteUser(ctx context.Context, userId string) (errRet
 		return err
 	}
 	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return nil
 }
@@ -305,7 +304,7 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 		var response *ckafka.DescribeUserResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeUser(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -314,7 +313,7 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		} else {
 			if len(response.Response.Result.Users) < CKAFKA_DESCRIBE_LIMIT {
@@ -362,7 +361,7 @@ func (me *CkafkaService) CreateAcl(ctx context.Context, instanceId, resourceType
 		return err
 	}
 	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return nil
 }
@@ -399,7 +398,7 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 		var response *ckafka.DescribeACLResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeACL(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -408,7 +407,7 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		} else {
 			if len(response.Response.Result.AclList) < CKAFKA_DESCRIBE_LIMIT {
@@ -486,7 +485,7 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 		return err
 	}
 	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 	}
 	return nil
 }
@@ -499,7 +498,7 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 	var response *ckafka.DescribeInstanceAttributesResponse
 	var err error
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeInstanceAttributes(request)
 		if err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -512,7 +511,7 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 		return nil
 	})
 	if err != nil {
-		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 
@@ -542,7 +541,7 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 	var response *ckafka.DescribeTopicAttributesResponse
 	var err error
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeTopicAttributes(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -550,7 +549,7 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 		return nil
 	})
 	if err != nil {
-		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return
 	}
 
@@ -589,7 +588,7 @@ func (me *CkafkaService) DescribeCkafkaTopics(ctx context.Context, instanceId st
 		return
 	}
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseCkafkaClient().DescribeTopicDetail(request)
 		if err != nil {
 			errRet = err
@@ -617,7 +616,7 @@ func (me *CkafkaService) CreateCkafkaTopic(ctx context.Context, request *ckafka.
 	}()
 	var response *ckafka.CreateTopicResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreateTopic(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -671,7 +670,7 @@ func (me *CkafkaService) DescribeCkafkaTopicAttributes(ctx context.Context, inst
 	request.InstanceId = &instanceId
 	request.TopicName = &topicName
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCkafkaClient().DescribeTopicAttributes(request)
 	if err != nil {
 		errRet = err
@@ -699,7 +698,7 @@ func (me *CkafkaService) AddCkafkaTopicIpWhiteList(ctx context.Context, instance
 	request.IpWhiteList = whiteIpList
 	var response *ckafka.CreateTopicIpWhiteListResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreateTopicIpWhiteList(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -730,7 +729,7 @@ func (me *CkafkaService) AddCkafkaTopicPartition(ctx context.Context, instanceId
 	request.PartitionNum = &partitionNum
 	var response *ckafka.CreatePartitionResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		resp, e := me.client.UseCkafkaClient().CreatePartition(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -757,7 +756,7 @@ func (me *CkafkaService) DescribeCkafkaById(ctx context.Context, instanceId stri
 	}()
 
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	resp, err := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
 	if err != nil {
 		has = false
@@ -781,7 +780,7 @@ func (me *CkafkaService) ModifyCkafkaTopicAttribute(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, errRet := me.client.UseCkafkaClient().ModifyTopicAttributes(request)
 	if errRet != nil {
 		return errRet
@@ -803,7 +802,7 @@ func (me *CkafkaService) DeleteCkafkaTopic(ctx context.Context, instanceId strin
 	request.InstanceId = &instanceId
 	request.TopicName = &name
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		_, err := me.client.UseCkafkaClient().DeleteTopic(request)
 		if err != nil {
@@ -844,18 +843,18 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicById(ctx context.Context, top
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTopic(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.Result == nil {
 		return
@@ -873,18 +872,18 @@ func (me *CkafkaService) DeleteCkafkaDatahubTopicById(ctx context.Context, topic
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteDatahubTopic(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -897,18 +896,18 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeConnectResource(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.Result == nil {
 		return
@@ -926,18 +925,18 @@ func (me *CkafkaService) DeleteCkafkaConnectResourceById(ctx context.Context, re
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteConnectResource(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -963,7 +962,7 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	offset := 0
@@ -989,14 +988,14 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceByFilter(ctx context.Conte
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeConnectResources(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.Result == nil {
 		errRet = fmt.Errorf("Response is null")
@@ -1014,7 +1013,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1033,13 +1032,13 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicByFilter(ctx context.Context,
 	}
 	request.Limit = helper.IntUint64(limit)
 	request.Offset = helper.IntUint64(offset)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTopics(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response is null")
@@ -1058,7 +1057,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1074,7 +1073,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -1088,7 +1087,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.Result == nil {
 			break
@@ -1112,7 +1111,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1134,7 +1133,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -1148,7 +1147,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.Result == nil || len(response.Response.Result.TaskList) < 1 {
 			break
@@ -1172,7 +1171,7 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1185,7 +1184,7 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -1199,7 +1198,7 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || len(response.Response.Result.GroupList) < 1 {
 			break
@@ -1223,7 +1222,7 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1242,7 +1241,7 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -1256,7 +1255,7 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.Result == nil || len(response.Response.Result.TopicList) < 1 {
 			break
@@ -1280,7 +1279,7 @@ func (me *CkafkaService) DescribeCkafkaGroupInfoByFilter(ctx context.Context, pa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1293,14 +1292,14 @@ func (me *CkafkaService) DescribeCkafkaGroupInfoByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeGroupInfo(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response body is null")
@@ -1319,20 +1318,20 @@ func (me *CkafkaService) DescribeCkafkaTaskStatusByFilter(ctx context.Context, f
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.FlowId = helper.IntInt64(flowId)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeTaskStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response body is null")
@@ -1351,7 +1350,7 @@ func (me *CkafkaService) DescribeCkafkaTopicFlowRankingByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1370,14 +1369,14 @@ func (me *CkafkaService) DescribeCkafkaTopicFlowRankingByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeTopicFlowRanking(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response body is null")
@@ -1396,7 +1395,7 @@ func (me *CkafkaService) DescribeCkafkaTopicProduceConnectionByFilter(ctx contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1409,14 +1408,14 @@ func (me *CkafkaService) DescribeCkafkaTopicProduceConnectionByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeTopicProduceConnection(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response body is null")
@@ -1435,7 +1434,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1448,7 +1447,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1462,7 +1461,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.Result == nil || len(response.Response.Result.GroupsInfo) < 1 {
 			break
@@ -1486,7 +1485,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1502,7 +1501,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -1516,7 +1515,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || response.Response.Result == nil || len(response.Response.Result.TopicInSyncReplicaList) < 1 {
 			break
@@ -1541,18 +1540,18 @@ func (me *CkafkaService) DescribeCkafkaAclRuleById(ctx context.Context, instance
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeAclRule(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.Result == nil || len(response.Response.Result.AclRuleList) < 1 {
 		return
@@ -1571,18 +1570,18 @@ func (me *CkafkaService) DeleteCkafkaAclRuleById(ctx context.Context, instanceId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteAclRule(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1596,18 +1595,18 @@ func (me *CkafkaService) DescribeCkafkaConsumerGroupById(ctx context.Context, in
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeConsumerGroup(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.Result == nil {
 		return
@@ -1626,18 +1625,18 @@ func (me *CkafkaService) DeleteCkafkaConsumerGroupById(ctx context.Context, inst
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteGroup(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1650,18 +1649,18 @@ func (me *CkafkaService) DeleteCkafkaDatahubTaskById(ctx context.Context, taskId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteDatahubTask(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1674,18 +1673,18 @@ func (me *CkafkaService) DescribeDatahubTask(ctx context.Context, taskId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeDatahubTask(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	if response == nil || response.Response == nil || response.Response.Result == nil {
 		errRet = fmt.Errorf("Response body is null")
 		return
@@ -1716,7 +1715,7 @@ func (me *CkafkaService) DescribeCkafkaCkafkaZoneByFilter(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1726,14 +1725,14 @@ func (me *CkafkaService) DescribeCkafkaCkafkaZoneByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeCkafkaZone(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("Response body is null")
@@ -1753,18 +1752,18 @@ func (me *CkafkaService) DescribeCkafkaRouteById(ctx context.Context, instanceId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeRoute(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response == nil || response.Response.Result == nil || len(response.Response.Result.Routers) < 1 {
 		return
@@ -1783,18 +1782,18 @@ func (me *CkafkaService) DeleteCkafkaRouteById(ctx context.Context, instanceId s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DeleteRoute(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	// response.Response.Result.Data.FlowId
 	return
 }
@@ -1821,18 +1820,18 @@ func (me *CkafkaService) DescribeCkafkaVersionByFilter(ctx context.Context, inst
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseCkafkaClient().DescribeCkafkaVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.Result == nil {
 		return
@@ -1851,7 +1850,7 @@ func (me *CkafkaService) DescribeInstancesByFilter(ctx context.Context, param ma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1883,12 +1882,12 @@ func (me *CkafkaService) DescribeInstancesByFilter(ctx context.Context, param ma
 		request.Offset = &offset
 		request.Limit = &limit
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.Result == nil || result.Response.Result.InstanceList == nil {
@@ -50,6 +50,13 @@ var CLB_LISTENER_SCHEDULER = []string{
 	CLB_LISTENER_SCHEDULER_IP_HASH,
 }
 
+// CLB_LISTENER_SCHEDULER_OF_LISTENER are the schedulers of a listener,
+// `IP_HASH` is set on the rules of HTTP/HTTPS listeners only.
+var CLB_LISTENER_SCHEDULER_OF_LISTENER = []string{
+	CLB_LISTENER_SCHEDULER_WRR,
+	CLB_LISTENER_SCHEDULER_LEASTCONN,
+}
+
 const (
 	HTTP_VERSION_ONE_ZERO = "HTTP/1.0"
 	HTTP_VERSION_ONE_ONE  = "HTTP/1.1"
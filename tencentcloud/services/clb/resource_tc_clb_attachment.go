@@ -14,8 +14,8 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudClbServerAttachment() *schema.Resource {
@@ -170,13 +170,13 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient().RegisterTargets(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				requestId = *result.Response.RequestId
 				retryErr := waitForTaskFinish(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient())
 				if retryErr != nil {
@@ -436,7 +436,7 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient().DeregisterTargets(request)
 			if e != nil {
 				ee, ok := e.(*sdkErrors.TencentCloudSDKError)
@@ -447,7 +447,7 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				requestId = *result.Response.RequestId
 				retryErr := waitForTaskFinish(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient())
 				if retryErr != nil {
@@ -562,7 +562,7 @@ func resourceTencentCloudClbServerAttachmentAdd(d *schema.ResourceData, meta int
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			requestId = *response.Response.RequestId
 			retryErr := waitForTaskFinish(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient())
 			if retryErr != nil {
@@ -742,13 +742,13 @@ func resourceTencentCloudClbServerAttachmentModifyWeight(d *schema.ResourceData,
 	}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient().ModifyTargetWeight(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		requestId := *response.Response.RequestId
 		if retryErr := waitForTaskFinish(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient()); retryErr != nil {
 			return resource.NonRetryableError(errors.WithStack(retryErr))
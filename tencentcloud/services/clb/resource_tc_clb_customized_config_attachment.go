@@ -9,6 +9,7 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
 	clbintl "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/clb/v20180317"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -107,7 +108,7 @@ func resourceTencentCloudClbCustomizedConfigAttachmentCreate(d *schema.ResourceD
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Associate CLB Customized Config Failed, Response is nil."))
 			}
@@ -225,7 +226,7 @@ func resourceTencentCloudClbCustomizedConfigAttachmentUpdate(d *schema.ResourceD
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					if result == nil || result.Response == nil || result.Response.RequestId == nil {
 						return resource.NonRetryableError(fmt.Errorf("Disassociate CLB Customized Config Failed, Response is nil."))
 					}
@@ -276,7 +277,7 @@ func resourceTencentCloudClbCustomizedConfigAttachmentUpdate(d *schema.ResourceD
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					if result == nil || result.Response == nil || result.Response.RequestId == nil {
 						return resource.NonRetryableError(fmt.Errorf("Associate CLB Customized Config Failed, Response is nil."))
 					}
@@ -340,7 +341,7 @@ func resourceTencentCloudClbCustomizedConfigAttachmentDelete(d *schema.ResourceD
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Disassociate CLB Customized Config Failed, Response is nil."))
 			}
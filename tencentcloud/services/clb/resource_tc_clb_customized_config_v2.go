@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	clbintl "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/clb/v20180317"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -85,7 +86,7 @@ func resourceTencentCloudClbCustomizedConfigV2Create(d *schema.ResourceData, met
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Create CLB Customized Config Failed, Response is nil."))
 			}
@@ -195,7 +196,7 @@ func resourceTencentCloudClbCustomizedConfigV2Update(d *schema.ResourceData, met
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				if result == nil || result.Response == nil || result.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Update CLB Customized Config Failed, Response is nil."))
 				}
@@ -240,7 +241,7 @@ func resourceTencentCloudClbCustomizedConfigV2Delete(d *schema.ResourceData, met
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Delete CLB Customized Config Failed, Response is nil."))
 			}
@@ -18,6 +18,7 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -30,13 +31,14 @@ func ResourceTencentCloudClbInstance() *schema.Resource {
 		Update: resourceTencentCloudClbInstanceUpdate,
 		Delete: resourceTencentCloudClbInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: tccommon.ImportStateWithRegion(schema.ImportStatePassthroughContext),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"network_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -372,6 +374,8 @@ func ResourceTencentCloudClbInstance() *schema.Resource {
 func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_clb_instance.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
@@ -625,7 +629,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.RequestId == nil {
@@ -673,7 +677,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, sgRequest.GetAction(), sgRequest.ToJsonString(), sgResponse.ToJsonString())
+					logId, sgRequest.GetAction(), connectivity.RedactJSON(sgRequest.ToJsonString()), connectivity.RedactJSON(sgResponse.ToJsonString()))
 				requestId := *sgResponse.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutCreate))
 				if retryErr != nil {
@@ -702,7 +706,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 					return tccommon.RetryError(e)
 				} else {
 					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-						logId, logRequest.GetAction(), logRequest.ToJsonString(), logResponse.ToJsonString())
+						logId, logRequest.GetAction(), connectivity.RedactJSON(logRequest.ToJsonString()), connectivity.RedactJSON(logResponse.ToJsonString()))
 					requestId := *logResponse.Response.RequestId
 					retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutCreate))
 					if retryErr != nil {
@@ -740,7 +744,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, mRequest.GetAction(), mRequest.ToJsonString(), mResponse.ToJsonString())
+					logId, mRequest.GetAction(), connectivity.RedactJSON(mRequest.ToJsonString()), connectivity.RedactJSON(mResponse.ToJsonString()))
 				requestId := *mResponse.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutCreate))
 				if retryErr != nil {
@@ -769,7 +773,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 					return tccommon.RetryError(e)
 				} else {
 					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-						logId, mRequest.GetAction(), mRequest.ToJsonString(), mResponse.ToJsonString())
+						logId, mRequest.GetAction(), connectivity.RedactJSON(mRequest.ToJsonString()), connectivity.RedactJSON(mResponse.ToJsonString()))
 					requestId := *mResponse.Response.RequestId
 					retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutCreate))
 					if retryErr != nil {
@@ -798,7 +802,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, mRequest.GetAction(), mRequest.ToJsonString(), mResponse.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, mRequest.GetAction(), connectivity.RedactJSON(mRequest.ToJsonString()), connectivity.RedactJSON(mResponse.ToJsonString()))
 					if mResponse == nil || mResponse.Response == nil || mResponse.Response.RequestId == nil {
 						return resource.NonRetryableError(fmt.Errorf("Modify load balancer attributes failed, Response is nil."))
 					}
@@ -827,6 +831,9 @@ func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{
 	defer tccommon.LogElapsed("resource.tencentcloud_clb_instance.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -1032,6 +1039,8 @@ func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{
 func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_clb_instance.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
@@ -1103,7 +1112,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			taskId = *result.Response.RequestId
@@ -1166,7 +1175,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				requestId := *response.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutUpdate))
 				if retryErr != nil {
@@ -1200,7 +1209,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, sgRequest.GetAction(), sgRequest.ToJsonString(), sgResponse.ToJsonString())
+					logId, sgRequest.GetAction(), connectivity.RedactJSON(sgRequest.ToJsonString()), connectivity.RedactJSON(sgResponse.ToJsonString()))
 				requestId := *sgResponse.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutUpdate))
 				if retryErr != nil {
@@ -1230,7 +1239,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, logRequest.GetAction(), logRequest.ToJsonString(), logResponse.ToJsonString())
+					logId, logRequest.GetAction(), connectivity.RedactJSON(logRequest.ToJsonString()), connectivity.RedactJSON(logResponse.ToJsonString()))
 				requestId := *logResponse.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutUpdate))
 				if retryErr != nil {
@@ -1262,7 +1271,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, pRequest.GetAction(), pRequest.ToJsonString(), pResponse.ToJsonString())
+					logId, pRequest.GetAction(), connectivity.RedactJSON(pRequest.ToJsonString()), connectivity.RedactJSON(pResponse.ToJsonString()))
 				requestId := *pResponse.Response.RequestId
 				retryErr := waitForTaskFinishWithTimeout(requestId, meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient(), d.Timeout(schema.TimeoutUpdate))
 				if retryErr != nil {
@@ -1375,8 +1384,8 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldValue, newValue := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
@@ -1394,6 +1403,8 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 func resourceTencentCloudClbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_clb_instance.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
@@ -8,11 +8,13 @@ import (
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -203,7 +205,7 @@ func ResourceTencentCloudClbListener() *schema.Resource {
 				Type:         schema.TypeString,
 				Default:      CLB_LISTENER_SCHEDULER_WRR,
 				Optional:     true,
-				ValidateFunc: tccommon.ValidateAllowedStringValue(CLB_LISTENER_SCHEDULER),
+				ValidateFunc: tccommon.ValidateAllowedStringValue(CLB_LISTENER_SCHEDULER_OF_LISTENER),
 				Description:  "Scheduling method. Valid values: `WRR` (weighted round-robin), `LEAST_CONN` (least connections). Default is `WRR`. Only applicable to `TCP`/`UDP`/`TCP_SSL`/`QUIC` listeners.",
 			},
 			"sni_switch": {
@@ -325,6 +327,42 @@ func ResourceTencentCloudClbListener() *schema.Resource {
 				Description: "ID of this CLB listener.",
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			helper.DiffConflictsWhen("protocol", []string{
+				CLB_LISTENER_PROTOCOL_TCP,
+				CLB_LISTENER_PROTOCOL_UDP,
+				CLB_LISTENER_PROTOCOL_HTTP,
+			}, "certificate_ssl_mode", "certificate_id", "certificate_ca_id", "multi_cert_info"),
+
+			helper.DiffRequiredWhen("certificate_ssl_mode", []string{CERT_SSL_MODE_UNI}, "certificate_id"),
+			helper.DiffRequiredWhen("certificate_ssl_mode", []string{CERT_SSL_MODE_MUT}, "certificate_id", "certificate_ca_id"),
+
+			helper.DiffConflictsWhen("protocol", []string{
+				CLB_LISTENER_PROTOCOL_HTTP,
+				CLB_LISTENER_PROTOCOL_HTTPS,
+				CLB_LISTENER_PROTOCOL_TCPSSL,
+				CLB_LISTENER_PROTOCOL_QUIC,
+			}, "session_expire_time"),
+
+			helper.DiffConflictsWhen("protocol", []string{
+				CLB_LISTENER_PROTOCOL_TCP,
+				CLB_LISTENER_PROTOCOL_UDP,
+				CLB_LISTENER_PROTOCOL_HTTP,
+				CLB_LISTENER_PROTOCOL_TCPSSL,
+				CLB_LISTENER_PROTOCOL_QUIC,
+			}, "sni_switch"),
+
+			// `IP_HASH` is set on the rules of HTTP/HTTPS listeners only, never on a listener
+			helper.DiffEnumPerParent("scheduler", "protocol", map[string][]string{
+				CLB_LISTENER_PROTOCOL_TCP:    {CLB_LISTENER_SCHEDULER_WRR, CLB_LISTENER_SCHEDULER_LEASTCONN},
+				CLB_LISTENER_PROTOCOL_UDP:    {CLB_LISTENER_SCHEDULER_WRR, CLB_LISTENER_SCHEDULER_LEASTCONN},
+				CLB_LISTENER_PROTOCOL_TCPSSL: {CLB_LISTENER_SCHEDULER_WRR, CLB_LISTENER_SCHEDULER_LEASTCONN},
+				CLB_LISTENER_PROTOCOL_QUIC:   {CLB_LISTENER_SCHEDULER_WRR, CLB_LISTENER_SCHEDULER_LEASTCONN},
+				CLB_LISTENER_PROTOCOL_HTTP:   {CLB_LISTENER_SCHEDULER_WRR},
+				CLB_LISTENER_PROTOCOL_HTTPS:  {CLB_LISTENER_SCHEDULER_WRR},
+			}),
+		),
 	}
 }
 
@@ -496,7 +534,7 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Create CLB listener failed, Response si nil."))
@@ -950,7 +988,7 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 				if response == nil || response.Response == nil || response.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Modify CLB listener failed, Response si nil."))
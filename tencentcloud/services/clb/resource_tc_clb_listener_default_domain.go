@@ -13,6 +13,7 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -83,6 +84,7 @@ func resourceTencentCloudClbListenerDefaultDomainCreate(d *schema.ResourceData,
 	request.DefaultServer = helper.Bool(true)
 
 	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient()
+	clbService := ClbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := client.ModifyDomainAttributes(request)
@@ -96,7 +98,7 @@ func resourceTencentCloudClbListenerDefaultDomainCreate(d *schema.ResourceData,
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.RequestId == nil {
@@ -115,7 +117,7 @@ func resourceTencentCloudClbListenerDefaultDomainCreate(d *schema.ResourceData,
 	taskId := *response.Response.RequestId
 
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
-	_ = waitTaskReady(ctx, client, taskId)
+	_ = clbService.waitTaskReady(ctx, client, taskId)
 
 	d.SetId(clbId + tccommon.FILED_SP + listenerId)
 	return resourceTencentCloudClbListenerDefaultDomainRead(d, meta)
@@ -213,6 +215,7 @@ func resourceTencentCloudClbListenerDefaultDomainUpdate(d *schema.ResourceData,
 		request.DefaultServer = helper.Bool(true)
 
 		client := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseClbClient()
+		clbService := ClbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			result, e := client.ModifyDomainAttributes(request)
@@ -226,7 +229,7 @@ func resourceTencentCloudClbListenerDefaultDomainUpdate(d *schema.ResourceData,
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.RequestId == nil {
@@ -245,7 +248,7 @@ func resourceTencentCloudClbListenerDefaultDomainUpdate(d *schema.ResourceData,
 		taskId := *response.Response.RequestId
 
 		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
-		_ = waitTaskReady(ctx, client, taskId)
+		_ = clbService.waitTaskReady(ctx, client, taskId)
 
 	}
 
@@ -14,6 +14,7 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -401,7 +402,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			if response == nil || response.Response == nil || response.Response.RequestId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Create CLB listener rule failed, Response is nil."))
 			}
@@ -466,7 +467,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				if response == nil || response.Response == nil || response.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Modify domain attributes failed, Response is nil."))
 				}
@@ -504,7 +505,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				if response == nil || response.Response == nil || response.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Modify rule failed, Response is nil."))
 				}
@@ -787,7 +788,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				if response == nil || response.Response == nil || response.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Modify rule failed, Response is nil."))
 				}
@@ -895,7 +896,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 				return tccommon.RetryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, domainRequest.GetAction(), domainRequest.ToJsonString(), response.ToJsonString())
+					logId, domainRequest.GetAction(), connectivity.RedactJSON(domainRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				if response == nil || response.Response == nil || response.Response.RequestId == nil {
 					return resource.NonRetryableError(fmt.Errorf("Modify domain attributes failed, Response is nil."))
 				}
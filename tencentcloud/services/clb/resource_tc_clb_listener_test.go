@@ -15,6 +15,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// TestTencentCloudClbListenerScheduler checks that the scheduler validation
+// agrees with the schedulers of the listener protocols.
+func TestTencentCloudClbListenerScheduler(t *testing.T) {
+	validate := localclb.ResourceTencentCloudClbListener().Schema["scheduler"].ValidateFunc
+	for _, scheduler := range []string{localclb.CLB_LISTENER_SCHEDULER_WRR, localclb.CLB_LISTENER_SCHEDULER_LEASTCONN} {
+		if _, errs := validate(scheduler, "scheduler"); len(errs) > 0 {
+			t.Errorf("scheduler %s is rejected: %v", scheduler, errs)
+		}
+	}
+	if _, errs := validate(localclb.CLB_LISTENER_SCHEDULER_IP_HASH, "scheduler"); len(errs) == 0 {
+		t.Errorf("scheduler %s of the listener rules is accepted", localclb.CLB_LISTENER_SCHEDULER_IP_HASH)
+	}
+}
+
 func TestAccTencentCloudClbListener_basic(t *testing.T) {
 	t.Parallel()
 
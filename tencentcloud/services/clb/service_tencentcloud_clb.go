@@ -22,7 +22,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewClbService(client *connectivity.TencentCloudClient) ClbService {
@@ -57,7 +56,7 @@ func (me *ClbService) DescribeLoadBalancerById(ctx context.Context, clbId string
 	logId := tccommon.GetLogId(ctx)
 	request := clb.NewDescribeLoadBalancersRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = clbId
 	response, err := me.client.UseClbClient(iacExtInfo).DescribeLoadBalancers(request)
@@ -66,7 +65,7 @@ func (me *ClbService) DescribeLoadBalancerById(ctx context.Context, clbId string
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil || response.Response.LoadBalancerSet == nil {
 		return
@@ -112,13 +111,13 @@ func (me *ClbService) DescribeLoadBalancerByFilter(ctx context.Context, params m
 
 		var response *clb.DescribeLoadBalancersResponse
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseClbClient().DescribeLoadBalancers(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result == nil || result.Response == nil || result.Response.LoadBalancerSet == nil {
 				return resource.NonRetryableError(fmt.Errorf("DescribeLoadBalancers response is nil"))
@@ -152,7 +151,7 @@ func (me *ClbService) DeleteLoadBalancerById(ctx context.Context, clbId string)
 	logId := tccommon.GetLogId(ctx)
 	request := clb.NewDeleteLoadBalancerRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteLoadBalancer(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -168,7 +167,7 @@ func (me *ClbService) DeleteLoadBalancerById(ctx context.Context, clbId string)
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	requestId := *response.Response.RequestId
 	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 	if retryErr != nil {
@@ -182,7 +181,7 @@ func (me *ClbService) DescribeListenerById(ctx context.Context, listenerId strin
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -194,7 +193,7 @@ func (me *ClbService) DescribeListenerById(ctx context.Context, listenerId strin
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Listeners) < 1 {
 		return
@@ -229,7 +228,7 @@ func (me *ClbService) DescribeListenersByFilter(ctx context.Context, params map[
 	}
 
 	listeners = make([]*clb.Listener, 0)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -241,7 +240,7 @@ func (me *ClbService) DescribeListenersByFilter(ctx context.Context, params map[
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	listeners = append(listeners, response.Response.Listeners...)
 
@@ -253,13 +252,13 @@ func (me *ClbService) DeleteListenerById(ctx context.Context, clbId string, list
 	request := clb.NewDeleteListenerRequest()
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteListener(request)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	requestId := *response.Response.RequestId
 	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 	if retryErr != nil {
@@ -272,13 +271,13 @@ func (me *ClbService) DescribeTaskStatusById(ctx context.Context, taskId string)
 	logId := tccommon.GetLogId(ctx)
 	request := clb.NewDescribeTaskStatusRequest()
 	request.TaskId = &taskId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeTaskStatus(request)
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	status = *response.Response.Status
 	return
 }
@@ -321,7 +320,7 @@ func (me *ClbService) DescribeRulesByFilter(ctx context.Context, params map[stri
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -334,7 +333,7 @@ func (me *ClbService) DescribeRulesByFilter(ctx context.Context, params map[stri
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	//listener not found, return empty
 	if len(response.Response.Listeners) < 1 {
@@ -374,7 +373,7 @@ func (me *ClbService) DescribeRuleByPara(ctx context.Context, clbId string, list
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -387,7 +386,7 @@ func (me *ClbService) DescribeRuleByPara(ctx context.Context, clbId string, list
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	//listener not found, return empty
 	if len(response.Response.Listeners) < 1 {
@@ -427,14 +426,14 @@ func (me *ClbService) DeleteRuleById(ctx context.Context, clbId string, listener
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
 	request.LocationIds = []*string{&locationId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteRule(request)
 	if err != nil {
 		log.Println(errors.WithStack(err).Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	requestId := *response.Response.RequestId
 	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 	if retryErr != nil {
@@ -448,7 +447,7 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -461,7 +460,7 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	//listener not found, return empty
 	if len(response.Response.Listeners) < 1 {
@@ -476,7 +475,7 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
 
 	if aErr != nil {
@@ -490,7 +489,7 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, aRequest.GetAction(), aRequest.ToJsonString(), aResponse.ToJsonString())
+		logId, aRequest.GetAction(), connectivity.RedactJSON(aRequest.ToJsonString()), connectivity.RedactJSON(aResponse.ToJsonString()))
 
 	if len(aResponse.Response.Listeners) < 1 {
 		return
@@ -506,14 +505,14 @@ func (me *ClbService) DescribeTargetsByPara(ctx context.Context, clbId, listener
 	request := clb.NewDescribeTargetsRequest()
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeTargets(request)
 	if err != nil {
 		return
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	//listener not found, return empty
 	if len(response.Response.Listeners) < 1 {
@@ -529,7 +528,7 @@ func (me *ClbService) DescribeTargetsByPara(ctx context.Context, clbId, listener
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
 
 	if aErr != nil {
@@ -543,7 +542,7 @@ func (me *ClbService) DescribeTargetsByPara(ctx context.Context, clbId, listener
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, aRequest.GetAction(), aRequest.ToJsonString(), aResponse.ToJsonString())
+		logId, aRequest.GetAction(), connectivity.RedactJSON(aRequest.ToJsonString()), connectivity.RedactJSON(aResponse.ToJsonString()))
 
 	if len(aResponse.Response.Listeners) < 1 {
 		return
@@ -578,7 +577,7 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -591,7 +590,7 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Listeners) < 1 {
 		return
@@ -605,7 +604,7 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
 
 	if aErr != nil {
@@ -619,7 +618,7 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, aRequest.GetAction(), aRequest.ToJsonString(), aResponse.ToJsonString())
+		logId, aRequest.GetAction(), connectivity.RedactJSON(aRequest.ToJsonString()), connectivity.RedactJSON(aResponse.ToJsonString()))
 
 	if len(aResponse.Response.Listeners) < 1 {
 		return
@@ -647,7 +646,7 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 		request.Domain = helper.String(domain)
 		request.Url = helper.String(url)
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeregisterTargets(request)
 	if err != nil {
 		ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -657,7 +656,7 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	requestId := *response.Response.RequestId
 	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 	if retryErr != nil {
@@ -696,7 +695,7 @@ func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId str
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -709,7 +708,7 @@ func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId str
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.RewriteSet) < 1 {
 		return
@@ -751,7 +750,7 @@ func (me *ClbService) DescribeAllAutoRedirections(ctx context.Context, rewriteId
 	request := clb.NewDescribeRewriteRequest()
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -764,7 +763,7 @@ func (me *ClbService) DescribeAllAutoRedirections(ctx context.Context, rewriteId
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.RewriteSet) < 1 {
 		return
@@ -822,7 +821,7 @@ func (me *ClbService) DescribeRedirectionsByFilter(ctx context.Context, params m
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		errRet = err
@@ -830,7 +829,7 @@ func (me *ClbService) DescribeRedirectionsByFilter(ctx context.Context, params m
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.RewriteSet) < 1 {
 		return
@@ -876,13 +875,13 @@ func (me *ClbService) DeleteRedirectionById(ctx context.Context, rewriteId strin
 	rewriteInfo.SourceLocationId = &sourceLocId
 	rewriteInfo.TargetLocationId = &targetLocId
 	request.RewriteInfos = []*clb.RewriteLocationMap{&rewriteInfo}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteRewrite(request)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	requestId := *response.Response.RequestId
 	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 	if retryErr != nil {
@@ -1587,7 +1586,7 @@ func (me *ClbService) CreateTopic(ctx context.Context, params map[string]interfa
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		resp, ok := me.client.UseClbClient().CreateTopic(request)
 		if ok != nil {
 			err = ok
@@ -1743,16 +1742,16 @@ func (me *ClbService) DescribeTargetGroups(ctx context.Context, targetGroupId st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || len(response.Response.TargetGroupSet) < 1 {
 			break
@@ -1785,16 +1784,16 @@ func (me *ClbService) DescribeTargetGroupList(ctx context.Context, targetGroupId
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroupList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || len(response.Response.TargetGroupSet) < 1 {
 			break
@@ -1825,16 +1824,16 @@ func (me *ClbService) DescribeTargetGroupInstances(ctx context.Context, filters
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroupInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil || len(response.Response.TargetGroupInstanceSet) < 1 {
 			break
@@ -1865,7 +1864,7 @@ func (me *ClbService) AssociateTargetGroups(ctx context.Context, listenerId, clb
 	request.Associations = append(request.Associations, &association)
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseClbClient().AssociateTargetGroups(request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1876,7 +1875,7 @@ func (me *ClbService) AssociateTargetGroups(ctx context.Context, listenerId, clb
 			return tccommon.RetryError(err, tccommon.InternalError)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			requestId := *response.Response.RequestId
 			retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
 			if retryErr != nil {
@@ -1949,7 +1948,7 @@ func (me *ClbService) DisassociateTargetGroups(ctx context.Context, targetGroupI
 	}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseClbClient().DisassociateTargetGroups(request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1978,7 +1977,7 @@ func (me *ClbService) ModifyTargetGroupInstancesWeight(ctx context.Context, targ
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseClbClient().ModifyTargetGroupInstancesWeight(request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -2021,7 +2020,7 @@ func (me *ClbService) ModifyTargetGroupInstancesPort(ctx context.Context, target
 	request.TargetGroupInstances = []*clb.TargetGroupInstance{&instance}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesPort(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -2044,7 +2043,7 @@ func (me *ClbService) ModifyTargetPort(ctx context.Context, loadBalancerId, list
 	request.NewPort = &newPort
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetPort(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -2067,7 +2066,7 @@ func (me *ClbService) ModifyTargetWeight(ctx context.Context, loadBalancerId, li
 	request.Weight = &weight
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetWeight(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -2087,10 +2086,10 @@ func (me *ClbService) DescribeClbLogSet(ctx context.Context) (logSetId string, h
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeClsLogSet(request)
 	if err != nil {
 		errRet = err
@@ -2098,7 +2097,7 @@ func (me *ClbService) DescribeClbLogSet(ctx context.Context) (logSetId string, h
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	logSetId = *response.Response.LogsetId
 	healthId = *response.Response.HealthLogsetId
@@ -2113,14 +2112,14 @@ func (me *ClbService) CreateClbLogSet(ctx context.Context, name string, logsetTy
 	if logsetType != "" {
 		request.LogsetType = &logsetType
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().CreateClsLogSet(request)
 	if err != nil {
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response != nil {
 		id = *response.Response.LogsetId
@@ -2134,18 +2133,18 @@ func (me *ClbService) UpdateClsLogSet(ctx context.Context, request *cls.ModifyLo
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClsClient().ModifyLogset(request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2155,14 +2154,14 @@ func (me *ClbService) DescribeLbCustomizedConfigById(ctx context.Context, config
 	request := clb.NewDescribeCustomizedConfigListRequest()
 	request.UconfigIds = []*string{&configId}
 	request.ConfigType = helper.String(configType)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeCustomizedConfigList(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.ConfigList) < 1 {
 		return
@@ -2176,14 +2175,14 @@ func (me *ClbService) DescribeLbIntlCustomizedConfigById(ctx context.Context, co
 	request := clbintl.NewDescribeCustomizedConfigListRequest()
 	request.UconfigIds = []*string{&configId}
 	request.ConfigType = helper.String(configType)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbIntlClient().DescribeCustomizedConfigList(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.ConfigList) < 1 {
 		return
@@ -2197,14 +2196,14 @@ func (me *ClbService) DeleteLbCustomizedConfigById(ctx context.Context, configId
 	request := clb.NewSetCustomizedConfigForLoadBalancerRequest()
 	request.OperationType = helper.String("DELETE")
 	request.UconfigId = helper.String(configId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -2219,7 +2218,7 @@ func (me *ClbService) BindOrUnBindCustomizedConfigWithLbId(ctx context.Context,
 		lbId := lbIdsList[i].(string)
 		request.LoadBalancerIds = append(request.LoadBalancerIds, &lbId)
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
 	if err != nil {
@@ -2227,7 +2226,7 @@ func (me *ClbService) BindOrUnBindCustomizedConfigWithLbId(ctx context.Context,
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	return
 }
 
@@ -2237,14 +2236,14 @@ func (me *ClbService) CreateLoadBalancerSnatIps(ctx context.Context, id string,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.LoadBalancerId = &id
 	request.SnatIps = ips
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().CreateLoadBalancerSnatIps(request)
 
 	if err != nil {
@@ -2255,7 +2254,7 @@ func (me *ClbService) CreateLoadBalancerSnatIps(ctx context.Context, id string,
 	taskId = *response.Response.RequestId
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2266,14 +2265,14 @@ func (me *ClbService) DeleteLoadBalancerSnatIps(ctx context.Context, id string,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.LoadBalancerId = &id
 	request.Ips = ips
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteLoadBalancerSnatIps(request)
 
 	if err != nil {
@@ -2289,7 +2288,7 @@ func (me *ClbService) DeleteLoadBalancerSnatIps(ctx context.Context, id string,
 	taskId = *response.Response.RequestId
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2313,18 +2312,18 @@ func (me *ClbService) DescribeClbFunctionTargetsAttachmentById(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeTargets(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Listeners) < 1 {
 		return
@@ -2354,18 +2353,18 @@ func (me *ClbService) DeleteClbFunctionTargetsAttachmentById(ctx context.Context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DeregisterFunctionTargets(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2378,7 +2377,7 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2388,7 +2387,7 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2402,7 +2401,7 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ClusterResourceSet) < 1 {
 			break
@@ -2426,7 +2425,7 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2436,7 +2435,7 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2450,7 +2449,7 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CrossTargetSet) < 1 {
 			break
@@ -2474,7 +2473,7 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2484,7 +2483,7 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2498,7 +2497,7 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ClusterSet) < 1 {
 			break
@@ -2522,7 +2521,7 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2532,7 +2531,7 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2546,7 +2545,7 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.IdleLoadBalancers) < 1 {
 			break
@@ -2570,7 +2569,7 @@ func (me *ClbService) DescribeClbListenersByTargets(ctx context.Context, param m
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2580,14 +2579,14 @@ func (me *ClbService) DescribeClbListenersByTargets(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeLBListeners(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	listenersByTargets = response.Response.LoadBalancers
 
@@ -2602,7 +2601,7 @@ func (me *ClbService) DescribeClbInstanceByCertId(ctx context.Context, param map
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2612,14 +2611,14 @@ func (me *ClbService) DescribeClbInstanceByCertId(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeLoadBalancerListByCertId(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	instances = response.Response.CertSet
 
@@ -2634,7 +2633,7 @@ func (me *ClbService) DescribeClbInstanceTraffic(ctx context.Context, param map[
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2644,14 +2643,14 @@ func (me *ClbService) DescribeClbInstanceTraffic(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeLoadBalancerTraffic(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	instanceTraffic = response.Response.LoadBalancerTraffic
 
@@ -2666,7 +2665,7 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2682,7 +2681,7 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2696,7 +2695,7 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.LoadBalancerDetailSet) < 1 {
 			break
@@ -2720,7 +2719,7 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2730,7 +2729,7 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2744,7 +2743,7 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ZoneResourceSet) < 1 {
 			break
@@ -2768,7 +2767,7 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2781,7 +2780,7 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -2795,7 +2794,7 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.TargetGroupSet) < 1 {
 			break
@@ -2819,7 +2818,7 @@ func (me *ClbService) DescribeClbTargetHealthByFilter(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2829,14 +2828,14 @@ func (me *ClbService) DescribeClbTargetHealthByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeTargetHealth(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	targetHealth = response.Response.LoadBalancers
 
@@ -2853,18 +2852,18 @@ func (me *ClbService) SetClbSecurityGroup(ctx context.Context, securityGroup str
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().SetSecurityGroupForLoadbalancers(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -2876,18 +2875,18 @@ func (me *ClbService) DescribeClbTargetGroupAttachmentsById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().DescribeTargetGroups(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.TargetGroupSet) < 1 {
 		return
@@ -2932,18 +2931,18 @@ func IsHealthCheckEnable(healthSwitch int64) bool {
 	return healthSwitch == int64(1)
 }
 
-func waitTaskReady(ctx context.Context, client *clb.Client, reqeustId string) error {
+func (me *ClbService) waitTaskReady(ctx context.Context, client *clb.Client, reqeustId string) error {
 	logId := tccommon.GetLogId(ctx)
 
 	describeRequest := clb.NewDescribeTaskStatusRequest()
 	describeRequest.TaskId = helper.String(reqeustId)
 
 	err := resource.Retry(2*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 		response, err := client.DescribeTaskStatus(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		// 任务状态：RUNNING，FAIL，SUCCESS
@@ -2978,12 +2977,12 @@ func (me *ClbService) DescribeDescribeCustomizedConfigAssociateListById(ctx cont
 		request.Offset = &offset
 		request.Limit = &limit
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, err := me.client.UseClbIntlClient().DescribeCustomizedConfigAssociateList(request)
 			if err != nil {
 				return tccommon.RetryError(err)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.BindList == nil {
@@ -3023,17 +3022,17 @@ func (me *ClbService) DescribeClbClsLogAttachmentById(ctx context.Context, loadB
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseClbClient().DescribeLoadBalancers(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.LoadBalancerSet == nil || len(result.Response.LoadBalancerSet) < 1 {
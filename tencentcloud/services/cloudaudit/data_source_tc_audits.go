@@ -10,7 +10,6 @@ import (
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudAudits() *schema.Resource {
@@ -78,7 +77,7 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 
 	var response *audit.ListAuditsResponse
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAuditClient().ListAudits(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
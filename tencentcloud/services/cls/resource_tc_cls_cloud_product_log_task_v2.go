@@ -12,6 +12,7 @@ import (
 	clsv20201016 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -203,7 +204,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Create(d *schema.ResourceData,
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -360,7 +361,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Update(d *schema.ResourceData,
 	cloudProductRegion := idSplit[3]
 
 	needChange := false
-	mutableArgs := []string{"extend", "tags"}
+	mutableArgs := []string{"extend", "tags", "tags_all"}
 	for _, v := range mutableArgs {
 		if d.HasChange(v) {
 			needChange = true
@@ -378,7 +379,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Update(d *schema.ResourceData,
 			request.Extend = helper.String(v.(string))
 		}
 
-		if d.HasChange("tags") {
+		if helper.HasTagsChange(d) {
 			tags := helper.GetTags(d, "tags")
 			request.Tags = make([]*clsv20201016.Tag, 0, len(tags))
 			for k, v := range tags {
@@ -396,7 +397,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Update(d *schema.ResourceData,
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -468,7 +469,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Delete(d *schema.ResourceData,
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -502,7 +503,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Delete(d *schema.ResourceData,
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request1.GetAction(), request1.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request1.GetAction(), connectivity.RedactJSON(request1.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -522,7 +523,7 @@ func resourceTencentCloudClsCloudProductLogTaskV2Delete(d *schema.ResourceData,
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request2.GetAction(), request2.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request2.GetAction(), connectivity.RedactJSON(request2.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -177,7 +178,7 @@ func resourceTencentCloudClsMachineGroupCreate(d *schema.ResourceData, meta inte
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
@@ -290,15 +291,15 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]interface{})
+	if helper.HasTagsChange(d) {
+		tags := helper.GetTags(d, "tags")
 		request.Tags = make([]*cls.Tag, 0, len(tags))
 		for k, v := range tags {
 			key := k
 			value := v
 			request.Tags = append(request.Tags, &cls.Tag{
 				Key:   &key,
-				Value: helper.String(value.(string)),
+				Value: &value,
 			})
 		}
 	}
@@ -333,7 +334,7 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
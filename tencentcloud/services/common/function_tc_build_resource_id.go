@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BuildResourceIdFunction{}
+
+// NewBuildResourceIdFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewBuildResourceIdFunction() function.Function {
+	return &BuildResourceIdFunction{}
+}
+
+// BuildResourceIdFunction implements function.Function for
+// provider::tencentcloud::build_resource_id.
+type BuildResourceIdFunction struct{}
+
+func (f *BuildResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_resource_id"
+}
+
+func (f *BuildResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a composite resource ID from its named parts.",
+		Description: "Joins the named parts into the composite ID of a resource, in the order and with the " +
+			"separator of the ID layout registered for the resource type. The optional parts, such as the host of a " +
+			"`tencentcloud_mysql_account`, may be left out. It is the inverse of `parse_resource_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "The resource type, e.g. `tencentcloud_ckafka_topic`. The `tencentcloud_` prefix may be omitted.",
+			},
+			function.MapParameter{
+				Name:        "parts",
+				ElementType: types.StringType,
+				Description: "The named parts of the ID, e.g. `{ instance_id = \"ckafka-xxx\", topic_name = \"demo\" }`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		resourceType string
+		parts        map[string]string
+	)
+
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &parts)
+	if resp.Error != nil {
+		return
+	}
+
+	layout, err := GetResourceIdLayout(resourceType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	id, err := layout.Build(parts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, id)
+}
@@ -0,0 +1,93 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/common"
+)
+
+// TestBuildResourceIdFunction_Run validates that the named parts are joined in
+// the order and with the separator of the registered layout.
+func TestBuildResourceIdFunction_Run(t *testing.T) {
+	cases := map[string]struct {
+		resourceType string
+		parts        map[string]attr.Value
+		want         string
+	}{
+		"hash separator": {
+			resourceType: "tencentcloud_kubernetes_addon",
+			parts:        map[string]attr.Value{"addon_name": types.StringValue("cbs"), "cluster_id": types.StringValue("cls-xxx")},
+			want:         "cls-xxx#cbs",
+		},
+		"dot separator": {
+			resourceType: "route_table_entry",
+			parts:        map[string]attr.Value{"route_table_id": types.StringValue("rtb-xxx"), "route_entry_id": types.StringValue("123")},
+			want:         "123.rtb-xxx",
+		},
+		"optional part": {
+			resourceType: "tencentcloud_mysql_account",
+			parts:        map[string]attr.Value{"mysql_id": types.StringValue("cdb-xxx"), "name": types.StringValue("test"), "host": types.StringValue("10.0.0.1")},
+			want:         "cdb-xxx#test#10.0.0.1",
+		},
+		"optional part left out": {
+			resourceType: "tencentcloud_mysql_account",
+			parts:        map[string]attr.Value{"mysql_id": types.StringValue("cdb-xxx"), "name": types.StringValue("test")},
+			want:         "cdb-xxx#test",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := svccommon.NewBuildResourceIdFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(c.resourceType),
+					types.MapValueMust(types.StringType, c.parts),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Run returned error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.StringValue(c.want)) {
+				t.Errorf("Run result = %s, want %q", resp.Result.Value(), c.want)
+			}
+		})
+	}
+}
+
+// TestBuildResourceIdFunction_RunError validates that missing, unexpected and
+// separator-containing parts are rejected.
+func TestBuildResourceIdFunction_RunError(t *testing.T) {
+	cases := map[string]map[string]attr.Value{
+		"missing part":    {"cluster_id": types.StringValue("cls-xxx")},
+		"unexpected part": {"cluster_id": types.StringValue("cls-xxx"), "addon_name": types.StringValue("cbs"), "region": types.StringValue("ap-guangzhou")},
+		"separator":       {"cluster_id": types.StringValue("cls-xxx"), "addon_name": types.StringValue("cbs#v1")},
+	}
+
+	for name, parts := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := svccommon.NewBuildResourceIdFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue("tencentcloud_kubernetes_addon"),
+					types.MapValueMust(types.StringType, parts),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if resp.Error == nil {
+				t.Fatalf("Run should return error")
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var _ function.Function = &ParseQcsArnFunction{}
+
+var qcsArnAttrTypes = map[string]attr.Type{
+	"project_id":    types.StringType,
+	"service":       types.StringType,
+	"region":        types.StringType,
+	"account":       types.StringType,
+	"resource_type": types.StringType,
+	"resource_id":   types.StringType,
+}
+
+// NewParseQcsArnFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewParseQcsArnFunction() function.Function {
+	return &ParseQcsArnFunction{}
+}
+
+// ParseQcsArnFunction implements function.Function for
+// provider::tencentcloud::parse_qcs_arn.
+type ParseQcsArnFunction struct{}
+
+func (f *ParseQcsArnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_qcs_arn"
+}
+
+func (f *ParseQcsArnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a qcs:: six-segment resource name.",
+		Description: "Parses a `qcs:project_id:service_type:region:account:resource_type/resource_id` resource name into an object " +
+			"with the `project_id`, `service`, `region`, `account`, `resource_type` and `resource_id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "The qcs:: resource name, e.g. `qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: qcsArnAttrTypes,
+		},
+	}
+}
+
+func (f *ParseQcsArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+
+	resp.Error = req.Arguments.Get(ctx, &arn)
+	if resp.Error != nil {
+		return
+	}
+
+	name, err := tccommon.ParseQcsResourceName(arn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(qcsArnAttrTypes, map[string]attr.Value{
+		"project_id":    types.StringValue(name.ProjectId),
+		"service":       types.StringValue(name.ServiceType),
+		"region":        types.StringValue(name.Region),
+		"account":       types.StringValue(name.Account),
+		"resource_type": types.StringValue(name.ResourceType),
+		"resource_id":   types.StringValue(name.ResourceId),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
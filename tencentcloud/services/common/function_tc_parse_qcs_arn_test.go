@@ -0,0 +1,67 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/common"
+)
+
+// TestParseQcsArnFunction_Run validates that a six-segment resource name is
+// split into its named segments.
+func TestParseQcsArnFunction_Run(t *testing.T) {
+	f := svccommon.NewParseQcsArnFunction()
+
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(t.Context(), function.DefinitionRequest{}, definitionResp)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx"),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(definitionResp.Definition.Return.GetType().(types.ObjectType).AttrTypes))}
+
+	f.Run(t.Context(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Run returned error: %s", resp.Error)
+	}
+
+	result, ok := resp.Result.Value().(types.Object)
+	if !ok {
+		t.Fatalf("Run result is %T, want types.Object", resp.Result.Value())
+	}
+
+	want := map[string]string{
+		"project_id":    "",
+		"service":       "cvm",
+		"region":        "ap-guangzhou",
+		"account":       "uin/100000000001",
+		"resource_type": "instance",
+		"resource_id":   "ins-xxxxxx",
+	}
+	for k, v := range want {
+		if !result.Attributes()[k].Equal(types.StringValue(v)) {
+			t.Errorf("Run result %s = %s, want %q", k, result.Attributes()[k], v)
+		}
+	}
+}
+
+// TestParseQcsArnFunction_RunError validates that malformed names are rejected.
+func TestParseQcsArnFunction_RunError(t *testing.T) {
+	f := svccommon.NewParseQcsArnFunction()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("qcs::cvm:ap-guangzhou:instance/ins-xxxxxx")}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectNull(nil))}
+
+	f.Run(t.Context(), req, resp)
+
+	if resp.Error == nil {
+		t.Fatalf("Run should return error")
+	}
+}
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseResourceIdFunction{}
+
+// NewParseResourceIdFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewParseResourceIdFunction() function.Function {
+	return &ParseResourceIdFunction{}
+}
+
+// ParseResourceIdFunction implements function.Function for
+// provider::tencentcloud::parse_resource_id.
+type ParseResourceIdFunction struct{}
+
+func (f *ParseResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_id"
+}
+
+func (f *ParseResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a composite resource ID into its named parts.",
+		Description: "Splits the composite ID of a resource, such as `instanceId#topicName`, into an object " +
+			"whose attributes are named after the parts of the ID layout registered for the resource type. " +
+			"The optional parts left out of the ID, such as the host of a `tencentcloud_mysql_account`, are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "The resource type, e.g. `tencentcloud_ckafka_topic`. The `tencentcloud_` prefix may be omitted.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID of the resource.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *ParseResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	layout, err := GetResourceIdLayout(resourceType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	parts, err := layout.Parse(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	// the optional parts left out of the ID are null
+	fields := layout.allFields()
+	attrTypes := make(map[string]attr.Type, len(fields))
+	attrValues := make(map[string]attr.Value, len(fields))
+	for _, k := range fields {
+		attrTypes[k] = types.StringType
+		attrValues[k] = types.StringNull()
+		if v, ok := parts[k]; ok {
+			attrValues[k] = types.StringValue(v)
+		}
+	}
+
+	result, diags := types.ObjectValue(attrTypes, attrValues)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, types.DynamicValue(result))
+}
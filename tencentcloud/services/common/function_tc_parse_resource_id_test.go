@@ -0,0 +1,118 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/common"
+)
+
+// TestParseResourceIdFunction_Run validates that a composite ID is split into
+// an object keyed by the registered layout fields.
+func TestParseResourceIdFunction_Run(t *testing.T) {
+	f := svccommon.NewParseResourceIdFunction()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("ckafka_topic"),
+			types.StringValue("ckafka-xxx#demo"),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+
+	f.Run(t.Context(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("Run returned error: %s", resp.Error)
+	}
+
+	want := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"instance_id": types.StringType, "topic_name": types.StringType},
+		map[string]attr.Value{"instance_id": types.StringValue("ckafka-xxx"), "topic_name": types.StringValue("demo")},
+	))
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("Run result = %s, want %s", resp.Result.Value(), want)
+	}
+}
+
+// TestParseResourceIdFunction_RunOptional validates that the optional parts
+// left out of an ID are null.
+func TestParseResourceIdFunction_RunOptional(t *testing.T) {
+	attrTypes := map[string]attr.Type{"mysql_id": types.StringType, "name": types.StringType, "host": types.StringType}
+	cases := map[string]struct {
+		id   string
+		want map[string]attr.Value
+	}{
+		"with host": {
+			id:   "cdb-xxx#test#10.0.0.1",
+			want: map[string]attr.Value{"mysql_id": types.StringValue("cdb-xxx"), "name": types.StringValue("test"), "host": types.StringValue("10.0.0.1")},
+		},
+		"without host": {
+			id:   "cdb-xxx#test",
+			want: map[string]attr.Value{"mysql_id": types.StringValue("cdb-xxx"), "name": types.StringValue("test"), "host": types.StringNull()},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := svccommon.NewParseResourceIdFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue("mysql_account"),
+					types.StringValue(c.id),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Run returned error: %s", resp.Error)
+			}
+
+			want := types.DynamicValue(types.ObjectValueMust(attrTypes, c.want))
+			if !resp.Result.Value().Equal(want) {
+				t.Errorf("Run result = %s, want %s", resp.Result.Value(), want)
+			}
+		})
+	}
+}
+
+// TestParseResourceIdFunction_RunError validates that unknown resource types
+// and malformed IDs are reported against the offending argument.
+func TestParseResourceIdFunction_RunError(t *testing.T) {
+	cases := map[string]struct {
+		resourceType string
+		id           string
+		argument     int64
+	}{
+		"unknown type": {resourceType: "tencentcloud_unknown", id: "a#b", argument: 0},
+		"missing part": {resourceType: "tencentcloud_ckafka_topic", id: "ckafka-xxx", argument: 1},
+		"empty part":   {resourceType: "tencentcloud_ckafka_topic", id: "ckafka-xxx#", argument: 1},
+		"extra part":   {resourceType: "tencentcloud_mysql_account", id: "cdb-xxx#test#%#extra", argument: 1},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := svccommon.NewParseResourceIdFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(c.resourceType),
+					types.StringValue(c.id),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if resp.Error == nil {
+				t.Fatalf("Run should return error")
+			}
+			if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != c.argument {
+				t.Errorf("Run error argument = %v, want %d", resp.Error.FunctionArgument, c.argument)
+			}
+		})
+	}
+}
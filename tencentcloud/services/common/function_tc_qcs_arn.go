@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var _ function.Function = &QcsArnFunction{}
+
+// NewQcsArnFunction is the factory referenced by
+// tencentcloud/framework/registry.go to register this function.
+func NewQcsArnFunction() function.Function {
+	return &QcsArnFunction{}
+}
+
+// QcsArnFunction implements function.Function for
+// provider::tencentcloud::qcs_arn.
+type QcsArnFunction struct{}
+
+func (f *QcsArnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "qcs_arn"
+}
+
+func (f *QcsArnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a qcs:: six-segment resource name.",
+		Description: "Builds the `qcs:project_id:service_type:region:account:resource_type/resource_id` name of a resource, " +
+			"as used by CAM policies and `tencentcloud_tag_attachment`, after validating each segment.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "service",
+				Description: "The service type, e.g. `cvm`.",
+			},
+			function.StringParameter{
+				Name:        "region",
+				Description: "The region, e.g. `ap-guangzhou`. Use an empty string for global resources.",
+			},
+			function.StringParameter{
+				Name:        "account",
+				Description: "The account, e.g. `uin/100000000001`. A bare uin is prefixed with `uid/` for `cos` and `uin/` otherwise.",
+			},
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "The resource type, e.g. `instance`.",
+			},
+			function.StringParameter{
+				Name:        "resource_id",
+				Description: "The resource ID, e.g. `ins-xxxxxx`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QcsArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region, account, resourceType, resourceId string
+
+	resp.Error = req.Arguments.Get(ctx, &service, &region, &account, &resourceType, &resourceId)
+	if resp.Error != nil {
+		return
+	}
+
+	name, err := tccommon.NewQcsResourceName(service, region, account, resourceType, resourceId)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, name.String())
+}
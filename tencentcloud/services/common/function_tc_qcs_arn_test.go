@@ -0,0 +1,66 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	svccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/common"
+)
+
+// TestQcsArnFunction_Run validates that the segments are joined into a
+// six-segment resource name and invalid segments are rejected.
+func TestQcsArnFunction_Run(t *testing.T) {
+	cases := map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"bare uin": {
+			args: []string{"cvm", "ap-guangzhou", "100000000001", "instance", "ins-xxxxxx"},
+			want: "qcs::cvm:ap-guangzhou:uin/100000000001:instance/ins-xxxxxx",
+		},
+		"global resource": {
+			args: []string{"cam", "", "uin/100000000001", "uin", "100000000002"},
+			want: "qcs::cam::uin/100000000001:uin/100000000002",
+		},
+		"invalid service": {
+			args:    []string{"CVM", "ap-guangzhou", "100000000001", "instance", "ins-xxxxxx"},
+			wantErr: true,
+		},
+		"invalid resource type": {
+			args:    []string{"cvm", "ap-guangzhou", "100000000001", "instance/x", "ins-xxxxxx"},
+			wantErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			values := make([]attr.Value, 0, len(c.args))
+			for _, v := range c.args {
+				values = append(values, types.StringValue(v))
+			}
+
+			f := svccommon.NewQcsArnFunction()
+			req := function.RunRequest{Arguments: function.NewArgumentsData(values)}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			f.Run(t.Context(), req, resp)
+
+			if c.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("Run should return error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run returned error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.StringValue(c.want)) {
+				t.Errorf("Run result = %s, want %q", resp.Result.Value(), c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+// ResourceIdLayout describes how the composite ID of a resource is assembled: the ordered
+// field names joined by Separator, as done by the resource in its Create function. The
+// OptionalFields follow the Fields, the trailing ones may be left out of the ID, e.g. the host
+// of a MySQL account, which is only in the ID when it is not the default one.
+type ResourceIdLayout struct {
+	Separator      string
+	Fields         []string
+	OptionalFields []string
+}
+
+// idLayout returns the layout of fields joined by separator.
+func idLayout(separator string, fields ...string) ResourceIdLayout {
+	return ResourceIdLayout{Separator: separator, Fields: fields}
+}
+
+// withOptional returns l followed by the optional fields.
+func (l ResourceIdLayout) withOptional(fields ...string) ResourceIdLayout {
+	l.OptionalFields = fields
+	return l
+}
+
+// resourceIdLayouts is the registry consumed by the parse_resource_id and build_resource_id
+// functions. Keep the fields in the same order as the resource passes them to d.SetId.
+var resourceIdLayouts = map[string]ResourceIdLayout{
+	"tencentcloud_cam_group_policy_attachment":              idLayout(tccommon.FILED_SP, "group_id", "policy_id"),
+	"tencentcloud_cam_role_policy_attachment":               idLayout(tccommon.FILED_SP, "role_id", "policy_id"),
+	"tencentcloud_ckafka_topic":                             idLayout(tccommon.FILED_SP, "instance_id", "topic_name"),
+	"tencentcloud_ckafka_user":                              idLayout(tccommon.FILED_SP, "instance_id", "account_name"),
+	"tencentcloud_clb_cls_log_attachment":                   idLayout(tccommon.FILED_SP, "load_balancer_id", "log_set_id", "log_topic_id"),
+	"tencentcloud_clb_listener":                             idLayout(tccommon.FILED_SP, "clb_id", "listener_id"),
+	"tencentcloud_clb_listener_rule":                        idLayout(tccommon.FILED_SP, "clb_id", "listener_id", "rule_id"),
+	"tencentcloud_clb_target_group_attachment":              idLayout(tccommon.FILED_SP, "target_group_id", "listener_id", "clb_id", "rule_id"),
+	"tencentcloud_clb_target_group_instance_attachment":     idLayout(tccommon.FILED_SP, "target_group_id", "bind_ip", "port"),
+	"tencentcloud_cls_metric_subscribe":                     idLayout(tccommon.FILED_SP, "topic_id", "task_id"),
+	"tencentcloud_cos_bucket_domain_certificate_attachment": idLayout(tccommon.FILED_SP, "bucket", "domain"),
+	"tencentcloud_dnspod_record":                            idLayout(tccommon.FILED_SP, "domain", "record_id"),
+	"tencentcloud_ha_vip_instance_attachment":               idLayout(tccommon.FILED_SP, "ha_vip_id", "instance_id"),
+	"tencentcloud_kubernetes_addon":                         idLayout(tccommon.FILED_SP, "cluster_id", "addon_name"),
+	"tencentcloud_kubernetes_cluster_attachment":            idLayout("_", "instance_id", "cluster_id"),
+	"tencentcloud_kubernetes_cluster_master_attachment":     idLayout(tccommon.FILED_SP, "cluster_id", "instance_id", "node_role"),
+	"tencentcloud_kubernetes_cluster_release":               idLayout(tccommon.FILED_SP, "cluster_id", "namespace", "name"),
+	"tencentcloud_kubernetes_native_node_pool":              idLayout(tccommon.FILED_SP, "cluster_id", "node_pool_id"),
+	"tencentcloud_kubernetes_node_pool":                     idLayout(tccommon.FILED_SP, "cluster_id", "node_pool_id"),
+	"tencentcloud_kubernetes_serverless_node_pool":          idLayout(tccommon.FILED_SP, "cluster_id", "node_pool_id"),
+	"tencentcloud_monitor_tmp_tke_cluster_agent":            idLayout(tccommon.FILED_SP, "instance_id", "cluster_id", "cluster_type"),
+	"tencentcloud_monitor_tmp_tke_template_attachment":      idLayout(tccommon.FILED_SP, "template_id", "instance_id", "region"),
+	"tencentcloud_mysql_account":                            idLayout(tccommon.FILED_SP, "mysql_id", "name").withOptional("host"),
+	"tencentcloud_mysql_database":                           idLayout(tccommon.FILED_SP, "instance_id", "db_name"),
+	"tencentcloud_mysql_proxy_address_config":               idLayout(tccommon.FILED_SP, "instance_id", "proxy_group_id", "proxy_address_id"),
+	"tencentcloud_postgresql_account":                       idLayout(tccommon.FILED_SP, "db_instance_id", "user_name"),
+	"tencentcloud_postgresql_database":                      idLayout(tccommon.FILED_SP, "db_instance_id", "database_name"),
+	"tencentcloud_postgresql_instance_network_access":       idLayout(tccommon.FILED_SP, "db_instance_id", "vpc_id", "subnet_id", "vip"),
+	"tencentcloud_private_dns_zone_vpc_attachment":          idLayout(tccommon.FILED_SP, "zone_id", "uniq_vpc_id"),
+	"tencentcloud_route_table_entry":                        idLayout(".", "route_entry_id", "route_table_id"),
+	"tencentcloud_scf_function_alias":                       idLayout(tccommon.FILED_SP, "namespace", "function_name", "name"),
+	"tencentcloud_tag_attachment_v2":                        idLayout(tccommon.FILED_SP, "tag_key", "resource"),
+}
+
+// GetResourceIdLayout returns the ID layout of the resource type. The `tencentcloud_` prefix
+// may be omitted.
+func GetResourceIdLayout(resourceType string) (ResourceIdLayout, error) {
+	if !strings.HasPrefix(resourceType, "tencentcloud_") {
+		resourceType = "tencentcloud_" + resourceType
+	}
+
+	layout, ok := resourceIdLayouts[resourceType]
+	if !ok {
+		return ResourceIdLayout{}, fmt.Errorf("resource type `%s` has no registered composite ID layout, supported types are: %s", resourceType, strings.Join(ResourceIdLayoutTypes(), ", "))
+	}
+
+	return layout, nil
+}
+
+// ResourceIdLayoutTypes returns the sorted resource types of the registry.
+func ResourceIdLayoutTypes() []string {
+	types := make([]string, 0, len(resourceIdLayouts))
+	for k := range resourceIdLayouts {
+		types = append(types, k)
+	}
+
+	sort.Strings(types)
+	return types
+}
+
+// Parse splits the ID into its named parts, the optional parts left out of the ID are missing
+// from the result.
+func (l ResourceIdLayout) Parse(id string) (map[string]string, error) {
+	idSplit := strings.Split(id, l.Separator)
+	if len(idSplit) < len(l.Fields) || len(idSplit) > len(l.Fields)+len(l.OptionalFields) {
+		return nil, fmt.Errorf("id is broken, `%s` should have %s parts `%s` joined by `%s`", id, l.partsCount(), l.format(), l.Separator)
+	}
+
+	fields := l.allFields()
+	parts := make(map[string]string, len(idSplit))
+	for i, v := range idSplit {
+		if v == "" {
+			return nil, fmt.Errorf("id is broken, part `%s` of `%s` is empty", fields[i], id)
+		}
+
+		parts[fields[i]] = v
+	}
+
+	return parts, nil
+}
+
+// Build joins the named parts into an ID. Every field of the layout must be given, an optional
+// field only with the ones before it, and no part may contain the separator.
+func (l ResourceIdLayout) Build(parts map[string]string) (string, error) {
+	for k := range parts {
+		if !l.hasField(k) {
+			return "", fmt.Errorf("unexpected part `%s`, expected parts are: %s", k, strings.Join(l.allFields(), ", "))
+		}
+	}
+
+	fields := l.allFields()
+	idParts := make([]string, 0, len(fields))
+	for i, field := range fields {
+		v := parts[field]
+		if v == "" {
+			if i < len(l.Fields) {
+				return "", fmt.Errorf("part `%s` is required", field)
+			}
+
+			for _, next := range fields[i+1:] {
+				if parts[next] != "" {
+					return "", fmt.Errorf("part `%s` is required with part `%s`", field, next)
+				}
+			}
+			break
+		}
+
+		if strings.Contains(v, l.Separator) {
+			return "", fmt.Errorf("part `%s` must not contain the separator `%s`", field, l.Separator)
+		}
+
+		idParts = append(idParts, v)
+	}
+
+	return strings.Join(idParts, l.Separator), nil
+}
+
+// allFields returns the fields followed by the optional fields.
+func (l ResourceIdLayout) allFields() []string {
+	return append(append([]string{}, l.Fields...), l.OptionalFields...)
+}
+
+// partsCount returns the number of parts of the IDs, e.g. `2 or 3`.
+func (l ResourceIdLayout) partsCount() string {
+	if len(l.OptionalFields) == 0 {
+		return strconv.Itoa(len(l.Fields))
+	}
+
+	return fmt.Sprintf("%d to %d", len(l.Fields), len(l.Fields)+len(l.OptionalFields))
+}
+
+// format returns the format of the IDs, the optional fields in brackets, e.g.
+// `mysql_id#name[#host]`.
+func (l ResourceIdLayout) format() string {
+	format := strings.Join(l.Fields, l.Separator)
+	for _, field := range l.OptionalFields {
+		format += "[" + l.Separator + field
+	}
+
+	return format + strings.Repeat("]", len(l.OptionalFields))
+}
+
+func (l ResourceIdLayout) hasField(field string) bool {
+	for _, f := range l.allFields() {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
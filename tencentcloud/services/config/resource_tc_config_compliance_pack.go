@@ -10,6 +10,7 @@ import (
 	configv20220802 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/config/v20220802"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -187,7 +188,7 @@ func resourceTencentCloudConfigCompliancePackCreate(d *schema.ResourceData, meta
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("create config compliance pack failed, Response is nil"))
@@ -321,7 +322,7 @@ func resourceTencentCloudConfigCompliancePackUpdate(d *schema.ResourceData, meta
 				return tccommon.RetryError(e)
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 
@@ -368,7 +369,7 @@ func resourceTencentCloudConfigCompliancePackDelete(d *schema.ResourceData, meta
 	// 		return tccommon.RetryError(e)
 	// 	}
 
-	// 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+	// 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 	// 	return nil
 	// })
 
@@ -391,7 +392,7 @@ func updateCompliancePackStatus(ctx context.Context, meta interface{}, complianc
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
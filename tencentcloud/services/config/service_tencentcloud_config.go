@@ -11,7 +11,6 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewConfigService(client *connectivity.TencentCloudClient) ConfigService {
@@ -58,7 +57,7 @@ func (me *ConfigService) DescribeConfigCompliancePacksByFilter(ctx context.Conte
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListCompliancePacksWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -68,7 +67,7 @@ func (me *ConfigService) DescribeConfigCompliancePacksByFilter(ctx context.Conte
 				return resource.NonRetryableError(fmt.Errorf("list config compliance packs failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result.Response.Total != nil {
 				total = *result.Response.Total
@@ -111,7 +110,7 @@ func (me *ConfigService) DescribeSystemConfigCompliancePacks(ctx context.Context
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListSystemCompliancePacksWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -121,7 +120,7 @@ func (me *ConfigService) DescribeSystemConfigCompliancePacks(ctx context.Context
 				return resource.NonRetryableError(fmt.Errorf("list system config compliance packs failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result.Response.Total != nil {
 				total = *result.Response.Total
@@ -172,7 +171,7 @@ func (me *ConfigService) DescribeConfigSystemRulesByFilter(ctx context.Context,
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListSystemRulesWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -182,7 +181,7 @@ func (me *ConfigService) DescribeConfigSystemRulesByFilter(ctx context.Context,
 				return resource.NonRetryableError(fmt.Errorf("list config system rules failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result.Response.Total != nil {
 				total = *result.Response.Total
@@ -237,7 +236,7 @@ func (me *ConfigService) DescribeConfigRuleEvaluationResultsByFilter(ctx context
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListConfigRuleEvaluationResultsWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -247,7 +246,7 @@ func (me *ConfigService) DescribeConfigRuleEvaluationResultsByFilter(ctx context
 				return resource.NonRetryableError(fmt.Errorf("list config rule evaluation results failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result.Response.Total != nil {
 				total = *result.Response.Total
@@ -310,7 +309,7 @@ func (me *ConfigService) DescribeConfigRulesByFilter(ctx context.Context, paramM
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListConfigRulesWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -320,7 +319,7 @@ func (me *ConfigService) DescribeConfigRulesByFilter(ctx context.Context, paramM
 				return resource.NonRetryableError(fmt.Errorf("list config rules failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result.Response.Total != nil {
 				total = *result.Response.Total
@@ -356,12 +355,12 @@ func (me *ConfigService) DescribeConfigRuleById(ctx context.Context, ruleId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().DescribeConfigRule(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -371,7 +370,7 @@ func (me *ConfigService) DescribeConfigRuleById(ctx context.Context, ruleId stri
 			return resource.NonRetryableError(fmt.Errorf("describe config rule failed, Response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		response = result
 		return nil
@@ -395,7 +394,7 @@ func (me *ConfigService) DescribeConfigAlarmPolicyById(ctx context.Context, alar
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -403,7 +402,7 @@ func (me *ConfigService) DescribeConfigAlarmPolicyById(ctx context.Context, alar
 		var result *configv20220802.ListAlarmPolicyResponse
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			resp, e := me.client.UseConfigV20220802Client().ListAlarmPolicy(request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -413,7 +412,7 @@ func (me *ConfigService) DescribeConfigAlarmPolicyById(ctx context.Context, alar
 				return resource.NonRetryableError(fmt.Errorf("list config alarm policy failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), resp.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(resp.ToJsonString()))
 
 			result = resp
 			return nil
@@ -449,12 +448,12 @@ func (me *ConfigService) DescribeConfigRecorder(ctx context.Context) (ret *confi
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().DescribeConfigRecorder(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -464,7 +463,7 @@ func (me *ConfigService) DescribeConfigRecorder(ctx context.Context) (ret *confi
 			return resource.NonRetryableError(fmt.Errorf("describe config recorder failed, Response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		response = result
 		return nil
@@ -506,7 +505,7 @@ func (me *ConfigService) DescribeConfigDiscoveredResourcesByFilter(ctx context.C
 		)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseConfigV20220802Client().ListDiscoveredResourcesWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -516,7 +515,7 @@ func (me *ConfigService) DescribeConfigDiscoveredResourcesByFilter(ctx context.C
 				return resource.NonRetryableError(fmt.Errorf("list config discovered resources failed, Response is nil"))
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			nextToken = result.Response.NextToken
 			pageItems = result.Response.Items
@@ -547,12 +546,12 @@ func (me *ConfigService) DescribeConfigResourceTypes(ctx context.Context) (items
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().ListResourceTypesWithContext(ctx, request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -562,7 +561,7 @@ func (me *ConfigService) DescribeConfigResourceTypes(ctx context.Context) (items
 			return resource.NonRetryableError(fmt.Errorf("list config resource types failed, Response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		items = result.Response.Items
 		return nil
@@ -584,12 +583,12 @@ func (me *ConfigService) DescribeConfigDeliver(ctx context.Context) (ret *config
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().DescribeConfigDeliver(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -599,7 +598,7 @@ func (me *ConfigService) DescribeConfigDeliver(ctx context.Context) (ret *config
 			return resource.NonRetryableError(fmt.Errorf("describe config deliver failed, Response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		response = result
 		return nil
@@ -625,12 +624,12 @@ func (me *ConfigService) DescribeConfigRemediationById(ctx context.Context, rule
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().ListRemediations(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -640,7 +639,7 @@ func (me *ConfigService) DescribeConfigRemediationById(ctx context.Context, rule
 			return resource.NonRetryableError(fmt.Errorf("list config remediations failed, Response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		for _, item := range result.Response.Remediations {
 			if item.RemediationId != nil && *item.RemediationId == remediationId {
@@ -669,12 +668,12 @@ func (me *ConfigService) DescribeConfigCompliancePackById(ctx context.Context, c
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseConfigV20220802Client().DescribeCompliancePack(request)
 		if e != nil {
 			return tccommon.RetryError(e)
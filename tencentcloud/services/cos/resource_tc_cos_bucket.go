@@ -164,12 +164,15 @@ func ResourceTencentCloudCosBucket() *schema.Resource {
 		Update: resourceTencentCloudCosBucketUpdate,
 		Delete: resourceTencentCloudCosBucketDelete,
 		Importer: &schema.ResourceImporter{
-			State: helper.ImportWithDefaultValue(map[string]interface{}{
-				"force_clean": false,
+			StateContext: tccommon.ImportStateWithRegion(func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return helper.ImportWithDefaultValue(map[string]interface{}{
+					"force_clean": false,
+				})(d, meta)
 			}),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"bucket": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -876,6 +879,8 @@ func ResourceTencentCloudCosBucket() *schema.Resource {
 func resourceTencentCloudCosBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var err error
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
@@ -926,6 +931,9 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
@@ -1242,6 +1250,8 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
@@ -1496,7 +1506,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		bucket := d.Id()
 
 		cosService := CosService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
@@ -1581,6 +1591,8 @@ func waitAclEnable(ctx context.Context, meta interface{}, bucket string, cdcId s
 func resourceTencentCloudCosBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
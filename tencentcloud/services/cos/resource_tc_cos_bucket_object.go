@@ -16,6 +16,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudCosBucketObject() *schema.Resource {
@@ -282,12 +284,8 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		v := d.Get("tags").(map[string]interface{})
-		tags := make(map[string]string)
-		for key, val := range v {
-			tags[key] = val.(string)
-		}
+	if helper.HasTagsChange(d) {
+		tags := helper.GetTags(d, "tags")
 		if err := cosService.SetObjectTags(ctx, bucket, key, tags); err != nil {
 			return err
 		}
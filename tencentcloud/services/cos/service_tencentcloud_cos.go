@@ -25,7 +25,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewCosService(client *connectivity.TencentCloudClient) CosService {
@@ -55,7 +54,7 @@ func (me *CosService) HeadObject(ctx context.Context, bucket, key string) (info
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
-	ratelimit.Check("HeadObject")
+	me.client.RateLimiter.Check("HeadObject")
 	response, err := me.client.UseCosClient().HeadObject(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -83,7 +82,7 @@ func (me *CosService) DeleteObject(ctx context.Context, bucket, key string) (err
 				logId, "delete object", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("DeleteObject")
+	me.client.RateLimiter.Check("DeleteObject")
 	response, err := me.client.UseCosClient().DeleteObject(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos delete object error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
@@ -110,7 +109,7 @@ func (me *CosService) PutObjectAcl(ctx context.Context, bucket, key, acl string)
 				logId, "put object acl", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutObjectAcl")
+	me.client.RateLimiter.Check("PutObjectAcl")
 	response, err := me.client.UseCosClient().PutObjectAcl(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put object acl error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
@@ -137,7 +136,7 @@ func (me *CosService) PutBucket(ctx context.Context, bucket, acl string, cdcId s
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("CreateBucket")
+	me.client.RateLimiter.Check("CreateBucket")
 	response, err := me.client.UseCosClientNew(cdcId).CreateBucket(&request)
 
 	if err != nil {
@@ -164,7 +163,7 @@ func (me *CosService) TencentCosPutBucket(ctx context.Context, bucket string, op
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
+	me.client.RateLimiter.Check("TencentcloudCosPutBucket")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.Put(ctx, opt)
 
 	if err != nil {
@@ -192,7 +191,7 @@ func (me *CosService) TencentCosBucketGetLocation(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
+	me.client.RateLimiter.Check("TencentcloudCosPutBucket")
 	result, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetLocation(ctx)
 	if err != nil {
 		errRet = fmt.Errorf("cos get location error: %s, bucket: %s", err.Error(), bucket)
@@ -293,7 +292,7 @@ func (me *CosService) TencentCosPutBucketACLBody(
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACLBody")
+	me.client.RateLimiter.Check("TencentcloudCosPutBucketACLBody")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutACL(ctx, opt)
 
 	if err != nil {
@@ -315,7 +314,7 @@ func (me *CosService) HeadBucket(ctx context.Context, bucket string) (errRet err
 	request := s3.HeadBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("HeadBucket")
+	me.client.RateLimiter.Check("HeadBucket")
 	response, err := me.client.UseCosClient().HeadBucket(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -368,7 +367,7 @@ func (me *CosService) DeleteBucket(ctx context.Context, bucket string, forced bo
 	request := s3.DeleteBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucket")
+	me.client.RateLimiter.Check("DeleteBucket")
 	response, err := me.client.UseCosClientNew(cdcId).DeleteBucket(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -508,7 +507,7 @@ func (me *CosService) ForceCleanObject(ctx context.Context, bucket string, versi
 func (me *CosService) GetBucketCorsNew(ctx context.Context, bucket string, cdcId string) (corsRules []map[string]interface{}, responseVary string, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 
-	ratelimit.Check("GetBucketCORS")
+	me.client.RateLimiter.Check("GetBucketCORS")
 	result, response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.GetCORS(ctx)
 	if err != nil {
 		// No CORS configuration set on the bucket is treated as an empty result.
@@ -555,7 +554,7 @@ func (me *CosService) GetBucketCors(ctx context.Context, bucket string, cdcId st
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketCors")
+	me.client.RateLimiter.Check("GetBucketCors")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketCors(&request)
 
 	if err != nil {
@@ -599,7 +598,7 @@ func (me *CosService) GetBucketLifecycle(ctx context.Context, bucket string, cdc
 	request := s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketLifecycleConfiguration")
+	me.client.RateLimiter.Check("GetBucketLifecycleConfiguration")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketLifecycleConfiguration(&request)
 
 	if err != nil {
@@ -712,7 +711,7 @@ func (me *CosService) GetDataSourceBucketLifecycle(ctx context.Context, bucket s
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketLifecycleConfiguration")
+	me.client.RateLimiter.Check("GetBucketLifecycleConfiguration")
 	response, err := me.client.UseCosClient().GetBucketLifecycleConfiguration(&request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
@@ -815,7 +814,7 @@ func (me *CosService) GetBucketWebsite(ctx context.Context, bucket string, cdcId
 	request := s3.GetBucketWebsiteInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketWebsite")
+	me.client.RateLimiter.Check("GetBucketWebsite")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketWebsite(&request)
 
 	if err != nil {
@@ -894,7 +893,7 @@ func (me *CosService) GetBucketEncryption(ctx context.Context, bucket string, cd
 	request := s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketEncryption")
+	me.client.RateLimiter.Check("GetBucketEncryption")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketEncryption(&request)
 
 	if err != nil {
@@ -927,7 +926,7 @@ func (me *CosService) GetBucketVersioning(ctx context.Context, bucket string, cd
 	request := s3.GetBucketVersioningInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
+	me.client.RateLimiter.Check("GetBucketVersioning")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketVersioning(&request)
 
 	if err != nil {
@@ -959,7 +958,7 @@ func (me *CosService) GetBucketAccleration(ctx context.Context, bucket string, c
 	request := s3.GetBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketAccelerateConfiguration")
+	me.client.RateLimiter.Check("GetBucketAccelerateConfiguration")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketAccelerateConfiguration(&request)
 
 	if err != nil {
@@ -991,7 +990,7 @@ func (me *CosService) GetBucketLogStatus(ctx context.Context, bucket string, cdc
 	request := s3.GetBucketLoggingInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
+	me.client.RateLimiter.Check("GetBucketVersioning")
 	response, err := me.client.UseCosClientNew(cdcId).GetBucketLogging(&request)
 
 	if err != nil {
@@ -1019,7 +1018,7 @@ func (me *CosService) ListBuckets(ctx context.Context) (buckets []*s3.Bucket, er
 	logId := tccommon.GetLogId(ctx)
 
 	request := s3.ListBucketsInput{}
-	ratelimit.Check("ListBuckets")
+	me.client.RateLimiter.Check("ListBuckets")
 	response, err := me.client.UseCosClient().ListBuckets(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1040,7 +1039,7 @@ func (me *CosService) ListObjects(ctx context.Context, bucket string) (objects [
 	request := s3.ListObjectsInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("ListObjects")
+	me.client.RateLimiter.Check("ListObjects")
 	response, err := me.client.UseCosClient().ListObjects(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1061,7 +1060,7 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 
 	deleteReq := &s3.DeleteBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("DeleteBucketTagging")
+	me.client.RateLimiter.Check("DeleteBucketTagging")
 	deleteResp, err := me.client.UseCosClientNew(cdcId).DeleteBucketTagging(deleteReq)
 
 	if err != nil {
@@ -1089,7 +1088,7 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 		})
 	}
 
-	ratelimit.Check("PutBucketTagging")
+	me.client.RateLimiter.Check("PutBucketTagging")
 
 	resp, err := me.client.UseCosClientNew(cdcId).PutBucketTagging(putReq)
 
@@ -1110,7 +1109,7 @@ func (me *CosService) GetBucketTags(ctx context.Context, bucket string, cdcId st
 
 	req := &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketTagging")
+	me.client.RateLimiter.Check("GetBucketTagging")
 	resp, err := me.client.UseCosClientNew(cdcId).GetBucketTagging(req)
 
 	if err != nil {
@@ -1142,7 +1141,7 @@ func (me *CosService) GetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    &key,
 	}
 
-	ratelimit.Check("GetObjectTagging")
+	me.client.RateLimiter.Check("GetObjectTagging")
 	resp, err := me.client.UseCosClient().GetObjectTagging(req)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "404" {
@@ -1175,7 +1174,7 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    aws.String(key),
 	}
 
-	ratelimit.Check("DeleteObjectTagging")
+	me.client.RateLimiter.Check("DeleteObjectTagging")
 
 	deleteResp, err := me.client.UseCosClient().DeleteObjectTagging(deleteReq)
 	if err != nil {
@@ -1204,7 +1203,7 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		})
 	}
 
-	ratelimit.Check("PutObjectTagging")
+	me.client.RateLimiter.Check("PutObjectTagging")
 
 	resp, err := me.client.UseCosClient().PutObjectTagging(putReq)
 	if err != nil {
@@ -1232,7 +1231,7 @@ func (me *CosService) PutBucketPolicy(ctx context.Context, bucket, policy string
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutBucketPolicy")
+	me.client.RateLimiter.Check("PutBucketPolicy")
 	response, err := me.client.UseCosClient().PutBucketPolicy(&request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put bucket policy error: %s, bucket: %s", err.Error(), bucket)
@@ -1249,7 +1248,7 @@ func (me *CosService) DescribePolicyByBucket(ctx context.Context, bucket string)
 	logId := tccommon.GetLogId(ctx)
 	request := s3.GetBucketPolicyInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketPolicy")
+	me.client.RateLimiter.Check("GetBucketPolicy")
 	response, err := me.client.UseCosClient().GetBucketPolicy(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1269,7 +1268,7 @@ func (me *CosService) DeleteBucketPolicy(ctx context.Context, bucket string) (er
 	request := s3.DeleteBucketPolicyInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucketPolicy")
+	me.client.RateLimiter.Check("DeleteBucketPolicy")
 	response, err := me.client.UseCosClient().DeleteBucketPolicy(&request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1292,7 +1291,7 @@ func (me *CosService) GetBucketACL(ctx context.Context, bucket string, cdcId str
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACL")
+	me.client.RateLimiter.Check("TencentcloudCosPutBucketACL")
 	acl, _, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.GetACL(ctx)
 
 	if err != nil {
@@ -1351,7 +1350,7 @@ func (me *CosService) GetBucketPullOrigin(ctx context.Context, bucket string) (r
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketPullOrigin")
+	me.client.RateLimiter.Check("TencentcloudCosGetBucketPullOrigin")
 	originConfig, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetOrigin(ctx)
 
 	if response.StatusCode == 404 {
@@ -1445,7 +1444,7 @@ func (me *CosService) PutBucketPullOrigin(ctx context.Context, bucket string, ru
 	opt := &cos.BucketPutOriginOptions{
 		Rule: rules,
 	}
-	ratelimit.Check("PutBucketPullOrigin")
+	me.client.RateLimiter.Check("PutBucketPullOrigin")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutOrigin(ctx, opt)
 
 	header, _ := json.Marshal(response.Header)
@@ -1480,7 +1479,7 @@ func (me *CosService) DeleteBucketPullOrigin(ctx context.Context, bucket string,
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketPullOrigin")
+	me.client.RateLimiter.Check("DeleteBucketPullOrigin")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.DeleteOrigin(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1506,7 +1505,7 @@ func (me *CosService) GetBucketOriginDomain(ctx context.Context, bucket string)
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketOriginDomain")
+	me.client.RateLimiter.Check("TencentcloudCosGetBucketOriginDomain")
 	domain, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetDomain(ctx)
 
 	if response != nil && response.StatusCode == 404 {
@@ -1543,7 +1542,7 @@ func (me *CosService) PutBucketOriginDomain(ctx context.Context, bucket string,
 	opt := &cos.BucketPutDomainOptions{
 		Rules: rules,
 	}
-	ratelimit.Check("PutBucketOriginDomain")
+	me.client.RateLimiter.Check("PutBucketOriginDomain")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutDomain(ctx, opt)
 
 	req, _ := json.Marshal(opt)
@@ -1577,7 +1576,7 @@ func (me *CosService) DeleteBucketOriginDomain(ctx context.Context, bucket strin
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketOriginDomain")
+	me.client.RateLimiter.Check("DeleteBucketOriginDomain")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.DeleteDomain(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1602,7 +1601,7 @@ func (me *CosService) GetBucketReplication(ctx context.Context, bucket string, c
 		}
 	}()
 
-	ratelimit.Check("GetBucketReplication")
+	me.client.RateLimiter.Check("GetBucketReplication")
 	result, response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.GetBucketReplication(ctx)
 
 	if response.StatusCode == 404 {
@@ -1639,7 +1638,7 @@ func (me *CosService) PutBucketReplication(ctx context.Context, bucket string, r
 		}
 	}()
 
-	ratelimit.Check("PutBucketReplication")
+	me.client.RateLimiter.Check("PutBucketReplication")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutBucketReplication(ctx, option)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1664,7 +1663,7 @@ func (me *CosService) DeleteBucketReplication(ctx context.Context, bucket string
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketReplication")
+	me.client.RateLimiter.Check("DeleteBucketReplication")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.DeleteBucketReplication(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1749,7 +1748,7 @@ func (me *CosService) DeleteCosBucketDomainCertificate(ctx context.Context, cert
 	}()
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("DeleteDomainCertificate")
+		me.client.RateLimiter.Check("DeleteDomainCertificate")
 		result, e := me.client.UseTencentCosClient(bucket).Bucket.DeleteDomainCertificate(ctx, option)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -1798,7 +1797,7 @@ func (me *CosService) DescribeCosBucketRefererById(ctx context.Context, bucket s
 		}
 	}()
 
-	resRaw, err := tccommon.RetryWithContext(ctx, tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetReferer(ctx)
 		return res, err
 	})
@@ -1822,7 +1821,7 @@ func (me *CosService) DescribeCosBucketVersionById(ctx context.Context, bucket s
 		}
 	}()
 
-	resRaw, err := tccommon.RetryWithContext(ctx, tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetVersioning(ctx)
 		return res, err
 	})
@@ -1848,7 +1847,7 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("PutIntelligentTiering")
+	me.client.RateLimiter.Check("PutIntelligentTiering")
 	response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutIntelligentTiering(ctx, opt)
 	if err != nil {
 		errRet = fmt.Errorf("cos bucket put intelligent tiering error: %s, bucket: %s", err.Error(), bucket)
@@ -1864,7 +1863,7 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 func (me *CosService) BucketGetIntelligentTiering(ctx context.Context, bucket string, cdcId string) (result *cos.BucketGetIntelligentTieringResult, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 
-	ratelimit.Check("GetIntelligentTiering")
+	me.client.RateLimiter.Check("GetIntelligentTiering")
 	intelligentTieringResult, response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.GetIntelligentTiering(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1886,7 +1885,7 @@ func (me *CosService) BucketGetIntelligentTiering(ctx context.Context, bucket st
 func (me *CosService) BucketGetIntelligentTieringArchivingRuleList(ctx context.Context, bucket string, cdcId string) (result *cos.ListIntelligentTieringConfigurations, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 
-	ratelimit.Check("ListIntelligentTiering")
+	me.client.RateLimiter.Check("ListIntelligentTiering")
 	intelligentTieringResult, response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.ListIntelligentTiering(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1909,7 +1908,7 @@ func (me *CosService) BucketPutIntelligentTieringArchivingRule(ctx context.Conte
 	logId := tccommon.GetLogId(ctx)
 
 	for _, rule := range rules {
-		ratelimit.Check("PutIntelligentTieringV2")
+		me.client.RateLimiter.Check("PutIntelligentTieringV2")
 		result, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutIntelligentTieringV2(ctx, rule)
 		if err != nil {
 			return err
@@ -1926,7 +1925,7 @@ func (me *CosService) BucketDeleteIntelligentTieringArchivingRule(ctx context.Co
 	logId := tccommon.GetLogId(ctx)
 
 	for _, ruleId := range ruleIds {
-		ratelimit.Check("DeleteIntelligentTiering")
+		me.client.RateLimiter.Check("DeleteIntelligentTiering")
 		result, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.DeleteIntelligentTiering(ctx, ruleId)
 		if err != nil {
 			return err
@@ -1948,7 +1947,7 @@ func (me *CosService) BucketPutObjectLockConfiguration(ctx context.Context, buck
 		}
 	}()
 
-	ratelimit.Check("PutObjectLockConfiguration")
+	me.client.RateLimiter.Check("PutObjectLockConfiguration")
 	result, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.PutObjectLockConfiguration(ctx, objectLockConfig)
 	if err != nil {
 		errRet = fmt.Errorf("cos put object lock configuration error: %s, bucket: %s", err.Error(), bucket)
@@ -1969,7 +1968,7 @@ func (me *CosService) BucketGetObjectLockConfiguration(ctx context.Context, buck
 		}
 	}()
 
-	ratelimit.Check("GetObjectLockConfiguration")
+	me.client.RateLimiter.Check("GetObjectLockConfiguration")
 	result, response, err := me.client.UseTencentCosClientNew(bucket, cdcId).Bucket.GetObjectLockConfiguration(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 	if response.StatusCode == 404 || response.StatusCode == 400 {
@@ -2068,7 +2067,7 @@ func (me *CosService) DescribeCosBucketReplicationById(ctx context.Context, buck
 		}
 	}()
 
-	resRaw, err := tccommon.RetryWithContext(ctx, tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
+	resRaw, err := tccommon.RetryWithContext(me.client.RetryPolicyContext(ctx), tccommon.ReadRetryTimeout, func(ctx context.Context) (interface{}, error) {
 		res, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetVersioning(ctx)
 		return res, err
 	})
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -125,7 +126,7 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableRequest.GetAction(), enableRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableRequest.GetAction(), connectivity.RedactJSON(enableRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				enableResponse = result
 				return nil
@@ -144,7 +145,7 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, disableRequest.GetAction(), disableRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, disableRequest.GetAction(), connectivity.RedactJSON(disableRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				disableResponse = result
 				return nil
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,7 +117,7 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 					}
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, openSSLRequest.GetAction(), openSSLRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, openSSLRequest.GetAction(), connectivity.RedactJSON(openSSLRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				taskId = *result.Response.TaskId
 				return nil
@@ -137,7 +138,7 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 					}
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, closeSSLRequest.GetAction(), closeSSLRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, closeSSLRequest.GetAction(), connectivity.RedactJSON(closeSSLRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				taskId = *result.Response.TaskId
 				return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -115,7 +116,7 @@ func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{
 	if authErr != nil {
 		return fmt.Errorf("Verify domain ownership failed, please check your domain and retry the authentication process through `tencentcloud_css_authenticate_domain_owner_operation` resource. The error reasons from SDK:[%s]", authErr.Error())
 	} else {
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, addRequest.GetAction(), addRequest.ToJsonString(), authRet.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, addRequest.GetAction(), connectivity.RedactJSON(addRequest.ToJsonString()), connectivity.RedactJSON(authRet.ToJsonString()))
 	}
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
@@ -123,7 +124,7 @@ func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, addRequest.GetAction(), addRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, addRequest.GetAction(), connectivity.RedactJSON(addRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -248,7 +249,7 @@ func resourceTencentCloudCssDomainUpdate(d *schema.ResourceData, meta interface{
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyRequest.GetAction(), modifyRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyRequest.GetAction(), connectivity.RedactJSON(modifyRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -288,7 +289,7 @@ func domainSwitch(ctx context.Context, enable *bool, name *string, meta interfac
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableRequest.GetAction(), enableRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, enableRequest.GetAction(), connectivity.RedactJSON(enableRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				return nil
 			})
@@ -303,7 +304,7 @@ func domainSwitch(ctx context.Context, enable *bool, name *string, meta interfac
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, forbidRequest.GetAction(), forbidRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, forbidRequest.GetAction(), connectivity.RedactJSON(forbidRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 				return nil
 			})
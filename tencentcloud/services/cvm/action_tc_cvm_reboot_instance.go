@@ -0,0 +1,135 @@
+package cvm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &CvmRebootInstanceAction{}
+	_ action.ActionWithConfigure = &CvmRebootInstanceAction{}
+)
+
+// NewCvmRebootInstanceAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewCvmRebootInstanceAction() action.Action {
+	return &CvmRebootInstanceAction{}
+}
+
+// CvmRebootInstanceAction implements action.Action for
+// tencentcloud_cvm_reboot_instance. It replaces the one-shot resource of the
+// same name, which leaves an entry in state after the reboot.
+type CvmRebootInstanceAction struct {
+	service *CvmService
+}
+
+// CvmRebootInstanceActionModel maps the action configuration attributes.
+type CvmRebootInstanceActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	StopType   types.String `tfsdk:"stop_type"`
+}
+
+func (a *CvmRebootInstanceAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_cvm_reboot_instance"
+}
+
+func (a *CvmRebootInstanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots a CVM instance and waits until it is running again.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance ID.",
+			},
+			"stop_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Shutdown type. Valid values: `SOFT`: soft shutdown; `HARD`: hard shutdown; `SOFT_FIRST`: perform a soft shutdown first, and perform a hard shutdown if the soft shutdown fails. Default value: SOFT.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *CvmRebootInstanceAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &CvmService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *CvmRebootInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_cvm_reboot_instance.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data CvmRebootInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	instanceId := data.InstanceId.ValueString()
+
+	request := cvm.NewRebootInstancesRequest()
+	request.InstanceIds = []*string{&instanceId}
+	if v := data.StopType.ValueString(); v != "" {
+		request.StopType = helper.String(v)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Rebooting CVM instance %s", instanceId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := a.service.client.UseCvmClient().RebootInstances(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s reboot cvm instance failed, reason:%+v", logId, err)
+		resp.Diagnostics.AddError("Error rebooting CVM instance", err.Error())
+		return
+	}
+
+	refresh := helper.ProgressRefreshFunc(a.service.CvmInstanceOperationStateRefreshFunc(instanceId, "RebootInstances"), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("CVM instance %s is %s", instanceId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{}, []string{CVM_STATUS_RUNNING}, 2*tccommon.ReadRetryTimeout, 5*time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CVM instance to reboot", err.Error())
+	}
+}
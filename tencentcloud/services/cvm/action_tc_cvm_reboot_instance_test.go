@@ -0,0 +1,77 @@
+package cvm_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+)
+
+func TestCvmRebootInstanceAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svccvm.NewCvmRebootInstanceAction(), "tencentcloud_cvm_reboot_instance", map[string]bool{"instance_id": true, "stop_type": false})
+}
+
+// TestCvmRebootInstanceAction_Invoke validates that the action reboots the
+// instance with the stop type, waits until it is running again and reports
+// every state once.
+func TestCvmRebootInstanceAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"RebootInstances": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeInstances": {
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"REBOOTING","LatestOperation":"RebootInstances","LatestOperationState":"OPERATING"}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"RUNNING","LatestOperation":"RebootInstances","LatestOperationState":"SUCCESS"}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svccvm.NewCvmRebootInstanceAction(), server.Client("cvm"), map[string]tftypes.Value{
+		"instance_id": tftypes.NewValue(tftypes.String, "ins-test"),
+		"stop_type":   tftypes.NewValue(tftypes.String, "SOFT_FIRST"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	if actions := fmt.Sprint(server.Actions()); actions != "[RebootInstances DescribeInstances DescribeInstances]" {
+		t.Errorf("unexpected actions: %s", actions)
+	}
+	request := server.Requests()[0].Body
+	if fmt.Sprint(request["InstanceIds"]) != "[ins-test]" || request["StopType"] != "SOFT_FIRST" {
+		t.Errorf("unexpected RebootInstances request: %v", request)
+	}
+	want := []string{
+		"Rebooting CVM instance ins-test",
+		"CVM instance ins-test is OPERATING",
+		"CVM instance ins-test is RUNNING",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
+
+// TestCvmRebootInstanceAction_InvokeFailed validates that a failed reboot
+// operation is an error.
+func TestCvmRebootInstanceAction_InvokeFailed(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"RebootInstances": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeInstances": {
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"RUNNING","LatestOperation":"RebootInstances","LatestOperationState":"FAILED","LatestOperationErrorMsg":"instance is locked"}],"RequestId":"req-2"}}`,
+		},
+	})
+
+	_, resp := acctest.InvokeAction(t, svccvm.NewCvmRebootInstanceAction(), server.Client("cvm"), map[string]tftypes.Value{
+		"instance_id": tftypes.NewValue(tftypes.String, "ins-test"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic for a failed reboot")
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, "instance is locked") {
+		t.Errorf("unexpected diagnostic: %s", detail)
+	}
+	if _, ok := server.Requests()[0].Body["StopType"]; ok {
+		t.Errorf("StopType is sent without stop_type")
+	}
+}
@@ -0,0 +1,118 @@
+package cvm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &CvmStartInstanceAction{}
+	_ action.ActionWithConfigure = &CvmStartInstanceAction{}
+)
+
+// NewCvmStartInstanceAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewCvmStartInstanceAction() action.Action {
+	return &CvmStartInstanceAction{}
+}
+
+// CvmStartInstanceAction implements action.Action for
+// tencentcloud_cvm_start_instance. It starts an instance once, unlike the
+// `running_flag` of tencentcloud_instance, which keeps it running.
+type CvmStartInstanceAction struct {
+	service *CvmService
+}
+
+// CvmStartInstanceActionModel maps the action configuration attributes.
+type CvmStartInstanceActionModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+}
+
+func (a *CvmStartInstanceAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_cvm_start_instance"
+}
+
+func (a *CvmStartInstanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a stopped CVM instance and waits until it is running.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance ID.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *CvmStartInstanceAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &CvmService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *CvmStartInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_cvm_start_instance.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data CvmStartInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	instanceId := data.InstanceId.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting CVM instance %s", instanceId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		if e := a.service.StartInstance(ctx, instanceId); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting CVM instance", err.Error())
+		return
+	}
+
+	refresh := helper.ProgressRefreshFunc(a.service.CvmInstanceOperationStateRefreshFunc(instanceId, "StartInstances"), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("CVM instance %s is %s", instanceId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{}, []string{CVM_STATUS_RUNNING}, 2*tccommon.ReadRetryTimeout, 5*time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CVM instance to start", err.Error())
+	}
+}
@@ -0,0 +1,49 @@
+package cvm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+)
+
+func TestCvmStartInstanceAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svccvm.NewCvmStartInstanceAction(), "tencentcloud_cvm_start_instance", map[string]bool{"instance_id": true})
+}
+
+// TestCvmStartInstanceAction_Invoke validates that the action starts the
+// instance, waits until it is running and reports every state once.
+func TestCvmStartInstanceAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"StartInstances": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeInstances": {
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"STARTING","LatestOperation":"StartInstances","LatestOperationState":"OPERATING"}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"RUNNING","LatestOperation":"StartInstances","LatestOperationState":"SUCCESS"}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svccvm.NewCvmStartInstanceAction(), server.Client("cvm"), map[string]tftypes.Value{
+		"instance_id": tftypes.NewValue(tftypes.String, "ins-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	if actions := fmt.Sprint(server.Actions()); actions != "[StartInstances DescribeInstances DescribeInstances]" {
+		t.Errorf("unexpected actions: %s", actions)
+	}
+	if ids := fmt.Sprint(server.Requests()[0].Body["InstanceIds"]); ids != "[ins-test]" {
+		t.Errorf("StartInstances InstanceIds = %s, want [ins-test]", ids)
+	}
+	want := []string{
+		"Starting CVM instance ins-test",
+		"CVM instance ins-test is OPERATING",
+		"CVM instance ins-test is RUNNING",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
@@ -0,0 +1,128 @@
+package cvm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &CvmStopInstanceAction{}
+	_ action.ActionWithConfigure = &CvmStopInstanceAction{}
+)
+
+// NewCvmStopInstanceAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewCvmStopInstanceAction() action.Action {
+	return &CvmStopInstanceAction{}
+}
+
+// CvmStopInstanceAction implements action.Action for
+// tencentcloud_cvm_stop_instance. It stops an instance once, unlike the
+// `running_flag` of tencentcloud_instance, which keeps it stopped.
+type CvmStopInstanceAction struct {
+	service *CvmService
+}
+
+// CvmStopInstanceActionModel maps the action configuration attributes.
+type CvmStopInstanceActionModel struct {
+	InstanceId  types.String `tfsdk:"instance_id"`
+	StopType    types.String `tfsdk:"stop_type"`
+	StoppedMode types.String `tfsdk:"stopped_mode"`
+}
+
+func (a *CvmStopInstanceAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_cvm_stop_instance"
+}
+
+func (a *CvmStopInstanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Stops a CVM instance and waits until it is stopped.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance ID.",
+			},
+			"stop_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Shutdown type. Valid values: `SOFT`: soft shutdown; `HARD`: hard shutdown; `SOFT_FIRST`: perform a soft shutdown first, and perform a hard shutdown if the soft shutdown fails. Default value: SOFT.",
+			},
+			"stopped_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Billing method of a pay-as-you-go instance after shutdown. Available values: `KEEP_CHARGING`: billing continues after shutdown; `STOP_CHARGING`: billing stops after shutdown. Default value: KEEP_CHARGING.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *CvmStopInstanceAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &CvmService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *CvmStopInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_cvm_stop_instance.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data CvmStopInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	instanceId := data.InstanceId.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping CVM instance %s", instanceId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		if e := a.service.StopInstance(ctx, instanceId, data.StopType.ValueString(), data.StoppedMode.ValueString()); e != nil {
+			return tccommon.RetryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error stopping CVM instance", err.Error())
+		return
+	}
+
+	refresh := helper.ProgressRefreshFunc(a.service.CvmInstanceOperationStateRefreshFunc(instanceId, "StopInstances"), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("CVM instance %s is %s", instanceId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{}, []string{CVM_STATUS_STOPPED}, 2*tccommon.ReadRetryTimeout, 5*time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CVM instance to stop", err.Error())
+	}
+}
@@ -0,0 +1,53 @@
+package cvm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
+)
+
+func TestCvmStopInstanceAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svccvm.NewCvmStopInstanceAction(), "tencentcloud_cvm_stop_instance", map[string]bool{"instance_id": true, "stop_type": false, "stopped_mode": false})
+}
+
+// TestCvmStopInstanceAction_Invoke validates that the action stops the
+// instance with the stop type and stopped mode, waits until it is stopped and
+// reports every state once.
+func TestCvmStopInstanceAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"StopInstances": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeInstances": {
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"STOPPING","LatestOperation":"StopInstances","LatestOperationState":"OPERATING"}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-test","InstanceState":"STOPPED","LatestOperation":"StopInstances","LatestOperationState":"SUCCESS"}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svccvm.NewCvmStopInstanceAction(), server.Client("cvm"), map[string]tftypes.Value{
+		"instance_id":  tftypes.NewValue(tftypes.String, "ins-test"),
+		"stop_type":    tftypes.NewValue(tftypes.String, "HARD"),
+		"stopped_mode": tftypes.NewValue(tftypes.String, "STOP_CHARGING"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	if actions := fmt.Sprint(server.Actions()); actions != "[StopInstances DescribeInstances DescribeInstances]" {
+		t.Errorf("unexpected actions: %s", actions)
+	}
+	request := server.Requests()[0].Body
+	if fmt.Sprint(request["InstanceIds"]) != "[ins-test]" || request["StopType"] != "HARD" || request["StoppedMode"] != "STOP_CHARGING" {
+		t.Errorf("unexpected StopInstances request: %v", request)
+	}
+	want := []string{
+		"Stopping CVM instance ins-test",
+		"CVM instance ins-test is OPERATING",
+		"CVM instance ins-test is STOPPED",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
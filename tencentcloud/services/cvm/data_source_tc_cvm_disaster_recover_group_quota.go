@@ -10,7 +10,6 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudCvmDisasterRecoverGroupQuota() *schema.Resource {
@@ -64,7 +63,7 @@ func dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead(d *schema.ResourceDa
 
 	request := cvm.NewDescribeDisasterRecoverGroupQuotaRequest()
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().DescribeDisasterRecoverGroupQuota(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -0,0 +1,134 @@
+package cvm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sdkv2list"
+)
+
+var (
+	_ list.ListResource                 = &InstanceListResource{}
+	_ list.ListResourceWithConfigure    = &InstanceListResource{}
+	_ list.ListResourceWithRawV5Schemas = &InstanceListResource{}
+)
+
+// NewInstanceListResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this list resource.
+func NewInstanceListResource() list.ListResource {
+	return &InstanceListResource{
+		Base: sdkv2list.Base{
+			TypeName:    "tencentcloud_instance",
+			NewResource: ResourceTencentCloudInstance,
+		},
+	}
+}
+
+// InstanceListResource implements list.ListResource for tencentcloud_instance
+// on top of the SDKv2 managed resource.
+type InstanceListResource struct {
+	sdkv2list.Base
+}
+
+// InstanceListResourceModel maps the list configuration attributes.
+type InstanceListResourceModel struct {
+	InstanceName     types.String `tfsdk:"instance_name"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	VpcId            types.String `tfsdk:"vpc_id"`
+	SubnetId         types.String `tfsdk:"subnet_id"`
+	Tags             types.Map    `tfsdk:"tags"`
+}
+
+func (l *InstanceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists CVM instances so that they can be imported in bulk.",
+		Attributes: map[string]schema.Attribute{
+			"instance_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the instances with this name.",
+			},
+			"availability_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the instances in this availability zone.",
+			},
+			"vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the instances in this VPC.",
+			},
+			"subnet_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the instances in this subnet.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lists the instances that have all of these tags.",
+			},
+		},
+	}
+}
+
+// List enumerates the instances matching the configuration with the
+// paginated DescribeInstances call used by the instances data source.
+func (l *InstanceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.Meta() == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(sdkv2list.NotConfiguredDiagnostics())
+		return
+	}
+
+	var data InstanceListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filters := make(map[string]string)
+	if v := data.InstanceName.ValueString(); v != "" {
+		filters["instance-name"] = v
+	}
+	if v := data.AvailabilityZone.ValueString(); v != "" {
+		filters["zone"] = v
+	}
+	if v := data.VpcId.ValueString(); v != "" {
+		filters["vpc-id"] = v
+	}
+	if v := data.SubnetId.ValueString(); v != "" {
+		filters["subnet-id"] = v
+	}
+	tags := make(map[string]string)
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	for k, v := range tags {
+		filters["tag:"+k] = v
+	}
+
+	service := CvmService{client: l.Meta().Client}
+	instances, err := service.DescribeInstanceByFilter(ctx, nil, filters)
+	if err != nil {
+		diags.AddError("Error listing CVM instances", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items := make([]sdkv2list.Item, 0, len(instances))
+	for _, instance := range instances {
+		if instance.InstanceId == nil {
+			continue
+		}
+
+		item := sdkv2list.Item{Id: *instance.InstanceId, DisplayName: *instance.InstanceId}
+		if instance.InstanceName != nil && *instance.InstanceName != "" {
+			item.DisplayName = *instance.InstanceName
+		}
+		items = append(items, item)
+	}
+
+	stream.Results = l.Results(ctx, req, items)
+}
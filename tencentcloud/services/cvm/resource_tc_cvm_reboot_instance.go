@@ -9,14 +9,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudCvmRebootInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmRebootInstanceCreate,
-		Read:   resourceTencentCloudCvmRebootInstanceRead,
-		Delete: resourceTencentCloudCvmRebootInstanceDelete,
+		DeprecationMessage: "This resource will be deprecated in Terraform TencentCloud provider later version. Please use the `tencentcloud_cvm_reboot_instance` action instead.",
+		Create:             resourceTencentCloudCvmRebootInstanceCreate,
+		Read:               resourceTencentCloudCvmRebootInstanceRead,
+		Delete:             resourceTencentCloudCvmRebootInstanceDelete,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -71,7 +73,7 @@ func resourceTencentCloudCvmRebootInstanceCreate(d *schema.ResourceData, meta in
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -15,8 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudEip() *schema.Resource {
@@ -197,15 +197,15 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 	eipId := ""
 	taskId := ""
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := client.UseVpcClient().AllocateAddresses(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if len(response.Response.AddressSet) < 1 {
 			return resource.RetryableError(fmt.Errorf("eip id is nil"))
@@ -232,7 +232,7 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 		taskRequest := vpc.NewDescribeTaskResultRequest()
 		taskRequest.TaskId = &taskIdUint64
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(taskRequest.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(taskRequest.GetAction())
 			taskResponse, err := client.UseVpcClient().DescribeTaskResult(taskRequest)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -419,8 +419,8 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := tccommon.BuildTagResourceName(svcvpc.VPC_SERVICE_TYPE, svcvpc.EIP_RESOURCE_TYPE, region, eipId)
 
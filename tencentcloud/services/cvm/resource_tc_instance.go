@@ -25,8 +25,8 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudInstance() *schema.Resource {
@@ -36,12 +36,14 @@ func ResourceTencentCloudInstance() *schema.Resource {
 		Update: resourceTencentCloudInstanceUpdate,
 		Delete: resourceTencentCloudInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: tccommon.ImportStateWithRegion(schema.ImportStatePassthroughWithIdentity(helper.IdentityIdKey)),
 		},
+		Identity: helper.IdIdentity(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"image_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -653,6 +655,16 @@ func ResourceTencentCloudInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_raw", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+
+			helper.DiffConflictsWhen("instance_charge_type", []string{
+				CVM_CHARGE_TYPE_PREPAID,
+				CVM_CHARGE_TYPE_POSTPAID,
+				CVM_CHARGE_TYPE_CDHPAID,
+				CVM_CHARGE_TYPE_CDCPAID,
+				CVM_CHARGE_TYPE_UNDERWRITE,
+			}, "spot_instance_type", "spot_max_price"),
+
+			helper.DiffRequiredWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_CDHPAID}, "cdh_instance_type", "cdh_host_id"),
 		),
 	}
 }
@@ -660,6 +672,8 @@ func ResourceTencentCloudInstance() *schema.Resource {
 func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId              = tccommon.GetLogId(tccommon.ContextNil)
 		ctx                = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -1102,11 +1116,11 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	instanceId := ""
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check("create")
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().RunInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			e, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if ok && tccommon.IsContains(CVM_RETRYABLE_ERROR, e.Code) {
 				return resource.RetryableError(fmt.Errorf("cvm create error: %s, retrying", e.Error()))
@@ -1116,7 +1130,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		if len(response.Response.InstanceIdSet) < 1 {
 			err = fmt.Errorf("instance id is nil")
 			return resource.NonRetryableError(err)
@@ -1172,7 +1186,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -1271,6 +1285,9 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	defer tccommon.LogElapsed("resource.tencentcloud_instance.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -1280,6 +1297,10 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 		instanceId = d.Id()
 	)
 
+	if err := helper.SetIdIdentity(d); err != nil {
+		return err
+	}
+
 	forceDelete := false
 	if v, ok := d.GetOkExists("force_delete"); ok {
 		forceDelete = v.(bool)
@@ -1964,6 +1985,8 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -2452,8 +2475,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		region := meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region
@@ -2558,7 +2581,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -2592,7 +2615,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -2619,6 +2642,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
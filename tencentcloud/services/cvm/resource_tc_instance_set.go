@@ -15,8 +15,8 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudInstanceSet() *schema.Resource {
@@ -479,11 +479,11 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	instanceIds := make([]*string, 0)
 
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check("create")
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().RunInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			e, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if ok && tccommon.IsContains(CVM_RETRYABLE_ERROR, e.Code) {
 				time.Sleep(1 * time.Second) // 需要重试的话，等待1s进行重试
@@ -492,7 +492,7 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 			return resource.NonRetryableError(err)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		if len(response.Response.InstanceIdSet) < instanceCount {
 			err = fmt.Errorf("number of instances is less than %s", strconv.Itoa(instanceCount))
 			return resource.NonRetryableError(err)
@@ -744,11 +744,11 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 			}
 
 			err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check("create")
+				meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check("create")
 				response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().RunInstances(request)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-						logId, request.GetAction(), request.ToJsonString(), err.Error())
+						logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 					e, ok := err.(*sdkErrors.TencentCloudSDKError)
 					if ok && tccommon.IsContains(CVM_RETRYABLE_ERROR, e.Code) {
 						time.Sleep(1 * time.Second) // 需要重试的话，等待1s进行重试
@@ -757,7 +757,7 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 					return resource.NonRetryableError(err)
 				}
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 				if len(response.Response.InstanceIdSet) < instanceCount {
 					err = fmt.Errorf("number of instances is less than %s", strconv.Itoa(instanceCount))
 					return resource.NonRetryableError(err)
@@ -15,7 +15,6 @@ import (
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 //internal version: replace import begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
@@ -220,7 +219,7 @@ func resourceTencentCloudCynosdbClusterV2Create(d *schema.ResourceData, meta int
 	var response *cynosdb.CreateClustersResponse
 	var err error
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCynosdbClient().CreateClusters(request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -252,7 +251,7 @@ func resourceTencentCloudCynosdbClusterV2Create(d *schema.ResourceData, meta int
 	dealRes := cynosdb.NewDescribeResourcesByDealNameResponse()
 	dealReq.DealName = dealName
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		dealRes, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCynosdbClient().DescribeResourcesByDealName(dealReq)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -1071,8 +1070,8 @@ func resourceTencentCloudCynosdbClusterV2Update(d *schema.ResourceData, meta int
 	}
 
 	// update tags
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := tccommon.BuildTagResourceName("cynosdb", "instance", region, clusterId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -14,6 +14,7 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -222,7 +223,7 @@ func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interfa
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil {
@@ -461,7 +462,7 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyRequest.GetAction(), switchProxyRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyRequest.GetAction(), connectivity.RedactJSON(switchProxyRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
@@ -509,7 +510,7 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyDescRequest.GetAction(), modifyProxyDescRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyDescRequest.GetAction(), connectivity.RedactJSON(modifyProxyDescRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -555,7 +556,7 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyRequest.GetAction(), switchProxyRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyRequest.GetAction(), connectivity.RedactJSON(switchProxyRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
@@ -13,6 +13,7 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -310,7 +311,7 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		response = result
@@ -372,7 +373,7 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyVipVportRequest.GetAction(), modifyVipVportRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyVipVportRequest.GetAction(), connectivity.RedactJSON(modifyVipVportRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
@@ -668,7 +669,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyRwSplitRequest.GetAction(), modifyProxyRwSplitRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyRwSplitRequest.GetAction(), connectivity.RedactJSON(modifyProxyRwSplitRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
@@ -721,7 +722,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyVpcRequest.GetAction(), switchProxyVpcRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, switchProxyVpcRequest.GetAction(), connectivity.RedactJSON(switchProxyVpcRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
@@ -769,7 +770,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyDescRequest.GetAction(), modifyProxyDescRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProxyDescRequest.GetAction(), connectivity.RedactJSON(modifyProxyDescRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -799,7 +800,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyVipVportRequest.GetAction(), modifyVipVportRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyVipVportRequest.GetAction(), connectivity.RedactJSON(modifyVipVportRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			flowId = *result.Response.FlowId
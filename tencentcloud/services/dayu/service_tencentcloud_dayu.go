@@ -16,7 +16,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewDayuService(client *connectivity.TencentCloudClient) DayuService {
@@ -48,7 +47,7 @@ func (me *DayuService) DescribeCCSelfdefinePolicies(ctx context.Context, resourc
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribeCCSelfDefinePolicy(request)
 
 		if err != nil {
@@ -129,7 +128,7 @@ func (me *DayuService) CreateCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -176,7 +175,7 @@ func (me *DayuService) ModifyCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.SetId = &policyId
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -209,7 +208,7 @@ func (me *DayuService) DeleteCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.Id = &resourceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteCCSelfDefinePolicy(request)
 	if err != nil {
 		errRet = err
@@ -243,7 +242,7 @@ func (me *DayuService) CreateDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -276,7 +275,7 @@ func (me *DayuService) DescribeDdosPolicies(ctx context.Context, resourceType st
 
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -317,7 +316,7 @@ func (me *DayuService) DescribeDdosPolicy(ctx context.Context, resourceType stri
 	}()
 
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -630,7 +629,7 @@ func (me *DayuService) DeleteDdosPolicy(ctx context.Context, resourceType string
 	request.PolicyId = &policyId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -664,7 +663,7 @@ func (me *DayuService) ModifyDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -697,7 +696,7 @@ func (me *DayuService) ModifyDdosPolicyName(ctx context.Context, resourceType st
 	request.PolicyId = &policyId
 	request.Name = helper.String(url.QueryEscape(name))
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicyName(request)
 	if err != nil {
 		errRet = err
@@ -725,7 +724,7 @@ func (me *DayuService) CreateDdosPolicyCase(ctx context.Context, request *dayu.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -754,7 +753,7 @@ func (me *DayuService) DescribeDdosPolicyCase(ctx context.Context, resourceType
 	}()
 	request.SceneId = &sceneId
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribePolicyCase(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -797,7 +796,7 @@ func (me *DayuService) DeleteDdosPolicyCase(ctx context.Context, resourceType st
 	request.SceneId = &sceneId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -832,7 +831,7 @@ func (me *DayuService) ModifyDdosPolicyCase(ctx context.Context, request *dayu.M
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyDDoSPolicyCase(request)
 	if err != nil {
 		errRet = err
@@ -865,7 +864,7 @@ func (me *DayuService) DescribeDdosPolicyAttachments(ctx context.Context, resour
 		request.Id = &resourceId
 	}
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -914,7 +913,7 @@ func (me *DayuService) BindDdosPolicy(ctx context.Context, resourceId string, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -948,7 +947,7 @@ func (me *DayuService) UnbindDdosPolicy(ctx context.Context, resourceId string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
 	if err != nil {
 		errRet = err
@@ -998,7 +997,7 @@ func (me *DayuService) DescribeL7Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleL7Rules(request)
 
 		if err != nil {
@@ -1080,7 +1079,7 @@ func (me *DayuService) SetL7Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L7HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL7HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1113,7 +1112,7 @@ func (me *DayuService) DescribeL7Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeL7HealthConfig(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1156,7 +1155,7 @@ func (me *DayuService) CreateL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L7RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1201,7 +1200,7 @@ func (me *DayuService) ModifyL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1245,7 +1244,7 @@ func (me *DayuService) DeleteL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteL7Rules(request)
 	if err != nil {
 		errRet = err
@@ -1282,7 +1281,7 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 		} else {
 			request.Method = helper.String("close")
 		}
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyCCHostProtection(request)
 		if err != nil {
 			errRet = err
@@ -1316,7 +1315,7 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 			//this default value can be a request value that asks the whole threshold value if needed
 			request.Threshold = helper.IntUint64(DAYU_L7_HTTPS_SWITCH_ON_DEFAULT)
 		}
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyCCThreshold(request)
 		if err != nil {
 			errRet = err
@@ -1384,7 +1383,7 @@ func (me *DayuService) DeleteNewL4Rules(ctx context.Context, business string, id
 		},
 	}
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DeleteNewL4Rules(request)
 
 		if err != nil {
@@ -1445,7 +1444,7 @@ func (me *DayuService) ModifyNewL4Rule(ctx context.Context, business string, id
 	request.Rule = &tmpRule
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().ModifyNewL4Rule(request)
 
 		if err != nil {
@@ -1510,7 +1509,7 @@ func (me *DayuService) CreateNewL4Rules(ctx context.Context, business string, id
 	request.Rules = rules
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().CreateNewL4Rules(request)
 
 		if err != nil {
@@ -1549,7 +1548,7 @@ func (me *DayuService) DescribeL4Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleL4Rules(request)
 
 		if err != nil {
@@ -1635,7 +1634,7 @@ func (me *DayuService) SetL4Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L4HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL4HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1668,7 +1667,7 @@ func (me *DayuService) DescribeL4Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DescribeL4HealthConfig(request)
 	if err != nil {
 		errRet = err
@@ -1704,7 +1703,7 @@ func (me *DayuService) CreateL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L4RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().CreateL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1750,7 +1749,7 @@ func (me *DayuService) ModifyL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1781,7 +1780,7 @@ func (me *DayuService) DeleteL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().DeleteL4Rules(request)
 	if err != nil {
 		errRet = err
@@ -1814,7 +1813,7 @@ func (me *DayuService) SetSession(ctx context.Context, resourceType string, reso
 	request.RuleId = &ruleId
 	request.KeepEnable = helper.BoolToInt64Pointer(switchFlag)
 	request.KeepTime = helper.IntUint64(sessionTime)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseDayuClient().ModifyL4KeepTime(request)
 	if err != nil {
 		errRet = err
@@ -1872,17 +1871,17 @@ func (me *DayuService) DescribeL7RulesV2(ctx context.Context, business string, e
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseDayuClient().DescribleNewL7Rules(request)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Rules) < 1 {
 			break
@@ -1951,7 +1950,7 @@ func (me *DayuService) CreateL7RuleV2(ctx context.Context, business string, reso
 	request.Business = &business
 	request.Rules = []*dayu.L7RuleEntry{&ruleEntry}
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().CreateNewL7Rules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
@@ -1984,7 +1983,7 @@ func (me *DayuService) ModifyL7RuleV2(ctx context.Context, resourceType string,
 	request.Rule = rule
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().ModifyNewDomainRules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
@@ -2022,7 +2021,7 @@ func (me *DayuService) DeleteL7RulesV2(ctx context.Context, resourceType string,
 		},
 	}
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet := me.client.UseDayuClient().DeleteNewL7Rules(request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
@@ -9,7 +9,7 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
 
 // info  for one direct connect gateway.
@@ -97,19 +97,19 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
 
 	if err != nil {
 		errRet = err
 		responseStr := ""
 		if response != nil {
-			responseStr = response.ToJsonString()
+			responseStr = connectivity.RedactJSON(response.ToJsonString())
 		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 			logId,
 			request.GetAction(),
-			request.ToJsonString(),
+			connectivity.RedactJSON(request.ToJsonString()),
 			responseStr,
 			errRet.Error())
 		return
@@ -278,7 +278,7 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewayCcnRoutes(request)
 
 	if err != nil {
@@ -293,12 +293,12 @@ getMoreData:
 		errRet = err
 		responseStr := ""
 		if response != nil {
-			responseStr = response.ToJsonString()
+			responseStr = connectivity.RedactJSON(response.ToJsonString())
 		}
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 			logId,
 			request.GetAction(),
-			request.ToJsonString(),
+			connectivity.RedactJSON(request.ToJsonString()),
 			responseStr,
 			errRet.Error())
 		return
@@ -347,19 +347,19 @@ func (me *VpcService) CreateDirectConnectGateway(ctx context.Context, name, netw
 	request.NetworkType = &networkType
 	request.NetworkInstanceId = &networkInstanceId
 	request.GatewayType = &gatewayType
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateDirectConnectGateway(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -389,19 +389,19 @@ func (me *VpcService) ModifyDirectConnectGatewayAttribute(ctx context.Context, d
 	request := vpc.NewModifyDirectConnectGatewayAttributeRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.DirectConnectGatewayName = &name
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyDirectConnectGatewayAttribute(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -416,19 +416,19 @@ func (me *VpcService) DeleteDirectConnectGateway(ctx context.Context, dcgId stri
 	logId := tccommon.GetLogId(ctx)
 	request := vpc.NewDeleteDirectConnectGatewayRequest()
 	request.DirectConnectGatewayId = &dcgId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteDirectConnectGateway(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -453,19 +453,19 @@ func (me *VpcService) CreateDirectConnectGatewayCcnRoute(ctx context.Context, dc
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -508,19 +508,19 @@ func (me *VpcService) ReplaceDirectConnectGatewayCcnRoute(ctx context.Context, d
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReplaceDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -554,19 +554,19 @@ func (me *VpcService) DeleteDirectConnectGatewayCcnRoute(ctx context.Context, dc
 	request := vpc.NewDeleteDirectConnectGatewayCcnRoutesRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.RouteIds = []*string{&routeId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteDirectConnectGatewayCcnRoutes(request)
 
 	defer func() {
 		if errRet != nil {
 			responseStr := ""
 			if response != nil {
-				responseStr = response.ToJsonString()
+				responseStr = connectivity.RedactJSON(response.ToJsonString())
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s],response body [%s], reason[%s]\n",
 				logId,
 				request.GetAction(),
-				request.ToJsonString(),
+				connectivity.RedactJSON(request.ToJsonString()),
 				responseStr,
 				errRet.Error())
 		}
@@ -584,18 +584,18 @@ func (me *VpcService) DescribeDcGatewayAttachmentById(ctx context.Context, vpcId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	for _, dc := range response.Response.DirectConnectGatewaySet {
 		if *dc.VpcId == vpcId && *dc.NatGatewayId == natGatewayId {
@@ -616,18 +616,18 @@ func (me *VpcService) DeleteDcGatewayAttachmentById(ctx context.Context, vpcId s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DisassociateDirectConnectGatewayNatGateway(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -23,7 +23,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 var eipUnattachLocker = &sync.Mutex{}
@@ -218,7 +217,7 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -247,7 +246,7 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 
 	var response *vpc.CreateVpcResponse
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateVpc(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -290,7 +289,7 @@ func (me *VpcService) DescribeVpcs(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -346,7 +345,7 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeVpcsResponse
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().DescribeVpcs(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -442,7 +441,7 @@ func (me *VpcService) DescribeSubnets(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -503,7 +502,7 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeSubnetsResponse
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().DescribeSubnets(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -515,7 +514,7 @@ getMoreData:
 		return nil, err
 	}
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if total < 0 {
 		total = int(*response.Response.TotalCount)
@@ -559,7 +558,7 @@ func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -576,7 +575,7 @@ func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string
 	request.EnableMulticast = &enableMulticast
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifyVpcAttribute(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -596,7 +595,7 @@ func (me *VpcService) DeleteVpc(ctx context.Context, vpcId string) (errRet error
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	if vpcId == "" {
@@ -607,7 +606,7 @@ func (me *VpcService) DeleteVpc(ctx context.Context, vpcId string) (errRet error
 	request.VpcId = &vpcId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteVpc(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -627,7 +626,7 @@ func (me *VpcService) CreateSubnet(ctx context.Context, vpcId, name, cidr, zone
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -652,7 +651,7 @@ func (me *VpcService) CreateSubnet(ctx context.Context, vpcId, name, cidr, zone
 
 	var response *vpc.CreateSubnetResponse
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateSubnet(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -675,7 +674,7 @@ func (me *VpcService) ModifySubnetAttribute(ctx context.Context, subnetId, name
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -685,7 +684,7 @@ func (me *VpcService) ModifySubnetAttribute(ctx context.Context, subnetId, name
 	request.SubnetName = &name
 	request.EnableBroadcast = &enableMulticast
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySubnetAttribute(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -705,12 +704,12 @@ func (me *VpcService) DeleteSubnet(ctx context.Context, subnetId string) (errRet
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.SubnetId = &subnetId
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteSubnet(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -730,18 +729,18 @@ func (me *VpcService) ReplaceRouteTableAssociation(ctx context.Context, subnetId
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.SubnetId = &subnetId
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReplaceRouteTableAssociation(request)
 
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	}
 	return
 }
@@ -790,7 +789,7 @@ func (me *VpcService) DescribeRouteTables(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -834,7 +833,7 @@ getMoreData:
 
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
@@ -842,7 +841,7 @@ getMoreData:
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if total < 0 {
 		total = int(*response.Response.TotalCount)
@@ -898,7 +897,7 @@ func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -917,12 +916,12 @@ func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string,
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRouteTable(request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		routeTableId = *response.Response.RouteTable.RouteTableId
 	}
@@ -936,7 +935,7 @@ func (me *VpcService) DeleteRouteTable(ctx context.Context, routeTableId string)
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -945,12 +944,12 @@ func (me *VpcService) DeleteRouteTable(ctx context.Context, routeTableId string)
 		return
 	}
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRouteTable(request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	}
 
 	return
@@ -963,7 +962,7 @@ func (me *VpcService) ModifyRouteTableAttribute(ctx context.Context, routeTableI
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -973,12 +972,12 @@ func (me *VpcService) ModifyRouteTableAttribute(ctx context.Context, routeTableI
 	}
 	request.RouteTableId = &routeTableId
 	request.RouteTableName = &name
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyRouteTableAttribute(request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	}
 
 	return
@@ -1036,7 +1035,7 @@ func (me *VpcService) DeleteRoutes(ctx context.Context, routeTableId string, ent
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1049,12 +1048,12 @@ func (me *VpcService) DeleteRoutes(ctx context.Context, routeTableId string, ent
 	var route vpc.Route
 	route.RouteId = &entryId
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRoutes(request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	}
 	return
 }
@@ -1067,7 +1066,7 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -1083,12 +1082,12 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 	route.GatewayId = &nextHub
 	route.Enabled = &enabled
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRoutes(request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	} else {
 		return
 	}
@@ -1146,11 +1145,11 @@ func (me *VpcService) EnableRoutes(ctx context.Context, request *vpc.EnableRoute
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().EnableRoutes(request)
 
 	if err != nil {
@@ -1159,7 +1158,7 @@ func (me *VpcService) EnableRoutes(ctx context.Context, request *vpc.EnableRoute
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1168,11 +1167,11 @@ func (me *VpcService) DisableRoutes(ctx context.Context, request *vpc.DisableRou
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisableRoutes(request)
 
 	if err != nil {
@@ -1181,7 +1180,7 @@ func (me *VpcService) DisableRoutes(ctx context.Context, request *vpc.DisableRou
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1208,12 +1207,12 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseVpcClient().CreateSecurityGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1240,7 +1239,7 @@ func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg
 	request.SecurityGroupIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 		if err != nil {
@@ -1251,7 +1250,7 @@ func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 
@@ -1280,11 +1279,11 @@ func (me *VpcService) ModifySecurityGroup(ctx context.Context, id string, newNam
 	request.GroupDescription = newDesc
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySecurityGroupAttribute(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1304,11 +1303,11 @@ func (me *VpcService) DeleteSecurityGroup(ctx context.Context, id string) error
 	request.SecurityGroupId = &id
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroup(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1326,11 +1325,11 @@ func (me *VpcService) DescribeSecurityGroupsAssociate(ctx context.Context, ids [
 
 	request := vpc.NewDescribeSecurityGroupAssociationStatisticsRequest()
 	request.SecurityGroupIds = common.StringPtrs(ids)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupAssociationStatistics(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return nil, err
 	}
 
@@ -1381,10 +1380,10 @@ func (me *VpcService) CreateSecurityGroupPolicy(ctx context.Context, info securi
 	case "egress":
 		createRequest.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(createRequest.GetAction())
+	me.client.RateLimiter.Check(createRequest.GetAction())
 	if _, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(createRequest); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+			logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 		return "", err
 	}
 
@@ -1414,11 +1413,11 @@ func (me *VpcService) CreateSecurityGroupPolicies(ctx context.Context, request *
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1427,7 +1426,7 @@ func (me *VpcService) CreateSecurityGroupPolicies(ctx context.Context, request *
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1444,7 +1443,7 @@ func (me *VpcService) DescribeSecurityGroupPolicy(ctx context.Context, ruleId st
 
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &info.SgId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1455,7 +1454,7 @@ func (me *VpcService) DescribeSecurityGroupPolicy(ctx context.Context, ruleId st
 		}
 
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -1498,12 +1497,12 @@ func (me *VpcService) DescribeSecurityGroupPolicies(ctx context.Context, sgId st
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 	request.SecurityGroupId = &sgId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1514,7 +1513,7 @@ func (me *VpcService) DescribeSecurityGroupPolicies(ctx context.Context, sgId st
 	result = response.Response.SecurityGroupPolicySet
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1581,10 +1580,10 @@ func (me *VpcService) DeleteSecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return err
 	}
 
@@ -1596,11 +1595,11 @@ func (me *VpcService) DeleteSecurityGroupPolicies(ctx context.Context, request *
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1609,7 +1608,7 @@ func (me *VpcService) DeleteSecurityGroupPolicies(ctx context.Context, request *
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1630,10 +1629,10 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndex(ctx context.Context
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return err
 	}
 	return nil
@@ -1662,10 +1661,10 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndexList(ctx context.Con
 		request.SecurityGroupPolicySet.Egress = tmpList
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return err
 	}
 	return nil
@@ -1701,10 +1700,10 @@ func (me *VpcService) ModifySecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().ReplaceSecurityGroupPolicy(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return err
 	}
 
@@ -1716,11 +1715,11 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1729,7 +1728,7 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -1773,7 +1772,7 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 		request.Offset = helper.String(strconv.Itoa(offset))
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 			if err != nil {
@@ -1786,7 +1785,7 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
 
@@ -1816,11 +1815,11 @@ func (me *VpcService) modifyLiteRulesInSecurityGroup(ctx context.Context, sgId s
 	request.SecurityGroupPolicySet.Ingress = getSecurityGroupPolicies(ingress)
 
 	return resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1842,11 +1841,11 @@ func (me *VpcService) DeleteLiteRules(ctx context.Context, sgId string, rules []
 	}
 
 	return resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 
 			return tccommon.RetryError(err)
 		}
@@ -1874,7 +1873,7 @@ func (me *VpcService) DescribeSecurityGroupPolices(ctx context.Context, sgId str
 	request.SecurityGroupId = &sgId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 		if err != nil {
@@ -1885,7 +1884,7 @@ func (me *VpcService) DescribeSecurityGroupPolices(ctx context.Context, sgId str
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1986,11 +1985,11 @@ func (me *VpcService) DetachAllLiteRulesFromSecurityGroup(ctx context.Context, s
 	}
 
 	return resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2278,16 +2277,16 @@ func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *v
 	request := vpc.NewDescribeAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.AddressSet) < 1 {
 		return
@@ -2311,16 +2310,16 @@ func (me *VpcService) DescribeEipByFilter(ctx context.Context, filters map[strin
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	eips = response.Response.AddressSet
 	return
@@ -2332,15 +2331,15 @@ func (me *VpcService) ModifyEipName(ctx context.Context, eipId, eipName string)
 	request.AddressId = &eipId
 	request.AddressName = &eipName
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2351,15 +2350,15 @@ func (me *VpcService) ModifyEipBandwidthOut(ctx context.Context, eipId string, b
 	request.AddressIds = []*string{&eipId}
 	request.InternetMaxBandwidthOut = helper.IntInt64(bandwidthOut)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressesBandwidth(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2378,15 +2377,15 @@ func (me *VpcService) ModifyEipInternetChargeType(ctx context.Context, eipId str
 		request.AddressChargePrepaid = &addressChargePrepaid
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressInternetChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2399,15 +2398,15 @@ func (me *VpcService) RenewAddress(ctx context.Context, eipId string, period int
 	addressChargePrepaid.AutoRenewFlag = helper.IntInt64(renewFlag)
 	addressChargePrepaid.Period = helper.IntInt64(period)
 	request.AddressChargePrepaid = &addressChargePrepaid
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RenewAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2417,15 +2416,15 @@ func (me *VpcService) DeleteEip(ctx context.Context, eipId string) error {
 	request := vpc.NewReleaseAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReleaseAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2436,15 +2435,15 @@ func (me *VpcService) AttachEip(ctx context.Context, eipId, instanceId string) e
 	request.AddressId = &eipId
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().AssociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -2456,11 +2455,11 @@ func (me *VpcService) DescribeNatGatewayById(ctx context.Context, natGateWayId s
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 
 	if err != nil {
@@ -2469,7 +2468,7 @@ func (me *VpcService) DescribeNatGatewayById(ctx context.Context, natGateWayId s
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.NatGatewaySet) > 0 {
 		natGateWay = response.Response.NatGatewaySet[0]
@@ -2499,16 +2498,16 @@ func (me *VpcService) DescribeNatGatewayByFilter(ctx context.Context, filters ma
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.NatGatewaySet) < 1 {
 			break
@@ -2533,7 +2532,7 @@ func (me *VpcService) DeleteNatGateway(ctx context.Context, natGatewayId string)
 	request.NatGatewayId = &natGatewayId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGateway(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -2548,7 +2547,7 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -2585,7 +2584,7 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 
 	request.PublicIpAddresses = candidates
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateNatGatewayAddress(request)
 
 	if err != nil {
@@ -2594,7 +2593,7 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	result = response
 	return
@@ -2641,11 +2640,11 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 
 	request := vpc.NewDisassociateAddressRequest()
 	request.AddressId = &eipId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		return err
 	}
 	if response.Response.TaskId == nil {
@@ -2659,7 +2658,7 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 	taskRequest := vpc.NewDescribeTaskResultRequest()
 	taskRequest.TaskId = &taskId
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(taskRequest.GetAction())
+		me.client.RateLimiter.Check(taskRequest.GetAction())
 		taskResponse, err := me.client.UseVpcClient().DescribeTaskResult(taskRequest)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -2724,12 +2723,12 @@ func (me *VpcService) CreateEni(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+		me.client.RateLimiter.Check(createRequest.GetAction())
 
 		response, err := client.CreateNetworkInterface(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+				logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2799,7 +2798,7 @@ func (me *VpcService) CreateEni(
 		return "", err
 	}
 
-	if err := waitEniReady(ctx, id, client, wantIpv4, nil); err != nil {
+	if err := me.waitEniReady(ctx, id, client, wantIpv4, nil); err != nil {
 		log.Printf("[CRITAL]%s create eni failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -2891,7 +2890,7 @@ func (me *VpcService) describeEnis(
 	count := ENI_DESCRIBE_LIMIT
 	for count == ENI_DESCRIBE_LIMIT {
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseVpcClient().DescribeNetworkInterfaces(request)
 			if err != nil {
@@ -2904,7 +2903,7 @@ func (me *VpcService) describeEnis(
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -2939,11 +2938,11 @@ func (me *VpcService) ModifyEniAttribute(ctx context.Context, id string, name, d
 	request.SecurityGroupIds = common.StringPtrs(sgs)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyNetworkInterfaceAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2953,7 +2952,7 @@ func (me *VpcService) ModifyEniAttribute(ctx context.Context, id string, name, d
 		return err
 	}
 
-	if err := waitEniReady(ctx, id, client, nil, nil); err != nil {
+	if err := me.waitEniReady(ctx, id, client, nil, nil); err != nil {
 		log.Printf("[CRITAL]%s modify eni attribute failed, reason: %v", logId, err)
 		return err
 	}
@@ -2975,11 +2974,11 @@ func (me *VpcService) UnAssignIpv4FromEni(ctx context.Context, id string, ipv4s
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.UnassignPrivateIpAddresses(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2989,7 +2988,7 @@ func (me *VpcService) UnAssignIpv4FromEni(ctx context.Context, id string, ipv4s
 		return err
 	}
 
-	if err := waitEniReady(ctx, id, client, nil, ipv4s); err != nil {
+	if err := me.waitEniReady(ctx, id, client, nil, ipv4s); err != nil {
 		log.Printf("[CRITAL]%s unassign ipv4 from eni failed, reason: %v", logId, err)
 		return err
 	}
@@ -3025,12 +3024,12 @@ func (me *VpcService) AssignIpv4ToEni(ctx context.Context, id string, ipv4s []Vp
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.AssignPrivateIpAddresses(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3084,7 +3083,7 @@ func (me *VpcService) AssignIpv4ToEni(ctx context.Context, id string, ipv4s []Vp
 		return err
 	}
 
-	if err := waitEniReady(ctx, id, client, wantIpv4, nil); err != nil {
+	if err := me.waitEniReady(ctx, id, client, wantIpv4, nil); err != nil {
 		log.Printf("[CRITAL]%s assign ipv4 to eni failed, reason: %v", logId, err)
 		return err
 	}
@@ -3100,11 +3099,11 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	deleteRequest.NetworkInterfaceId = &id
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		if _, err := client.DeleteNetworkInterface(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3118,7 +3117,7 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	describeRequest.NetworkInterfaceIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
@@ -3129,7 +3128,7 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3165,11 +3164,11 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	attachRequest.InstanceId = &cvmId
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(attachRequest.GetAction())
+		me.client.RateLimiter.Check(attachRequest.GetAction())
 
 		if _, err := client.AttachNetworkInterface(attachRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, attachRequest.GetAction(), attachRequest.ToJsonString(), err)
+				logId, attachRequest.GetAction(), connectivity.RedactJSON(attachRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3183,12 +3182,12 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	describeRequest.NetworkInterfaceIds = []*string{&eniId}
 
 	if err := resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3260,7 +3259,7 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 	request.InstanceId = &cvmId
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.DetachNetworkInterface(request); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3275,7 +3274,7 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3285,7 +3284,7 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 		return err
 	}
 
-	if err := waitEniDetach(ctx, eniId, client); err != nil {
+	if err := me.waitEniDetach(ctx, eniId, client); err != nil {
 		log.Printf("[CRITAL]%s detach eni from instance failed, reason: %v", logId, err)
 		return err
 	}
@@ -3307,11 +3306,11 @@ func (me *VpcService) ModifyEniPrimaryIpv4Desc(ctx context.Context, id, ip strin
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyPrivateIpAddressesAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -3320,7 +3319,7 @@ func (me *VpcService) ModifyEniPrimaryIpv4Desc(ctx context.Context, id, ip strin
 		return err
 	}
 
-	if err := waitEniReady(ctx, id, client, []string{ip}, nil); err != nil {
+	if err := me.waitEniReady(ctx, id, client, []string{ip}, nil); err != nil {
 		log.Printf("[CRITAL]%s modify eni primary ipv4 description failed, reason: %v", logId, err)
 		return err
 	}
@@ -3357,16 +3356,16 @@ func (me *VpcService) DescribeHaVipByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeHaVips(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.HaVipSet) < 1 {
 			break
@@ -3397,11 +3396,11 @@ func (me *VpcService) DescribeHaVipEipById(ctx context.Context, haVipEipAttachme
 	eip = ""
 	haVip = ""
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if result, err := client.DescribeHaVips(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		} else {
 			length := len(result.Response.HaVipSet)
@@ -3440,7 +3439,7 @@ func (me *VpcService) DeleteHaVip(ctx context.Context, haVipId string) (errRet e
 	request.HaVipId = &haVipId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteHaVip(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -3450,7 +3449,7 @@ func (me *VpcService) DeleteHaVip(ctx context.Context, haVipId string) (errRet e
 	return
 }
 
-func waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s []string, dropIpv4s []string) error {
+func (me *VpcService) waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s []string, dropIpv4s []string) error {
 	logId := tccommon.GetLogId(ctx)
 
 	wantCheckMap := make(map[string]bool, len(wantIpv4s))
@@ -3467,12 +3466,12 @@ func waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3568,14 +3567,14 @@ func flattenVpnSPDList(spd []*vpc.SecurityPolicyDatabase) (mapping []*map[string
 	return
 }
 
-func waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
+func (me *VpcService) waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
 	logId := tccommon.GetLogId(ctx)
 
 	request := vpc.NewDescribeNetworkInterfacesRequest()
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	return resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
@@ -3584,7 +3583,7 @@ func waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3687,7 +3686,7 @@ func (me *VpcService) CreateVpcNetworkAcl(ctx context.Context, vpcID string, nam
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err = me.client.UseVpcClient().CreateNetworkAcl(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -3697,7 +3696,7 @@ func (me *VpcService) CreateVpcNetworkAcl(ctx context.Context, vpcID string, nam
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -3762,7 +3761,7 @@ func (me *VpcService) ModifyNetWorkAclRules(ctx context.Context, aclID string, i
 	}
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclEntries(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -3772,7 +3771,7 @@ func (me *VpcService) ModifyNetWorkAclRules(ctx context.Context, aclID string, i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -3822,13 +3821,13 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 			}
 
 			err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check(request.GetAction())
+				me.client.RateLimiter.Check(request.GetAction())
 				_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(requestSubnet)
 				if err != nil {
 					if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 						if ee.Code == VPCNotFound {
 							log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-								logId, request.GetAction(), request.ToJsonString(), err)
+								logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 							return nil
 						}
 					}
@@ -3846,7 +3845,7 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 	// delete acl
 	request.NetworkAclId = &aclID
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DeleteNetworkAcl(request)
 
 		if err != nil {
@@ -3856,7 +3855,7 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 			}
 			if ee.Code == VPCNotFound {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return nil
 			}
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -3866,7 +3865,7 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -3885,7 +3884,7 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 	request.NetworkAclName = name
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclAttribute(request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -3894,7 +3893,7 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 			}
 			if ee.Code == VPCNotFound {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return resource.NonRetryableError(err)
 			}
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -3904,7 +3903,7 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -3928,7 +3927,7 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 	request.SubnetIds = subIds
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().AssociateNetworkAclSubnets(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -3937,7 +3936,7 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -3972,7 +3971,7 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 	request.Limit = &pageSize
 	for {
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVpcClient().DescribeNetworkAcls(request)
 			if err != nil {
 				if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3987,7 +3986,7 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			errRet = err
 			return
 		}
@@ -4015,7 +4014,7 @@ func (me *VpcService) DescribeByAclId(ctx context.Context, attachmentAcl string)
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4056,7 +4055,7 @@ func (me *VpcService) DeleteAclAttachment(ctx context.Context, attachmentAcl str
 	request.SubnetIds = helper.Strings(items[1:])
 
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -4066,7 +4065,7 @@ func (me *VpcService) DeleteAclAttachment(ctx context.Context, attachmentAcl str
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-			logId, request.GetAction(), request.ToJsonString(), err)
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		errRet = err
 		return
 	}
@@ -4096,7 +4095,7 @@ func (me *VpcService) DescribeVpngwById(ctx context.Context, vpngwId string) (ha
 		return nil
 	})
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), request.ToJsonString(), err)
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return
 	}
 	if response == nil || response.Response == nil || len(response.Response.VpnGatewaySet) < 1 {
@@ -4130,16 +4129,16 @@ func (me *VpcService) DescribeVpnGwByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.VpnGatewaySet) < 1 {
 			break
@@ -4164,7 +4163,7 @@ func (me *VpcService) DeleteVpnGateway(ctx context.Context, vpnGatewayId string)
 	request.VpnGatewayId = &vpnGatewayId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGateway(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -4195,16 +4194,16 @@ func (me *VpcService) DescribeCustomerGatewayByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeCustomerGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CustomerGatewaySet) < 1 {
 			break
@@ -4229,7 +4228,7 @@ func (me *VpcService) DeleteCustomerGateway(ctx context.Context, customerGateway
 	request.CustomerGatewayId = &customerGatewayId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteCustomerGateway(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -4245,7 +4244,7 @@ func (me *VpcService) CreateAddressTemplate(ctx context.Context, name string, ad
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4255,7 +4254,7 @@ func (me *VpcService) CreateAddressTemplate(ctx context.Context, name string, ad
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4306,7 +4305,7 @@ func (me *VpcService) DescribeAddressTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4329,7 +4328,7 @@ func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId stri
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4340,7 +4339,7 @@ func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId stri
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateAttribute(request)
 	return err
 }
@@ -4355,7 +4354,7 @@ func (me *VpcService) DeleteAddressTemplate(ctx context.Context, templateId stri
 	}()
 	request.AddressTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplate(request)
 	return err
 }
@@ -4366,7 +4365,7 @@ func (me *VpcService) CreateAddressTemplateGroup(ctx context.Context, name strin
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4376,7 +4375,7 @@ func (me *VpcService) CreateAddressTemplateGroup(ctx context.Context, name strin
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4397,7 +4396,7 @@ func (me *VpcService) ModifyAddressTemplateGroup(ctx context.Context, templateGr
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4408,7 +4407,7 @@ func (me *VpcService) ModifyAddressTemplateGroup(ctx context.Context, templateGr
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateGroupAttribute(request)
 	return err
 }
@@ -4449,7 +4448,7 @@ func (me *VpcService) DescribeAddressTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4476,7 +4475,7 @@ func (me *VpcService) DeleteAddressTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.AddressTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplateGroup(request)
 	return err
 }
@@ -4487,7 +4486,7 @@ func (me *VpcService) CreateServiceTemplate(ctx context.Context, name string, se
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4497,7 +4496,7 @@ func (me *VpcService) CreateServiceTemplate(ctx context.Context, name string, se
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4518,7 +4517,7 @@ func (me *VpcService) ModifyServiceTemplate(ctx context.Context, templateId stri
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4529,7 +4528,7 @@ func (me *VpcService) ModifyServiceTemplate(ctx context.Context, templateId stri
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateAttribute(request)
 	return err
 }
@@ -4570,7 +4569,7 @@ func (me *VpcService) DescribeServiceTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4597,7 +4596,7 @@ func (me *VpcService) DeleteServiceTemplate(ctx context.Context, templateId stri
 	}()
 	request.ServiceTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplate(request)
 	return err
 }
@@ -4608,7 +4607,7 @@ func (me *VpcService) CreateServiceTemplateGroup(ctx context.Context, name strin
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4618,7 +4617,7 @@ func (me *VpcService) CreateServiceTemplateGroup(ctx context.Context, name strin
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4669,7 +4668,7 @@ func (me *VpcService) DescribeServiceTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4692,7 +4691,7 @@ func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGro
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), errRet)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet)
 		}
 	}()
 
@@ -4703,7 +4702,7 @@ func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGro
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateGroupAttribute(request)
 	return err
 }
@@ -4718,7 +4717,7 @@ func (me *VpcService) DeleteServiceTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.ServiceTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplateGroup(request)
 	return err
 }
@@ -4736,7 +4735,7 @@ func (me *VpcService) CreateVpnGatewayRoute(ctx context.Context, vpnGatewayId st
 
 	var response *vpc.CreateVpnGatewayRoutesResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateVpnGatewayRoutes(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create vpn gateway route failed, reason: %v", logId, errRet)
@@ -4772,7 +4771,7 @@ func (me *VpcService) ModifyVpnGatewayRoute(ctx context.Context, vpnGatewayId, r
 
 	var response *vpc.ModifyVpnGatewayRoutesResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyVpnGatewayRoutes(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -4803,7 +4802,7 @@ func (me *VpcService) DeleteVpnGatewayRoutes(ctx context.Context, vpnGatewayId s
 	request.RouteIds = routeIds
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayRoutes(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -4833,7 +4832,7 @@ func (me *VpcService) DescribeVpnGatewayRoutes(ctx context.Context, vpnGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeVpnGatewayRoutesResponse
 		errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
 			if errRet != nil {
 				return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -4866,7 +4865,7 @@ func (me *VpcService) DescribeVpcTaskResult(ctx context.Context, taskId *string)
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpcTaskResult(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -4893,7 +4892,7 @@ func (me *VpcService) DescribeTaskResult(ctx context.Context, taskId *uint64) (r
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeTaskResult(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -4930,7 +4929,7 @@ func (me *VpcService) DescribeVpnSslServerById(ctx context.Context, sslId string
 		return nil
 	})
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), request.ToJsonString(), err)
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return
 	}
 	if response == nil || response.Response == nil || len(response.Response.SslVpnSeverSet) < 1 {
@@ -4964,16 +4963,16 @@ func (me *VpcService) DescribeVpnGwSslServerByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.SslVpnSeverSet) < 1 {
 			break
@@ -4998,7 +4997,7 @@ func (me *VpcService) DeleteVpnGatewaySslServer(ctx context.Context, SslServerId
 	request.SslVpnServerId = &SslServerId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslServer(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -5032,7 +5031,7 @@ func (me *VpcService) DescribeVpnSslClientById(ctx context.Context, sslId string
 		return nil
 	})
 	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), request.ToJsonString(), err)
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 		return
 	}
 	if response == nil || response.Response == nil || len(response.Response.SslVpnClientSet) < 1 {
@@ -5066,16 +5065,16 @@ func (me *VpcService) DescribeVpnGwSslClientByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.SslVpnClientSet) < 1 {
 			break
@@ -5100,7 +5099,7 @@ func (me *VpcService) DeleteVpnGatewaySslClient(ctx context.Context, SslClientId
 	request.SslVpnClientId = &SslClientId
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslClient(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -5124,7 +5123,7 @@ func (me *VpcService) CreateNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.CreateNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create nat gateway source ip translation nat rule failed, reason: %v", logId, errRet)
@@ -5155,7 +5154,7 @@ func (me *VpcService) ModifyNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.ModifyNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -5184,7 +5183,7 @@ func (me *VpcService) DeleteNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.NatGatewaySnatIds = []*string{&snatId}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -5214,7 +5213,7 @@ func (me *VpcService) DescribeNatGatewaySnats(ctx context.Context, natGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
 		errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
 			if errRet != nil {
 				return tccommon.RetryError(errRet, tccommon.InternalError)
@@ -5243,13 +5242,13 @@ func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	request.VpcIds = []*string{&vpcId}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAssistantCidr(request)
 
 	if err != nil {
@@ -5258,7 +5257,7 @@ func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	info = response.Response.AssistantCidrSet
 
@@ -5271,11 +5270,11 @@ func (me *VpcService) CheckAssistantCidr(ctx context.Context, request *vpc.Check
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CheckAssistantCidr(request)
 
 	if err != nil {
@@ -5284,7 +5283,7 @@ func (me *VpcService) CheckAssistantCidr(ctx context.Context, request *vpc.Check
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	info = response.Response.ConflictSourceSet
 
@@ -5296,11 +5295,11 @@ func (me *VpcService) CreateAssistantCidr(ctx context.Context, request *vpc.Crea
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAssistantCidr(request)
 
 	if err != nil {
@@ -5309,7 +5308,7 @@ func (me *VpcService) CreateAssistantCidr(ctx context.Context, request *vpc.Crea
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	info = response.Response.AssistantCidrSet
 
@@ -5321,11 +5320,11 @@ func (me *VpcService) ModifyAssistantCidr(ctx context.Context, request *vpc.Modi
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAssistantCidr(request)
 
 	if err != nil {
@@ -5334,7 +5333,7 @@ func (me *VpcService) ModifyAssistantCidr(ctx context.Context, request *vpc.Modi
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5344,11 +5343,11 @@ func (me *VpcService) DeleteAssistantCidr(ctx context.Context, request *vpc.Dele
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteAssistantCidr(request)
 
 	if err != nil {
@@ -5357,7 +5356,7 @@ func (me *VpcService) DeleteAssistantCidr(ctx context.Context, request *vpc.Dele
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5371,7 +5370,7 @@ func (me *VpcService) DescribeVpcBandwidthPackage(ctx context.Context, bandwidth
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -5383,16 +5382,16 @@ func (me *VpcService) DescribeVpcBandwidthPackage(ctx context.Context, bandwidth
 	//		Values: []*string{&bandwidthPackageId},
 	//	},
 	//)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil && len(response.Response.BandwidthPackageSet) > 0 {
 		resource = response.Response.BandwidthPackageSet[0]
@@ -5411,18 +5410,18 @@ func (me *VpcService) DeleteVpcBandwidthPackageById(ctx context.Context, bandwid
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteBandwidthPackage(request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5436,7 +5435,7 @@ func (me *VpcService) DescribeVpcBandwidthPackageAttachment(ctx context.Context,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -5449,16 +5448,16 @@ func (me *VpcService) DescribeVpcBandwidthPackageAttachment(ctx context.Context,
 		},
 	)
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageResources(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.ResourceSet) < 1 {
 		return
@@ -5486,18 +5485,18 @@ func (me *VpcService) DeleteVpcBandwidthPackageAttachmentById(ctx context.Contex
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete object", request.ToJsonString(), errRet.Error())
+				logId, "delete object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RemoveBandwidthPackageResources(request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5507,11 +5506,11 @@ func (me *VpcService) DescribeFlowLogs(ctx context.Context, request *vpc.Describ
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeFlowLogs(request)
 
 	if err != nil {
@@ -5522,7 +5521,7 @@ func (me *VpcService) DescribeFlowLogs(ctx context.Context, request *vpc.Describ
 	result = response.Response.FlowLog
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5539,18 +5538,18 @@ func (me *VpcService) DescribeVpcFlowLogById(ctx context.Context, flowLogId, vpc
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeFlowLog(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.FlowLog) < 1 {
 		return
@@ -5571,18 +5570,18 @@ func (me *VpcService) DeleteVpcFlowLogById(ctx context.Context, flowLogId, vpcId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteFlowLog(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5596,11 +5595,11 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -5612,12 +5611,12 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 		request.Limit = &limit
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseVpcClient().DescribeVpcEndPointService(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -5659,18 +5658,18 @@ func (me *VpcService) DeleteVpcEndPointServiceById(ctx context.Context, endPoint
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointService(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5684,11 +5683,11 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -5699,12 +5698,12 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 		request.Offset = &offset
 		request.Limit = &limit
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			result, e := me.client.UseVpcClient().DescribeVpcEndPoint(request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil {
@@ -5746,18 +5745,18 @@ func (me *VpcService) DeleteVpcEndPointById(ctx context.Context, endPointId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteVpcEndPoint(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5785,11 +5784,11 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -5804,7 +5803,7 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.VpcEndpointServiceUserSet) < 1 {
 			break
@@ -5833,18 +5832,18 @@ func (me *VpcService) DeleteVpcEndPointServiceWhiteListById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWhiteList(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -5858,7 +5857,7 @@ func (me *VpcService) DescribeVpcBandwidthPackageByEip(ctx context.Context, eipI
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -5869,16 +5868,16 @@ func (me *VpcService) DescribeVpcBandwidthPackageByEip(ctx context.Context, eipI
 			Values: []*string{&eipId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response != nil && len(response.Response.BandwidthPackageSet) > 0 {
 		resource = response.Response.BandwidthPackageSet[0]
@@ -5895,7 +5894,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -5954,7 +5953,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -5968,7 +5967,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CrossBorderComplianceSet) < 1 {
 			break
@@ -5992,7 +5991,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -6005,7 +6004,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -6019,7 +6018,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CcnSet) < 1 {
 			break
@@ -6043,7 +6042,7 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -6073,14 +6072,14 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitor(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.CrossBorderFlowMonitorData) < 1 {
 		return
@@ -6099,18 +6098,18 @@ func (me *VpcService) DescribeVpnCustomerGatewayVendors(ctx context.Context) (vp
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeCustomerGatewayVendors(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.CustomerGatewayVendorSet) < 1 {
 		return
@@ -6128,18 +6127,18 @@ func (me *VpcService) DescribeVpcVpnGatewayCcnRoutesById(ctx context.Context, vp
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeVpnGatewayCcnRoutes(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.RouteSet) < 1 {
 		return
@@ -6162,18 +6161,18 @@ func (me *VpcService) DescribeVpcIpv6AddressById(ctx context.Context, ip6Address
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeIp6Addresses(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.AddressSet) < 1 {
 		return
@@ -6191,18 +6190,18 @@ func (me *VpcService) DeleteVpcIpv6AddressById(ctx context.Context, ip6AddressId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().ReleaseIp6AddressesBandwidth(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6231,7 +6230,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -6241,7 +6240,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -6255,7 +6254,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.CcnBandwidthSet) < 1 {
 			break
@@ -6279,7 +6278,7 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -6292,7 +6291,7 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -6306,7 +6305,7 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.NatDirectConnectGatewayRouteSet) < 1 {
 			break
@@ -6330,18 +6329,18 @@ func (me *VpcService) DescribeEipAddressQuota(ctx context.Context) (addressQuota
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeAddressQuota(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	addressQuota = append(addressQuota, response.Response.QuotaSet...)
 
@@ -6356,18 +6355,18 @@ func (me *VpcService) DescribeEipNetworkAccountType(ctx context.Context) (networ
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeNetworkAccountType(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	networkAccountType = response.Response.NetworkAccountType
 
@@ -6382,18 +6381,18 @@ func (me *VpcService) DescribeVpcBandwidthPackageQuota(ctx context.Context) (ban
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageQuota(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	bandwidthPackageQuota = append(bandwidthPackageQuota, response.Response.QuotaSet...)
 
@@ -6408,7 +6407,7 @@ func (me *VpcService) DescribeVpcBandwidthPackageBillUsageByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -6418,14 +6417,14 @@ func (me *VpcService) DescribeVpcBandwidthPackageBillUsageByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageBillUsage(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	bandwidthPackageBillUsage = append(bandwidthPackageBillUsage, response.Response.BandwidthPackageBillBandwidthSet...)
 
@@ -6440,18 +6439,18 @@ func (me *VpcService) DescribeVpcTrafficPackageById(ctx context.Context, traffic
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeTrafficPackages(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.TrafficPackageSet) < 1 {
 		return
@@ -6469,18 +6468,18 @@ func (me *VpcService) DeleteVpcTrafficPackageById(ctx context.Context, trafficPa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteTrafficPackages(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6493,18 +6492,18 @@ func (me *VpcService) DescribeVpcSnapshotPoliciesById(ctx context.Context, snaps
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	snapshotPolices = response.Response.SnapshotPolicySet
 	return
@@ -6518,18 +6517,18 @@ func (me *VpcService) DeleteVpcSnapshotPoliciesById(ctx context.Context, snapsho
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6542,18 +6541,18 @@ func (me *VpcService) DescribeVpcSnapshotPolicyAttachmentById(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSnapshotAttachedInstances(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.InstanceSet) < 1 {
 		return
@@ -6578,18 +6577,18 @@ func (me *VpcService) DeleteVpcSnapshotPolicyAttachmentById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DetachSnapshotInstances(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6602,18 +6601,18 @@ func (me *VpcService) DescribeVpcNetDetectById(ctx context.Context, netDetectId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeNetDetects(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.NetDetectSet) < 1 {
 		return
@@ -6631,18 +6630,18 @@ func (me *VpcService) DeleteVpcNetDetectById(ctx context.Context, netDetectId st
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteNetDetect(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6659,11 +6658,11 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -6678,7 +6677,7 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ClassicLinkInstanceSet) < 1 {
 			break
@@ -6712,18 +6711,18 @@ func (me *VpcService) DeleteVpcClassicLinkAttachmentById(ctx context.Context, vp
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DetachClassicLinkVpc(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6736,18 +6735,18 @@ func (me *VpcService) DescribeVpcDhcpIpById(ctx context.Context, dhcpIpId string
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DhcpIpSet) < 1 {
 		return
@@ -6765,18 +6764,18 @@ func (me *VpcService) DeleteVpcDhcpIpById(ctx context.Context, dhcpIpId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteDhcpIp(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6789,18 +6788,18 @@ func (me *VpcService) DescribeVpcDhcpAssociateAddressById(ctx context.Context, d
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.DhcpIpSet) < 1 {
 		return
@@ -6823,18 +6822,18 @@ func (me *VpcService) DeleteVpcDhcpAssociateAddressById(ctx context.Context, dhc
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DisassociateDhcpIpWithAddressIp(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6847,11 +6846,11 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -6866,7 +6865,7 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.VpcSet) < 1 {
 			break
@@ -6894,18 +6893,18 @@ func (me *VpcService) DeleteVpcIpv6CidrBlockById(ctx context.Context, vpcId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().UnassignIpv6CidrBlock(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6918,11 +6917,11 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -6937,7 +6936,7 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.SubnetSet) < 1 {
 			break
@@ -6969,18 +6968,18 @@ func (me *VpcService) DeleteVpcIpv6SubnetCidrBlockById(ctx context.Context, vpcI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().UnassignIpv6SubnetCidrBlock(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -6994,18 +6993,18 @@ func (me *VpcService) DescribeVpcIpv6EniAddressById(ctx context.Context, vpcId s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeVpcIpv6Addresses(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Ipv6AddressSet) < 1 {
 		return
@@ -7026,18 +7025,18 @@ func (me *VpcService) DeleteVpcIpv6EniAddressById(ctx context.Context, networkIn
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().UnassignIpv6Addresses(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -7056,18 +7055,18 @@ func (me *VpcService) DescribeVpcLocalGatewayById(ctx context.Context, localGate
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeLocalGateway(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.LocalGatewaySet) < 1 {
 		return
@@ -7086,18 +7085,18 @@ func (me *VpcService) DeleteVpcLocalGatewayById(ctx context.Context, cdcId strin
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteLocalGateway(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -7110,18 +7109,18 @@ func (me *VpcService) DescribeVpcAccountAttributes(ctx context.Context) (account
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeAccountAttributes(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	accountAttributes = response.Response.AccountAttributeSet
 
@@ -7136,7 +7135,7 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7146,7 +7145,7 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -7160,7 +7159,7 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ClassicLinkInstanceSet) < 1 {
 			break
@@ -7184,7 +7183,7 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7212,7 +7211,7 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7226,7 +7225,7 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.GatewayFlowMonitorDetailSet) < 1 {
 			break
@@ -7250,7 +7249,7 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7263,7 +7262,7 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7277,7 +7276,7 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.GatewayQosSet) < 1 {
 			break
@@ -7301,7 +7300,7 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7311,7 +7310,7 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7325,7 +7324,7 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.InstanceSet) < 1 {
 			break
@@ -7349,7 +7348,7 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7362,7 +7361,7 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7376,7 +7375,7 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.NetDetectStateSet) < 1 {
 			break
@@ -7400,7 +7399,7 @@ func (me *VpcService) DescribeVpcNetworkInterfaceLimit(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7410,14 +7409,14 @@ func (me *VpcService) DescribeVpcNetworkInterfaceLimit(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeNetworkInterfaceLimit(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	networkInterfaceLimit = response.Response
 
@@ -7432,7 +7431,7 @@ func (me *VpcService) DescribeVpcPrivateIpAddresses(ctx context.Context, param m
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7445,14 +7444,14 @@ func (me *VpcService) DescribeVpcPrivateIpAddresses(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeVpcPrivateIpAddresses(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	PrivateIpAddresses = response.Response.VpcPrivateIpAddressSet
 
@@ -7467,7 +7466,7 @@ func (me *VpcService) DescribeVpcProductQuota(ctx context.Context, param map[str
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7477,14 +7476,14 @@ func (me *VpcService) DescribeVpcProductQuota(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeProductQuota(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	ProductQuota = response.Response.ProductQuotaSet
 
@@ -7499,7 +7498,7 @@ func (me *VpcService) DescribeVpcResourceDashboard(ctx context.Context, param ma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7509,14 +7508,14 @@ func (me *VpcService) DescribeVpcResourceDashboard(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeVpcResourceDashboard(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	ResourceDashboard = response.Response.ResourceDashboardSet
 
@@ -7531,7 +7530,7 @@ func (me *VpcService) DescribeVpcRouteConflicts(ctx context.Context, param map[s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7544,14 +7543,14 @@ func (me *VpcService) DescribeVpcRouteConflicts(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeRouteConflicts(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	routeConflicts = response.Response.RouteConflictSet
 
@@ -7566,18 +7565,18 @@ func (me *VpcService) DescribeVpcSecurityGroupLimits(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupLimits(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	securityGroupLimit = response.Response.SecurityGroupLimitSet
 
@@ -7592,7 +7591,7 @@ func (me *VpcService) DescribeVpcSecurityGroupReferences(ctx context.Context, pa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7602,14 +7601,14 @@ func (me *VpcService) DescribeVpcSecurityGroupReferences(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupReferences(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	securityGroupReferences = response.Response.ReferredSecurityGroupSet
 
@@ -7624,7 +7623,7 @@ func (me *VpcService) DescribeVpcSgSnapshotFileContent(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7640,14 +7639,14 @@ func (me *VpcService) DescribeVpcSgSnapshotFileContent(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSgSnapshotFileContent(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	sgSnapshotFileContent = response.Response
 
@@ -7662,7 +7661,7 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7681,7 +7680,7 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7695,7 +7694,7 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.SnapshotFileSet) < 1 {
 			break
@@ -7719,7 +7718,7 @@ func (me *VpcService) DescribeVpcSubnetResourceDashboardByFilter(ctx context.Con
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7729,14 +7728,14 @@ func (me *VpcService) DescribeVpcSubnetResourceDashboardByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeSubnetResourceDashboard(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	subnetResourceDashboard = response.Response.ResourceStatisticsSet
 
@@ -7751,18 +7750,18 @@ func (me *VpcService) DescribeVpcTemplateLimits(ctx context.Context) (templateLi
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeTemplateLimits(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	templateLimit = response.Response.TemplateLimit
 
@@ -7777,7 +7776,7 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7793,7 +7792,7 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -7807,7 +7806,7 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.IpAddressStates) < 1 {
 			break
@@ -7831,7 +7830,7 @@ func (me *VpcService) DescribeVpcLimitsByFilter(ctx context.Context, param map[s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7841,14 +7840,14 @@ func (me *VpcService) DescribeVpcLimitsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeVpcLimits(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	limits = response.Response.VpcLimitSet
 
@@ -7863,18 +7862,18 @@ func (me *VpcService) DescribeVpcNetworkAclQuintupleById(ctx context.Context, ne
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.NetworkAclQuintupleSet) < 1 {
 		return
@@ -7892,18 +7891,18 @@ func (me *VpcService) DeleteVpcNetworkAclQuintupleById(ctx context.Context, netw
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DeleteNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -7917,18 +7916,18 @@ func (me *VpcService) DeleteVpcEniSgAttachmentById(ctx context.Context, networkI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DisassociateNetworkInterfaceSecurityGroups(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -7941,7 +7940,7 @@ func (me *VpcService) DescribeVpcNetDetectStateCheck(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -7969,14 +7968,14 @@ func (me *VpcService) DescribeVpcNetDetectStateCheck(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().CheckNetDetectState(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	netDetectStateCheck = response.Response.NetDetectIpStateSet
 
@@ -7991,18 +7990,18 @@ func (me *VpcService) DescribeVpcNotifyRoutesById(ctx context.Context, routeTabl
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.RouteTableSet) < 1 {
 		return
@@ -8028,18 +8027,18 @@ func (me *VpcService) DeleteVpcNotifyRoutesById(ctx context.Context, routeTableI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().WithdrawNotifyRoutes(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -8052,7 +8051,7 @@ func (me *VpcService) DescribeVpnDefaultHealthCheckIp(ctx context.Context, param
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -8062,14 +8061,14 @@ func (me *VpcService) DescribeVpnDefaultHealthCheckIp(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().GenerateVpnConnectionDefaultHealthCheckIp(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	defaultHealthCheck = response.Response
 
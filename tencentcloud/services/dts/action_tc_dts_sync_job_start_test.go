@@ -0,0 +1,47 @@
+package dts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svcdts "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/dts"
+)
+
+func TestDtsSyncJobStartAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svcdts.NewDtsSyncJobStartAction(), "tencentcloud_dts_sync_job_start", map[string]bool{"job_id": true})
+}
+
+// TestDtsSyncJobStartAction_Invoke validates that the action starts the job,
+// waits until it is running and reports every status once.
+func TestDtsSyncJobStartAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"StartSyncJob": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeSyncJobs": {
+			`{"Response":{"TotalCount":1,"JobList":[{"JobId":"sync-test","Status":"Starting"}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"JobList":[{"JobId":"sync-test","Status":"Running"}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svcdts.NewDtsSyncJobStartAction(), server.Client("dts"), map[string]tftypes.Value{
+		"job_id": tftypes.NewValue(tftypes.String, "sync-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	requests := server.Requests()
+	if requests[0].Action != "StartSyncJob" || requests[0].Body["JobId"] != "sync-test" {
+		t.Errorf("unexpected first request: %v", requests[0])
+	}
+	want := []string{
+		"Starting DTS sync job sync-test",
+		"DTS sync job sync-test is Starting",
+		"DTS sync job sync-test is Running",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
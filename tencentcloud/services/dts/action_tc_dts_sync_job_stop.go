@@ -0,0 +1,128 @@
+package dts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &DtsSyncJobStopAction{}
+	_ action.ActionWithConfigure = &DtsSyncJobStopAction{}
+)
+
+// NewDtsSyncJobStopAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewDtsSyncJobStopAction() action.Action {
+	return &DtsSyncJobStopAction{}
+}
+
+// DtsSyncJobStopAction implements action.Action for
+// tencentcloud_dts_sync_job_stop. It replaces
+// tencentcloud_dts_sync_job_stop_operation, which leaves an entry in state
+// after the job has stopped.
+type DtsSyncJobStopAction struct {
+	service *DtsService
+}
+
+// DtsSyncJobStopActionModel maps the action configuration attributes.
+type DtsSyncJobStopActionModel struct {
+	JobId types.String `tfsdk:"job_id"`
+}
+
+func (a *DtsSyncJobStopAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_dts_sync_job_stop"
+}
+
+func (a *DtsSyncJobStopAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Stops a DTS sync job and waits until it is stopped.",
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *DtsSyncJobStopAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &DtsService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *DtsSyncJobStopAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_dts_sync_job_stop.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data DtsSyncJobStopActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	jobId := data.JobId.ValueString()
+
+	request := dts.NewStopSyncJobRequest()
+	request.JobId = helper.String(jobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping DTS sync job %s", jobId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := a.service.client.UseDtsClient().StopSyncJob(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s stop dts sync job failed, reason:%+v", logId, err)
+		resp.Diagnostics.AddError("Error stopping DTS sync job", err.Error())
+		return
+	}
+
+	refresh := helper.ProgressRefreshFunc(a.service.DtsSyncJobStateRefreshFunc(jobId, "Stopped", []string{}), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("DTS sync job %s is %s", jobId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{}, []string{"Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for DTS sync job to stop", err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package dts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svcdts "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/dts"
+)
+
+func TestDtsSyncJobStopAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svcdts.NewDtsSyncJobStopAction(), "tencentcloud_dts_sync_job_stop", map[string]bool{"job_id": true})
+}
+
+// TestDtsSyncJobStopAction_Invoke validates that the action stops the job,
+// waits until it is stopped and reports every status once.
+func TestDtsSyncJobStopAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"StopSyncJob": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeSyncJobs": {
+			`{"Response":{"TotalCount":1,"JobList":[{"JobId":"sync-test","Status":"Stopping"}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"JobList":[{"JobId":"sync-test","Status":"Stopped"}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svcdts.NewDtsSyncJobStopAction(), server.Client("dts"), map[string]tftypes.Value{
+		"job_id": tftypes.NewValue(tftypes.String, "sync-test"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	requests := server.Requests()
+	if requests[0].Action != "StopSyncJob" || requests[0].Body["JobId"] != "sync-test" {
+		t.Errorf("unexpected first request: %v", requests[0])
+	}
+	want := []string{
+		"Stopping DTS sync job sync-test",
+		"DTS sync job sync-test is Stopping",
+		"DTS sync job sync-test is Stopped",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
@@ -1668,7 +1668,7 @@ func handleModifyMigrate(d *schema.ResourceData, tcClient *connectivity.TencentC
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, configMigrationJobRequest.GetAction(), configMigrationJobRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, configMigrationJobRequest.GetAction(), connectivity.RedactJSON(configMigrationJobRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -1688,7 +1688,7 @@ func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCl
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, checkMigrateJobRequest.GetAction(), checkMigrateJobRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, checkMigrateJobRequest.GetAction(), connectivity.RedactJSON(checkMigrateJobRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -1717,7 +1717,7 @@ func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCl
 // 		if e != nil {
 // 			return tccommon.RetryError(e)
 // 		} else {
-// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, resumeMigrateJobRequest.GetAction(), resumeMigrateJobRequest.ToJsonString(), result.ToJsonString())
+// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, resumeMigrateJobRequest.GetAction(), connectivity.RedactJSON(resumeMigrateJobRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 // 		}
 // 		return nil
 // 	})
@@ -1750,7 +1750,7 @@ func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCl
 // 		if e != nil {
 // 			return tccommon.RetryError(e)
 // 		} else {
-// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, completeMigrateJobRequest.GetAction(), completeMigrateJobRequest.ToJsonString(), result.ToJsonString())
+// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, completeMigrateJobRequest.GetAction(), connectivity.RedactJSON(completeMigrateJobRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 // 		}
 // 		return nil
 // 	})
@@ -1782,7 +1782,7 @@ func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCl
 // 		if e != nil {
 // 			return tccommon.RetryError(e)
 // 		} else {
-// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, startCompareRequest.GetAction(), startCompareRequest.ToJsonString(), result.ToJsonString())
+// 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, startCompareRequest.GetAction(), connectivity.RedactJSON(startCompareRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 // 		}
 // 		return nil
 // 	})
@@ -10,14 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudDtsSyncJobStopOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobStopOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobStopOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobStopOperationDelete,
+		DeprecationMessage: "This resource will be deprecated in Terraform TencentCloud provider later version. Please use the `tencentcloud_dts_sync_job_stop` action instead.",
+		Create:             resourceTencentCloudDtsSyncJobStopOperationCreate,
+		Read:               resourceTencentCloudDtsSyncJobStopOperationRead,
+		Delete:             resourceTencentCloudDtsSyncJobStopOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -49,7 +51,7 @@ func resourceTencentCloudDtsSyncJobStopOperationCreate(d *schema.ResourceData, m
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
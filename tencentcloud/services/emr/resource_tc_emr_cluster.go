@@ -419,8 +419,8 @@ func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface
 	if !hasTimeUnit || !hasTimeSpan || !hasPayMode {
 		return innerErr.New("Time_unit, time_span or pay_mode must be set.")
 	}
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		err := emrService.ModifyResourcesTags(ctx, meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region, instanceId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		if err != nil {
 			return err
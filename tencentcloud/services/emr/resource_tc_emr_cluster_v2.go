@@ -16,6 +16,7 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -819,7 +820,7 @@ func resourceTencentCloudEmrClusterV2Create(d *schema.ResourceData, meta interfa
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("Create emr cluster v2 failed, Response is nil."))
@@ -1528,7 +1529,7 @@ func resourceTencentCloudEmrClusterV2Update(d *schema.ResourceData, meta interfa
 				return tccommon.RetryError(e)
 			}
 			if result != nil {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -1542,8 +1543,8 @@ func resourceTencentCloudEmrClusterV2Update(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		oldTagList := oldInterface.([]interface{})
 		newTagsList := newInterface.([]interface{})
 		region := meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region
@@ -1626,7 +1627,7 @@ func resourceTencentCloudEmrClusterV2Update(d *schema.ResourceData, meta interfa
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || result.Response == nil || result.Response.ClusterToFlowIdList == nil || len(result.Response.ClusterToFlowIdList) == 0 {
@@ -2202,7 +2203,7 @@ func resourceTencentCloudEmrClusterV2Delete(d *schema.ResourceData, meta interfa
 			return tccommon.RetryError(e)
 		}
 		if result != nil {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -2246,7 +2247,7 @@ func resourceTencentCloudEmrClusterV2Delete(d *schema.ResourceData, meta interfa
 				return tccommon.RetryError(e)
 			}
 			if result != nil {
-				log.Printf("[DEBUG]%s api[%s] (2nd) success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] (2nd) success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -3355,7 +3356,7 @@ func handleNodeDataDiskChange(
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, resizeReq.GetAction(), resizeReq.ToJsonString(), result.ToJsonString())
+				logId, resizeReq.GetAction(), connectivity.RedactJSON(resizeReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.FlowId == nil {
 				return resource.NonRetryableError(fmt.Errorf("resize data disks failed, Response is nil"))
 			}
@@ -3396,7 +3397,7 @@ func handleNodeDataDiskChange(
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, attachReq.GetAction(), attachReq.ToJsonString(), result.ToJsonString())
+				logId, attachReq.GetAction(), connectivity.RedactJSON(attachReq.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil || result.Response.FlowId == nil {
 				return resource.NonRetryableError(fmt.Errorf("attach data disks failed, Response is nil"))
 			}
@@ -3512,7 +3513,7 @@ func emrModifyNodeInstanceType(
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, req.GetAction(), req.ToJsonString(), result.ToJsonString())
+			logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil || result.Response.TraceId == nil {
 			return resource.NonRetryableError(fmt.Errorf("ModifyResource: response is nil"))
 		}
@@ -3568,7 +3569,7 @@ func emrTerminateNodes(
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, req.GetAction(), req.ToJsonString(), result.ToJsonString())
+			logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil || result.Response.FlowId == nil {
 			return resource.NonRetryableError(fmt.Errorf("TerminateClusterNodes: response is nil"))
 		}
@@ -3691,7 +3692,7 @@ func emrScaleOutSingleNode(
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, req.GetAction(), req.ToJsonString(), result.ToJsonString())
+			logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil || result.Response.TraceId == nil {
 			return resource.NonRetryableError(fmt.Errorf("ScaleOutCluster: response is nil"))
 		}
@@ -3800,7 +3801,7 @@ func emrInstallAddedSoftware(
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, req.GetAction(), req.ToJsonString(), result.ToJsonString())
+			logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil || result.Response.FlowId == nil {
 			return resource.NonRetryableError(fmt.Errorf("InstallSoftware: response or FlowId is nil"))
 		}
@@ -11,6 +11,7 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -345,7 +346,7 @@ func resourceTencentCloudEmrYarnUpdate(d *schema.ResourceData, meta interface{})
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 			return nil
 		})
@@ -362,7 +363,7 @@ func resourceTencentCloudEmrYarnUpdate(d *schema.ResourceData, meta interface{})
 		// 	if e != nil {
 		// 		return tccommon.RetryError(e)
 		// 	} else {
-		// 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, deployRequest.GetAction(), deployRequest.ToJsonString(), result.ToJsonString())
+		// 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, deployRequest.GetAction(), connectivity.RedactJSON(deployRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		// 	}
 		// 	flowId = result.Response.FlowId
 		// 	return nil
@@ -0,0 +1,144 @@
+package es
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	elasticsearch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var (
+	_ action.Action              = &ElasticsearchRestartInstanceAction{}
+	_ action.ActionWithConfigure = &ElasticsearchRestartInstanceAction{}
+)
+
+// NewElasticsearchRestartInstanceAction is the factory referenced by
+// tencentcloud/framework/registry.go to register this action.
+func NewElasticsearchRestartInstanceAction() action.Action {
+	return &ElasticsearchRestartInstanceAction{}
+}
+
+// ElasticsearchRestartInstanceAction implements action.Action for
+// tencentcloud_elasticsearch_restart_instance. It replaces
+// tencentcloud_elasticsearch_restart_instance_operation, which leaves an
+// entry in state after the restart.
+type ElasticsearchRestartInstanceAction struct {
+	service *ElasticsearchService
+}
+
+// ElasticsearchRestartInstanceActionModel maps the action configuration attributes.
+type ElasticsearchRestartInstanceActionModel struct {
+	InstanceId   types.String `tfsdk:"instance_id"`
+	ForceRestart types.Bool   `tfsdk:"force_restart"`
+	RestartMode  types.Int64  `tfsdk:"restart_mode"`
+}
+
+func (a *ElasticsearchRestartInstanceAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "tencentcloud_elasticsearch_restart_instance"
+}
+
+func (a *ElasticsearchRestartInstanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts an Elasticsearch instance and waits until it is normal again.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance id.",
+			},
+			"force_restart": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Force restart. Valid values: `true`: forced restart; `false`: no forced restart. Default value: false.",
+			},
+			"restart_mode": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Restart mode: 0 roll restart; 1 full restart. Default value: 0.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (a *ElasticsearchRestartInstanceAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	a.service = &ElasticsearchService{client: meta.Client}
+}
+
+// Invoke runs the operation and sends a progress event whenever the polled
+// state changes until the operation has finished.
+func (a *ElasticsearchRestartInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tccommon.LogElapsed("action.tencentcloud_elasticsearch_restart_instance.invoke")()
+
+	if a.service == nil {
+		resp.Diagnostics.AddError("TencentCloud provider not configured",
+			"The action was invoked before the provider was configured. "+
+				"Please file an issue if you see this in production.")
+		return
+	}
+
+	var data ElasticsearchRestartInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	instanceId := data.InstanceId.ValueString()
+
+	request := elasticsearch.NewRestartInstanceRequest()
+	request.InstanceId = helper.String(instanceId)
+	if !data.ForceRestart.IsNull() {
+		request.ForceRestart = helper.Bool(data.ForceRestart.ValueBool())
+	}
+	if !data.RestartMode.IsNull() {
+		request.RestartMode = helper.Int64(data.RestartMode.ValueInt64())
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting Elasticsearch instance %s", instanceId)})
+	err := resource.RetryContext(ctx, tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := a.service.client.UseEsClient().RestartInstance(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s restart elasticsearch instance failed, reason:%+v", logId, err)
+		resp.Diagnostics.AddError("Error restarting Elasticsearch instance", err.Error())
+		return
+	}
+
+	refresh := helper.ProgressRefreshFunc(a.service.ElasticsearchInstanceRefreshFunc(instanceId, []string{}), func(state string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Elasticsearch instance %s status is %s", instanceId, state)})
+	})
+	conf := tccommon.BuildStateChangeConfContext(ctx, []string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, refresh)
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error waiting for Elasticsearch instance to restart", err.Error())
+	}
+}
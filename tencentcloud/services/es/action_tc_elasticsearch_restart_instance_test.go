@@ -0,0 +1,53 @@
+package es_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+	svces "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/es"
+)
+
+func TestElasticsearchRestartInstanceAction_Schema(t *testing.T) {
+	acctest.CheckActionSchema(t, svces.NewElasticsearchRestartInstanceAction(), "tencentcloud_elasticsearch_restart_instance", map[string]bool{"instance_id": true, "force_restart": false, "restart_mode": false})
+}
+
+// TestElasticsearchRestartInstanceAction_Invoke validates that the action
+// restarts the instance with the restart options, waits until it is normal
+// again and reports every status once.
+func TestElasticsearchRestartInstanceAction_Invoke(t *testing.T) {
+	server := acctest.NewActionServer(t, map[string][]string{
+		"RestartInstance": {`{"Response":{"RequestId":"req-1"}}`},
+		"DescribeInstances": {
+			`{"Response":{"TotalCount":1,"InstanceList":[{"InstanceId":"es-test","Status":0}],"RequestId":"req-2"}}`,
+			`{"Response":{"TotalCount":1,"InstanceList":[{"InstanceId":"es-test","Status":1}],"RequestId":"req-3"}}`,
+		},
+	})
+
+	progress, resp := acctest.InvokeAction(t, svces.NewElasticsearchRestartInstanceAction(), server.Client("es"), map[string]tftypes.Value{
+		"instance_id":   tftypes.NewValue(tftypes.String, "es-test"),
+		"force_restart": tftypes.NewValue(tftypes.Bool, true),
+		"restart_mode":  tftypes.NewValue(tftypes.Number, 1),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	if actions := fmt.Sprint(server.Actions()); actions != "[RestartInstance DescribeInstances DescribeInstances]" {
+		t.Errorf("unexpected actions: %s", actions)
+	}
+	request := server.Requests()[0].Body
+	if request["InstanceId"] != "es-test" || request["ForceRestart"] != true || request["RestartMode"] != float64(1) {
+		t.Errorf("unexpected RestartInstance request: %v", request)
+	}
+	want := []string{
+		"Restarting Elasticsearch instance es-test",
+		"Elasticsearch instance es-test status is 0",
+		"Elasticsearch instance es-test status is 1",
+	}
+	if fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+}
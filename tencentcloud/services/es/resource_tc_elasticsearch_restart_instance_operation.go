@@ -10,14 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	elasticsearch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudElasticsearchRestartInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudElasticsearchRestartInstanceOperationCreate,
-		Read:   resourceTencentCloudElasticsearchRestartInstanceOperationRead,
-		Delete: resourceTencentCloudElasticsearchRestartInstanceOperationDelete,
+		DeprecationMessage: "This resource will be deprecated in Terraform TencentCloud provider later version. Please use the `tencentcloud_elasticsearch_restart_instance` action instead.",
+		Create:             resourceTencentCloudElasticsearchRestartInstanceOperationCreate,
+		Read:               resourceTencentCloudElasticsearchRestartInstanceOperationRead,
+		Delete:             resourceTencentCloudElasticsearchRestartInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -76,7 +78,7 @@ func resourceTencentCloudElasticsearchRestartInstanceOperationCreate(d *schema.R
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -14,6 +14,7 @@ import (
 	ga2v20250115 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ga2/v20250115"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -304,7 +305,7 @@ func resourceTencentCloudGa2EndpointGroupCreate(d *schema.ResourceData, meta int
 		if e != nil {
 			return tccommon.RetryError(e, "UnsupportedOperation.InstanceNotRunning", "UnsupportedOperation.InstanceStateNotAllowedOperate")
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil {
@@ -428,7 +429,7 @@ func resourceTencentCloudGa2EndpointGroupUpdate(d *schema.ResourceData, meta int
 		if e != nil {
 			return tccommon.RetryError(e, "UnsupportedOperation.InstanceNotRunning", "UnsupportedOperation.InstanceStateNotAllowedOperate")
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.TaskId == nil {
@@ -483,7 +484,7 @@ func resourceTencentCloudGa2EndpointGroupDelete(d *schema.ResourceData, meta int
 
 			return tccommon.RetryError(e, "UnsupportedOperation.InstanceNotRunning", "UnsupportedOperation.InstanceStateNotAllowedOperate")
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if result == nil || result.Response == nil || result.Response.TaskId == nil {
@@ -341,8 +341,8 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := svctag.NewTagService(m.(tccommon.ProviderMeta).GetAPIV3Conn())
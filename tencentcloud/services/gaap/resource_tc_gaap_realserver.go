@@ -200,8 +200,8 @@ func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := svctag.NewTagService(m.(tccommon.ProviderMeta).GetAPIV3Conn())
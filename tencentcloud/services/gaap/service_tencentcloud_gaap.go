@@ -19,7 +19,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type gaapRealserverBind struct {
@@ -63,12 +62,12 @@ func (me *GaapService) CreateRealserver(ctx context.Context, address, name strin
 	request.ProjectId = helper.IntUint64(projectId)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().AddRealServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -131,14 +130,14 @@ func (me *GaapService) DescribeRealservers(ctx context.Context, address, name *s
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeRealServers(request)
 			if err != nil {
 				count = 0
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -165,11 +164,11 @@ func (me *GaapService) ModifyRealserverName(ctx context.Context, id, name string
 	request.RealServerName = &name
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().ModifyRealServerName(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -187,11 +186,11 @@ func (me *GaapService) DeleteRealserver(ctx context.Context, id string) error {
 	request.RealServerIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().RemoveRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -213,12 +212,12 @@ func (me *GaapService) createCertificate(ctx context.Context, certificateType in
 	request.CertificateKey = key
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().CreateCertificate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		if response.Response.CertificateId == nil {
@@ -245,7 +244,7 @@ func (me *GaapService) DescribeCertificateById(ctx context.Context, id string) (
 	request.CertificateId = &id
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeCertificateDetail(request)
 		if err != nil {
@@ -256,7 +255,7 @@ func (me *GaapService) DescribeCertificateById(ctx context.Context, id string) (
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -278,11 +277,11 @@ func (me *GaapService) ModifyCertificateName(ctx context.Context, id, name strin
 	request.CertificateAlias = &name
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().ModifyCertificateAttributes(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -301,11 +300,11 @@ func (me *GaapService) DeleteCertificate(ctx context.Context, id string) error {
 	request.CertificateId = &id
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().DeleteCertificate(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -317,18 +316,18 @@ func (me *GaapService) DeleteCertificate(ctx context.Context, id string) error {
 	return nil
 }
 
-func waitTaskReady(ctx context.Context, client *gaap.Client, reqeustId string) error {
+func (me *GaapService) waitTaskReady(ctx context.Context, client *gaap.Client, reqeustId string) error {
 	logId := tccommon.GetLogId(ctx)
 
 	describeRequest := gaap.NewDescribeTaskStatusRequest()
 	describeRequest.TaskId = helper.String(reqeustId)
 
 	err := resource.Retry(2*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 		response, err := client.DescribeTaskStatus(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		// 任务状态：RUNNING，FAIL，SUCCESS
@@ -376,13 +375,13 @@ func (me *GaapService) CreateProxy(
 	}
 
 	if err := resource.Retry(2*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+		me.client.RateLimiter.Check(createRequest.GetAction())
 		createRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.CreateProxy(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+				logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -401,7 +400,7 @@ func (me *GaapService) CreateProxy(
 	}
 
 	time.Sleep(3 * time.Second)
-	if err := waitTaskReady(ctx, client, createProxyRequestId); err != nil {
+	if err := me.waitTaskReady(ctx, client, createProxyRequestId); err != nil {
 		return "", err
 	}
 
@@ -416,13 +415,13 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	enableRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
+		me.client.RateLimiter.Check(enableRequest.GetAction())
 		enableRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.OpenProxies(enableRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, enableRequest.GetAction(), enableRequest.ToJsonString(), err)
+				logId, enableRequest.GetAction(), connectivity.RedactJSON(enableRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -448,12 +447,12 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -495,13 +494,13 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	disableRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
+		me.client.RateLimiter.Check(disableRequest.GetAction())
 		disableRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.CloseProxies(disableRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, disableRequest.GetAction(), disableRequest.ToJsonString(), err)
+				logId, disableRequest.GetAction(), connectivity.RedactJSON(disableRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -527,12 +526,12 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -614,7 +613,7 @@ func (me *GaapService) DescribeProxies(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			if len(ids) > 0 {
 				var iacExtInfo connectivity.IacExtInfo
 				tmpIds := strings.Join(ids, tccommon.FILED_SP)
@@ -634,7 +633,7 @@ func (me *GaapService) DescribeProxies(
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -661,12 +660,12 @@ func (me *GaapService) ModifyProxyName(ctx context.Context, id, name string) err
 	request.ProxyName = &name
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		request.ClientToken = helper.String(helper.BuildToken())
 
 		if _, err := me.client.UseGaapClient().ModifyProxiesAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -686,12 +685,12 @@ func (me *GaapService) ModifyProxyProjectId(ctx context.Context, id string, proj
 	request.ProjectId = helper.IntInt64(projectId)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		request.ClientToken = helper.String(helper.BuildToken())
 
 		if _, err := me.client.UseGaapClient().ModifyProxiesProject(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -717,13 +716,13 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 	}
 
 	if err := resource.Retry(2*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(modifyRequest.GetAction())
+		me.client.RateLimiter.Check(modifyRequest.GetAction())
 		modifyRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.ModifyProxyConfiguration(modifyRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, modifyRequest.GetAction(), modifyRequest.ToJsonString(), err)
+				logId, modifyRequest.GetAction(), connectivity.RedactJSON(modifyRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		modifyProxyRequestId = *response.Response.RequestId
@@ -734,7 +733,7 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 	}
 
 	time.Sleep(3 * time.Second)
-	if err := waitTaskReady(ctx, client, modifyProxyRequestId); err != nil {
+	if err := me.waitTaskReady(ctx, client, modifyProxyRequestId); err != nil {
 		return err
 	}
 
@@ -750,13 +749,13 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	deleteRequest.Force = helper.IntInt64(1)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 		deleteRequest.ClientToken = helper.String(helper.BuildToken())
 
 		response, err := client.DestroyProxies(deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -782,7 +781,7 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	describeRequest.ProxyIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeProxies(describeRequest)
 		if err != nil {
@@ -793,7 +792,7 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -839,12 +838,12 @@ func (me *GaapService) CreateTCPListener(
 	request.UnhealthyThreshold = helper.IntUint64(unhealthyThreshold)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.CreateTCPListeners(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -861,7 +860,7 @@ func (me *GaapService) CreateTCPListener(
 		return "", err
 	}
 
-	if err := waitLayer4ListenerReady(ctx, client, id, "TCP"); err != nil {
+	if err := me.waitLayer4ListenerReady(ctx, client, id, "TCP"); err != nil {
 		log.Printf("[CRITAL]%s create TCP listener failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -911,12 +910,12 @@ func (me *GaapService) CreateUDPListener(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.CreateUDPListeners(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -933,7 +932,7 @@ func (me *GaapService) CreateUDPListener(
 		return "", err
 	}
 
-	if err := waitLayer4ListenerReady(ctx, client, id, "UDP"); err != nil {
+	if err := me.waitLayer4ListenerReady(ctx, client, id, "UDP"); err != nil {
 		log.Printf("[CRITAL]%s create UDP listener failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -958,11 +957,11 @@ func (me *GaapService) BindLayer4ListenerRealservers(ctx context.Context, id, pr
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.BindListenerRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -971,7 +970,7 @@ func (me *GaapService) BindLayer4ListenerRealservers(ctx context.Context, id, pr
 		return err
 	}
 
-	if err := waitLayer4ListenerReady(ctx, client, id, protocol); err != nil {
+	if err := me.waitLayer4ListenerReady(ctx, client, id, protocol); err != nil {
 		log.Printf("[CRITAL]%s bind realservers to layer4 listener failed, reason: %v", logId, err)
 		return err
 	}
@@ -1008,7 +1007,7 @@ func (me *GaapService) DescribeTCPListeners(ctx context.Context, proxyId, listen
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeTCPListeners(request)
 			if err != nil {
@@ -1021,7 +1020,7 @@ func (me *GaapService) DescribeTCPListeners(ctx context.Context, proxyId, listen
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -1069,7 +1068,7 @@ func (me *GaapService) DescribeUDPListeners(ctx context.Context, proxyId, id, na
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeUDPListeners(request)
 			if err != nil {
@@ -1082,7 +1081,7 @@ func (me *GaapService) DescribeUDPListeners(ctx context.Context, proxyId, id, na
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -1129,11 +1128,11 @@ func (me *GaapService) ModifyTCPListenerAttribute(
 	request.UnhealthyThreshold = helper.IntUint64(unhealthyThreshold)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyTCPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1142,7 +1141,7 @@ func (me *GaapService) ModifyTCPListenerAttribute(
 		return err
 	}
 
-	if err := waitLayer4ListenerReady(ctx, client, id, "TCP"); err != nil {
+	if err := me.waitLayer4ListenerReady(ctx, client, id, "TCP"); err != nil {
 		log.Printf("[CRITAL]%s modify TCP listener attribute failed, reason: %v", logId, err)
 		return err
 	}
@@ -1194,11 +1193,11 @@ func (me *GaapService) ModifyUDPListenerAttribute(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyUDPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1207,7 +1206,7 @@ func (me *GaapService) ModifyUDPListenerAttribute(
 		return err
 	}
 
-	if err := waitLayer4ListenerReady(ctx, client, id, "UDP"); err != nil {
+	if err := me.waitLayer4ListenerReady(ctx, client, id, "UDP"); err != nil {
 		log.Printf("[CRITAL]%s modify UDP listener attribute failed, reason: %v", logId, err)
 		return err
 	}
@@ -1225,12 +1224,12 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		response, err := client.DeleteListeners(deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err, GAAPInternalError)
 		}
 
@@ -1265,7 +1264,7 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+			me.client.RateLimiter.Check(describeRequest.GetAction())
 
 			response, err := client.DescribeTCPListeners(describeRequest)
 			if err != nil {
@@ -1276,7 +1275,7 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+					logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -1297,7 +1296,7 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+			me.client.RateLimiter.Check(describeRequest.GetAction())
 
 			response, err := client.DescribeUDPListeners(describeRequest)
 			if err != nil {
@@ -1308,7 +1307,7 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+					logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -1336,12 +1335,12 @@ func (me *GaapService) CreateSecurityPolicy(ctx context.Context, proxyId, action
 	request.DefaultAction = &action
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().CreateSecurityPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1369,11 +1368,11 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	enableRequest.ProxyId = &proxyId
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
+		me.client.RateLimiter.Check(enableRequest.GetAction())
 
 		if _, err := client.OpenSecurityPolicy(enableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, enableRequest.GetAction(), enableRequest.ToJsonString(), err)
+				logId, enableRequest.GetAction(), connectivity.RedactJSON(enableRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1386,7 +1385,7 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	describeRequest.PolicyId = &policyId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
@@ -1396,7 +1395,7 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1429,11 +1428,11 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	disableRequest.ProxyId = &proxyId
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
+		me.client.RateLimiter.Check(disableRequest.GetAction())
 
 		if _, err := client.CloseSecurityPolicy(disableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, disableRequest.GetAction(), disableRequest.ToJsonString(), err)
+				logId, disableRequest.GetAction(), connectivity.RedactJSON(disableRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1446,7 +1445,7 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	describeRequest.PolicyId = &policyId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
@@ -1456,7 +1455,7 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1488,7 +1487,7 @@ func (me *GaapService) DescribeSecurityPolicy(ctx context.Context, id string) (p
 	request.PolicyId = &id
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
 		if err != nil {
@@ -1499,7 +1498,7 @@ func (me *GaapService) DescribeSecurityPolicy(ctx context.Context, id string) (p
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1543,11 +1542,11 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	deleteRequest.PolicyId = &id
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		if _, err := client.DeleteSecurityPolicy(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1560,7 +1559,7 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	describeRequest.PolicyId = &id
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		_, err := client.DescribeSecurityPolicyDetail(describeRequest)
 		if err != nil {
@@ -1570,7 +1569,7 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err, GAAPInternalError)
 		}
 
@@ -1604,12 +1603,12 @@ func (me *GaapService) CreateSecurityRule(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().CreateSecurityRules(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1642,7 +1641,7 @@ func (me *GaapService) DescribeSecurityRule(ctx context.Context, id string) (sec
 	request.SecurityRuleIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeSecurityRules(request)
 		if err != nil {
@@ -1653,7 +1652,7 @@ func (me *GaapService) DescribeSecurityRule(ctx context.Context, id string) (sec
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1694,11 +1693,11 @@ func (me *GaapService) ModifySecurityRule(ctx context.Context, policyId, ruleId,
 	request.AliasName = &name
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().ModifySecurityRule(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1718,11 +1717,11 @@ func (me *GaapService) DeleteSecurityRule(ctx context.Context, policyId, ruleId
 	request.RuleIdList = []*string{&ruleId}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().DeleteSecurityRules(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1749,12 +1748,12 @@ func (me *GaapService) CreateHTTPListener(ctx context.Context, name, proxyId, gr
 	request.Port = helper.IntUint64(port)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.CreateHTTPListener(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1771,7 +1770,7 @@ func (me *GaapService) CreateHTTPListener(ctx context.Context, name, proxyId, gr
 		return "", err
 	}
 
-	if err := waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTP"); err != nil {
+	if err := me.waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTP"); err != nil {
 		log.Printf("[CRITAL]%s create HTTP listener failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -1803,12 +1802,12 @@ func (me *GaapService) CreateHTTPSListener(
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.CreateHTTPSListener(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -1825,7 +1824,7 @@ func (me *GaapService) CreateHTTPSListener(
 		return "", err
 	}
 
-	if err := waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTPS"); err != nil {
+	if err := me.waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTPS"); err != nil {
 		log.Printf("[CRITAL]%s create HTTPS listener failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -1871,7 +1870,7 @@ func (me *GaapService) DescribeHTTPListeners(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeHTTPListeners(request)
 			if err != nil {
@@ -1884,7 +1883,7 @@ func (me *GaapService) DescribeHTTPListeners(
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -1941,7 +1940,7 @@ func (me *GaapService) DescribeHTTPSListeners(
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeHTTPSListeners(request)
 			if err != nil {
@@ -1954,7 +1953,7 @@ func (me *GaapService) DescribeHTTPSListeners(
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -1982,11 +1981,11 @@ func (me *GaapService) ModifyHTTPListener(ctx context.Context, id, proxyId, grou
 	request.ListenerName = &name
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyHTTPListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -1995,7 +1994,7 @@ func (me *GaapService) ModifyHTTPListener(ctx context.Context, id, proxyId, grou
 		return err
 	}
 
-	if err := waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTP"); err != nil {
+	if err := me.waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTP"); err != nil {
 		log.Printf("[CRITAL]%s modify HTTP listener failed, reason: %v", logId, err)
 		return err
 	}
@@ -2023,11 +2022,11 @@ func (me *GaapService) ModifyHTTPSListener(
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyHTTPSListenerAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2036,7 +2035,7 @@ func (me *GaapService) ModifyHTTPSListener(
 		return err
 	}
 
-	if err := waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTPS"); err != nil {
+	if err := me.waitLayer7ListenerReady(ctx, client, proxyId, groupId, id, "HTTPS"); err != nil {
 		log.Printf("[CRITAL]%s modify HTTPS listener failed, reason: %v", logId, err)
 		return err
 	}
@@ -2059,12 +2058,12 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		response, err := client.DeleteListeners(deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2101,7 +2100,7 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+			me.client.RateLimiter.Check(describeRequest.GetAction())
 
 			response, err := client.DescribeHTTPListeners(describeRequest)
 			if err != nil {
@@ -2112,7 +2111,7 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+					logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -2135,7 +2134,7 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 		describeRequest.ListenerId = &id
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+			me.client.RateLimiter.Check(describeRequest.GetAction())
 
 			response, err := client.DescribeHTTPSListeners(describeRequest)
 			if err != nil {
@@ -2146,7 +2145,7 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+					logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -2166,7 +2165,7 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, gr
 	return nil
 }
 
-func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, protocol string) (err error) {
+func (me *GaapService) waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, protocol string) (err error) {
 	logId := tccommon.GetLogId(ctx)
 
 	switch protocol {
@@ -2175,12 +2174,12 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request.ListenerId = &id
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := client.DescribeTCPListeners(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -2219,12 +2218,12 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request.ListenerId = &id
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := client.DescribeUDPListeners(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err, GAAPInternalError)
 			}
 
@@ -2262,7 +2261,7 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 	return
 }
 
-func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId, groupId, id, protocol string) (err error) {
+func (me *GaapService) waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId, groupId, id, protocol string) (err error) {
 	logId := tccommon.GetLogId(ctx)
 
 	switch protocol {
@@ -2277,7 +2276,7 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		}
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := client.DescribeHTTPListeners(request)
 			if err != nil {
@@ -2288,7 +2287,7 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 				}
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -2333,7 +2332,7 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		}
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := client.DescribeHTTPSListeners(request)
 			if err != nil {
@@ -2343,7 +2342,7 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 					}
 				}
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -2391,11 +2390,11 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	createRequest.IsDefaultServer = &isDefaultServer
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+		me.client.RateLimiter.Check(createRequest.GetAction())
 
 		if _, err := client.CreateDomain(createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+				logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2408,7 +2407,7 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
@@ -2418,7 +2417,7 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2465,11 +2464,11 @@ func (me *GaapService) CreateHTTPSDomain(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+		me.client.RateLimiter.Check(createRequest.GetAction())
 
 		if _, err := client.CreateDomain(createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+				logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2482,7 +2481,7 @@ func (me *GaapService) CreateHTTPSDomain(
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
@@ -2492,7 +2491,7 @@ func (me *GaapService) CreateHTTPSDomain(
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2561,11 +2560,11 @@ func (me *GaapService) SetAdvancedAuth(
 	request.GaapCertificateId = gaapAuthId
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().SetAuthentication(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2584,7 +2583,7 @@ func (me *GaapService) DescribeDomain(ctx context.Context, listenerId, domain st
 	request.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeRules(request)
 		if err != nil {
@@ -2594,7 +2593,7 @@ func (me *GaapService) DescribeDomain(ctx context.Context, listenerId, domain st
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2637,11 +2636,11 @@ func (me *GaapService) ModifyDomainCertificate(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := me.client.UseGaapClient().ModifyCertificate(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2663,11 +2662,11 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	deleteRequest.Force = helper.IntUint64(0)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		if _, err := client.DeleteDomain(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2680,7 +2679,7 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
@@ -2690,7 +2689,7 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err, GAAPInternalError)
 		}
 
@@ -2753,12 +2752,12 @@ func (me *GaapService) CreateHttpRule(ctx context.Context, httpRule gaapHttpRule
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.CreateRule(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2775,7 +2774,7 @@ func (me *GaapService) CreateHttpRule(ctx context.Context, httpRule gaapHttpRule
 		return "", err
 	}
 
-	if err := waitHttpRuleReady(ctx, client, httpRule.listenerId, id); err != nil {
+	if err := me.waitHttpRuleReady(ctx, client, httpRule.listenerId, id); err != nil {
 		log.Printf("[CRITAL]%s create HTTP rule failed, reason: %v", logId, err)
 		return "", err
 	}
@@ -2801,11 +2800,11 @@ func (me *GaapService) BindHttpRuleRealservers(ctx context.Context, listenerId,
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.BindRuleRealServers(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2814,7 +2813,7 @@ func (me *GaapService) BindHttpRuleRealservers(ctx context.Context, listenerId,
 		return err
 	}
 
-	if err := waitHttpRuleReady(ctx, client, listenerId, ruleId); err != nil {
+	if err := me.waitHttpRuleReady(ctx, client, listenerId, ruleId); err != nil {
 		log.Printf("[CRITAL]%s bind HTTP rule realservers failed, reason: %v", logId, err)
 		return err
 	}
@@ -2829,7 +2828,7 @@ func (me *GaapService) DescribeHttpRule(ctx context.Context, id string) (rule *g
 	request.RuleIds = []*string{&id}
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeRulesByRuleIds(request)
 		if err != nil {
@@ -2840,7 +2839,7 @@ func (me *GaapService) DescribeHttpRule(ctx context.Context, id string) (rule *g
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -2895,11 +2894,11 @@ func (me *GaapService) ModifyHTTPRuleAttribute(
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyRuleAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2908,7 +2907,7 @@ func (me *GaapService) ModifyHTTPRuleAttribute(
 		return err
 	}
 
-	return waitHttpRuleReady(ctx, client, listenerId, ruleId)
+	return me.waitHttpRuleReady(ctx, client, listenerId, ruleId)
 }
 
 func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId string) error {
@@ -2921,11 +2920,11 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	deleteRequest.Force = helper.IntUint64(1)
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		if _, err := client.DeleteRule(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -2938,7 +2937,7 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	describeRequest.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeRules(describeRequest)
 		if err != nil {
@@ -2948,7 +2947,7 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err, GAAPInternalError)
 		}
 
@@ -2977,14 +2976,14 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	return nil
 }
 
-func waitHttpRuleReady(ctx context.Context, client *gaap.Client, listenerId, ruleId string) error {
+func (me *GaapService) waitHttpRuleReady(ctx context.Context, client *gaap.Client, listenerId, ruleId string) error {
 	logId := tccommon.GetLogId(ctx)
 
 	request := gaap.NewDescribeRulesRequest()
 	request.ListenerId = &listenerId
 
 	return resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := client.DescribeRules(request)
 		if err != nil {
@@ -2994,7 +2993,7 @@ func waitHttpRuleReady(ctx context.Context, client *gaap.Client, listenerId, rul
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3036,7 +3035,7 @@ func (me *GaapService) DescribeDomains(ctx context.Context, listenerId, domain s
 	request.ListenerId = &listenerId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeRules(request)
 		if err != nil {
@@ -3046,7 +3045,7 @@ func (me *GaapService) DescribeDomains(ctx context.Context, listenerId, domain s
 				}
 			}
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3078,7 +3077,7 @@ func (me *GaapService) DescribeSecurityRules(ctx context.Context, policyId strin
 	request.PolicyId = &policyId
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
 		if err != nil {
@@ -3089,7 +3088,7 @@ func (me *GaapService) DescribeSecurityRules(ctx context.Context, policyId strin
 			}
 
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3122,14 +3121,14 @@ func (me *GaapService) DescribeCertificates(ctx context.Context, id, name *strin
 		request.Offset = helper.IntUint64(offset)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseGaapClient().DescribeCertificates(request)
 			if err != nil {
 				count = 0
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -3194,11 +3193,11 @@ func (me *GaapService) ModifyHTTPRuleForwardHost(ctx context.Context, listenerId
 	request.ForwardHost = &forwardHost
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		if _, err := client.ModifyRuleAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -3208,7 +3207,7 @@ func (me *GaapService) ModifyHTTPRuleForwardHost(ctx context.Context, listenerId
 		return err
 	}
 
-	if err := waitHttpRuleReady(ctx, client, listenerId, ruleId); err != nil {
+	if err := me.waitHttpRuleReady(ctx, client, listenerId, ruleId); err != nil {
 		log.Printf("[CRITAL]%s modify HTTP rule forward host failed, reason: %v", logId, err)
 		return err
 	}
@@ -3387,17 +3386,17 @@ func (me *GaapService) DescribeGaapAccessRegions(ctx context.Context) (describeA
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseGaapClient().DescribeAccessRegions(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.AccessRegionSet) < 1 {
 		return
@@ -3415,7 +3414,7 @@ func (me *GaapService) DescribeGaapAccessRegionsByDestRegionByFilter(ctx context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3431,14 +3430,14 @@ func (me *GaapService) DescribeGaapAccessRegionsByDestRegionByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeAccessRegionsByDestRegion(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.AccessRegionSet) < 1 {
 		return
@@ -3456,17 +3455,17 @@ func (me *GaapService) DescribeGaapBlackHeader(ctx context.Context) (blackHeader
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseGaapClient().DescribeBlackHeader(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.BlackHeaders) < 1 {
 		return
@@ -3484,18 +3483,18 @@ func (me *GaapService) DescribeGaapCountryAreaMapping(ctx context.Context) (coun
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeCountryAreaMapping(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.CountryAreaMappingList) < 1 {
 		return
@@ -3513,18 +3512,18 @@ func (me *GaapService) DescribeGaapCustomHeader(ctx context.Context, ruleId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	request.RuleId = &ruleId
 	response, err := me.client.UseGaapClient().DescribeCustomHeader(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.Headers) < 1 {
 		return
@@ -3543,18 +3542,18 @@ func (me *GaapService) CreateCustomHeader(ctx context.Context, ruleId string, he
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().CreateCustomHeader(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -3567,18 +3566,18 @@ func (me *GaapService) DescribeGaapDestRegions(ctx context.Context) (destRegions
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeDestRegions(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.DestRegionSet) < 1 {
 		return
@@ -3595,18 +3594,18 @@ func (me *GaapService) DescribeGaapProxyDetail(ctx context.Context, proxyId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	request.ProxyId = &proxyId
 	response, err := me.client.UseGaapClient().DescribeProxyDetail(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -3624,7 +3623,7 @@ func (me *GaapService) DescribeGaapProxyGroupsByFilter(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3640,7 +3639,7 @@ func (me *GaapService) DescribeGaapProxyGroupsByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset int64 = 0
@@ -3654,7 +3653,7 @@ func (me *GaapService) DescribeGaapProxyGroupsByFilter(ctx context.Context, para
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ProxyGroupList) < 1 {
 			break
@@ -3678,7 +3677,7 @@ func (me *GaapService) DescribeGaapProxyStatisticsByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3703,14 +3702,14 @@ func (me *GaapService) DescribeGaapProxyStatisticsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeProxyStatistics(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.StatisticsData) < 1 {
 		return
@@ -3728,7 +3727,7 @@ func (me *GaapService) DescribeGaapProxyGroupStatisticsByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3750,14 +3749,14 @@ func (me *GaapService) DescribeGaapProxyGroupStatisticsByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeProxyGroupStatistics(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.StatisticsData) < 1 {
 		return
@@ -3775,7 +3774,7 @@ func (me *GaapService) DescribeGaapRealServersStatusByFilter(ctx context.Context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3785,14 +3784,14 @@ func (me *GaapService) DescribeGaapRealServersStatusByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeRealServersStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.RealServerStatusSet) < 1 {
 		return
@@ -3810,7 +3809,7 @@ func (me *GaapService) DescribeGaapRuleRealServersByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3820,7 +3819,7 @@ func (me *GaapService) DescribeGaapRuleRealServersByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -3834,7 +3833,7 @@ func (me *GaapService) DescribeGaapRuleRealServersByFilter(ctx context.Context,
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.RealServerSet) < 1 {
 			break
@@ -3862,7 +3861,7 @@ func (me *GaapService) DescribeGaapResourcesByTagByFilter(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3878,14 +3877,14 @@ func (me *GaapService) DescribeGaapResourcesByTagByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeResourcesByTag(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.ResourceSet) < 1 {
 		return
@@ -3903,7 +3902,7 @@ func (me *GaapService) DescribeGaapRegionAndPriceByFilter(ctx context.Context, p
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3916,14 +3915,14 @@ func (me *GaapService) DescribeGaapRegionAndPriceByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeRegionAndPrice(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -3946,7 +3945,7 @@ func (me *GaapService) DescribeGaapProxyAndStatisticsListenersByFilter(ctx conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3956,14 +3955,14 @@ func (me *GaapService) DescribeGaapProxyAndStatisticsListenersByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeProxyAndStatisticsListeners(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.ProxySet) < 1 {
 		return
@@ -3981,7 +3980,7 @@ func (me *GaapService) DescribeGaapProxiesStatusByFilter(ctx context.Context, pa
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -3994,14 +3993,14 @@ func (me *GaapService) DescribeGaapProxiesStatusByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeProxiesStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.InstanceStatusSet) < 1 {
 		return
@@ -4019,7 +4018,7 @@ func (me *GaapService) DescribeGaapListenerStatisticsByFilter(ctx context.Contex
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4041,14 +4040,14 @@ func (me *GaapService) DescribeGaapListenerStatisticsByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeListenerStatistics(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.StatisticsData) < 1 {
 		return
@@ -4066,7 +4065,7 @@ func (me *GaapService) DescribeGaapListenerRealServersByFilter(ctx context.Conte
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4076,14 +4075,14 @@ func (me *GaapService) DescribeGaapListenerRealServersByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeListenerRealServers(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.RealServerSet) < 1 {
 		return
@@ -4101,7 +4100,7 @@ func (me *GaapService) DescribeGaapGroupAndStatisticsProxyByFilter(ctx context.C
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4111,14 +4110,14 @@ func (me *GaapService) DescribeGaapGroupAndStatisticsProxyByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeGroupAndStatisticsProxy(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.GroupSet) < 1 {
 		return
@@ -4136,7 +4135,7 @@ func (me *GaapService) DescribeGaapDomainErrorPageInfosByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4146,14 +4145,14 @@ func (me *GaapService) DescribeGaapDomainErrorPageInfosByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeDomainErrorPageInfoByIds(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.ErrorPageSet) < 1 {
 		return
@@ -4171,7 +4170,7 @@ func (me *GaapService) DescribeGaapCheckProxyCreate(ctx context.Context, param m
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4205,14 +4204,14 @@ func (me *GaapService) DescribeGaapCheckProxyCreate(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().CheckProxyCreate(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -4230,18 +4229,18 @@ func (me *GaapService) DescribeGaapProxyGroupById(ctx context.Context, groupId s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeProxyGroupDetails(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -4263,7 +4262,7 @@ func (me *GaapService) DescribeGaapProxyGroupProxies(ctx context.Context, groupI
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -4279,7 +4278,7 @@ func (me *GaapService) DescribeGaapProxyGroupProxies(ctx context.Context, groupI
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ProxySet) < 1 {
 			break
@@ -4304,18 +4303,18 @@ func (me *GaapService) DeleteGaapProxyGroupById(ctx context.Context, groupId str
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DeleteProxyGroup(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4335,18 +4334,18 @@ func (me *GaapService) DescribeGaapGlobalDomainById(ctx context.Context, domainI
 	request.Limit = helper.IntUint64(10)
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeGlobalDomains(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.Domains) < 1 {
 		return
@@ -4378,18 +4377,18 @@ func (me *GaapService) DisableGlobalDomain(ctx context.Context, domainId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DisableGlobalDomain(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4402,18 +4401,18 @@ func (me *GaapService) EnableGlobalDomain(ctx context.Context, domainId string)
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().EnableGlobalDomain(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4426,18 +4425,18 @@ func (me *GaapService) DeleteGaapGlobalDomainById(ctx context.Context, domainId
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DeleteGlobalDomain(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4450,18 +4449,18 @@ func (me *GaapService) DescribeGaapGlobalDomainDnsById(ctx context.Context, doma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DescribeGlobalDomainDns(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.GlobalDnsList) < 1 {
 		return
@@ -4533,18 +4532,18 @@ func (me *GaapService) DeleteGaapGlobalDomainDnsById(ctx context.Context, dnsRec
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().DeleteGlobalDomainDns(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4562,18 +4561,18 @@ func (me *GaapService) ModifyDomain(ctx context.Context, listenerId, oldDomain,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().ModifyDomain(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -4584,25 +4583,25 @@ func (me *GaapService) SwitchProxyGroup(ctx context.Context, groupId, status str
 	if status == "open" {
 		request := gaap.NewOpenProxyGroupRequest()
 		request.GroupId = helper.String(groupId)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().OpenProxyGroup(request)
 		if err != nil {
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	} else if status == "close" {
 		request := gaap.NewCloseProxyGroupRequest()
 		request.GroupId = helper.String(groupId)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 
 		response, err := me.client.UseGaapClient().CloseProxyGroup(request)
 		if err != nil {
 			errRet = err
 			return
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	}
 
 	return
@@ -4618,18 +4617,18 @@ func (me *GaapService) SetTlsVersion(ctx context.Context, listenerId, tlsCiphers
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseGaapClient().SetTlsVersion(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
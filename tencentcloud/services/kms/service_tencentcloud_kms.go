@@ -18,7 +18,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewKmsService(client *connectivity.TencentCloudClient) KmsService {
@@ -72,14 +71,14 @@ func (me *KmsService) DescribeKeysByFilter(ctx context.Context, param map[string
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseKmsClient().ListKeyDetail(request)
 		if err != nil {
 			errRet = errors.WithStack(err)
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		if response == nil || len(response.Response.KeyMetadatas) < 1 {
 			break
 		}
@@ -98,7 +97,7 @@ func (me *KmsService) DescribeKeyById(ctx context.Context, keyId string) (key *k
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewDescribeKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeKey(request)
 	if err != nil {
@@ -106,7 +105,7 @@ func (me *KmsService) DescribeKeyById(ctx context.Context, keyId string) (key *k
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	key = response.Response.KeyMetadata
 	return
@@ -126,7 +125,7 @@ func (me *KmsService) CreateKey(ctx context.Context, keyType uint64, alias, desc
 	if hsmClusterId != "" {
 		request.HsmClusterId = helper.String(hsmClusterId)
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CreateKey(request)
 	if err != nil {
@@ -140,7 +139,7 @@ func (me *KmsService) CreateKey(ctx context.Context, keyType uint64, alias, desc
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.KeyId == nil {
 		errRet = fmt.Errorf("KeyId is nil.")
@@ -155,7 +154,7 @@ func (me *KmsService) EnableKeyRotation(ctx context.Context, keyId string) (errR
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewEnableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().EnableKeyRotation(request)
 	if err != nil {
@@ -163,7 +162,7 @@ func (me *KmsService) EnableKeyRotation(ctx context.Context, keyId string) (errR
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -172,7 +171,7 @@ func (me *KmsService) DisableKeyRotation(ctx context.Context, keyId string) (err
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewDisableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DisableKeyRotation(request)
 	if err != nil {
@@ -180,7 +179,7 @@ func (me *KmsService) DisableKeyRotation(ctx context.Context, keyId string) (err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -189,7 +188,7 @@ func (me *KmsService) EnableKey(ctx context.Context, keyId string) (errRet error
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewEnableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().EnableKey(request)
 	if err != nil {
@@ -197,7 +196,7 @@ func (me *KmsService) EnableKey(ctx context.Context, keyId string) (errRet error
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -206,7 +205,7 @@ func (me *KmsService) DisableKey(ctx context.Context, keyId string) (errRet erro
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewDisableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DisableKey(request)
 	if err != nil {
@@ -214,7 +213,7 @@ func (me *KmsService) DisableKey(ctx context.Context, keyId string) (errRet erro
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -223,7 +222,7 @@ func (me *KmsService) ArchiveKey(ctx context.Context, keyId string) (errRet erro
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewArchiveKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().ArchiveKey(request)
 	if err != nil {
@@ -231,7 +230,7 @@ func (me *KmsService) ArchiveKey(ctx context.Context, keyId string) (errRet erro
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -240,7 +239,7 @@ func (me *KmsService) CancelKeyArchive(ctx context.Context, keyId string) (errRe
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewCancelKeyArchiveRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CancelKeyArchive(request)
 	if err != nil {
@@ -248,7 +247,7 @@ func (me *KmsService) CancelKeyArchive(ctx context.Context, keyId string) (errRe
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -256,7 +255,7 @@ func (me *KmsService) CancelKeyDeletion(ctx context.Context, keyId string) (errR
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewCancelKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().CancelKeyDeletion(request)
 	if err != nil {
@@ -264,7 +263,7 @@ func (me *KmsService) CancelKeyDeletion(ctx context.Context, keyId string) (errR
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -274,7 +273,7 @@ func (me *KmsService) UpdateKeyDescription(ctx context.Context, keyId, descripti
 	request := kms.NewUpdateKeyDescriptionRequest()
 	request.KeyId = helper.String(keyId)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().UpdateKeyDescription(request)
 	if err != nil {
@@ -282,7 +281,7 @@ func (me *KmsService) UpdateKeyDescription(ctx context.Context, keyId, descripti
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -292,7 +291,7 @@ func (me *KmsService) UpdateKeyAlias(ctx context.Context, keyId, alias string) (
 	request := kms.NewUpdateAliasRequest()
 	request.KeyId = helper.String(keyId)
 	request.Alias = helper.String(alias)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().UpdateAlias(request)
 	if err != nil {
@@ -300,7 +299,7 @@ func (me *KmsService) UpdateKeyAlias(ctx context.Context, keyId, alias string) (
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -310,7 +309,7 @@ func (me *KmsService) DeleteKey(ctx context.Context, keyId string, pendingDelete
 	request := kms.NewScheduleKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
 	request.PendingWindowInDays = helper.Uint64(pendingDeleteWindowInDays)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().ScheduleKeyDeletion(request)
 	if err != nil {
@@ -318,7 +317,7 @@ func (me *KmsService) DeleteKey(ctx context.Context, keyId string, pendingDelete
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -350,7 +349,7 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	paramRequest.KeyId = helper.String(keyId)
 	paramRequest.WrappingAlgorithm = helper.String(wrappingAlgorithm)
 	paramRequest.WrappingKeySpec = helper.String(wrappingKeySpec)
-	ratelimit.Check(paramRequest.GetAction())
+	me.client.RateLimiter.Check(paramRequest.GetAction())
 
 	paramResponse, err := me.client.UseKmsClient().GetParametersForImport(paramRequest)
 	if err != nil {
@@ -358,7 +357,7 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, paramRequest.GetAction(), paramRequest.ToJsonString(), paramResponse.ToJsonString())
+		logId, paramRequest.GetAction(), connectivity.RedactJSON(paramRequest.ToJsonString()), connectivity.RedactJSON(paramResponse.ToJsonString()))
 
 	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
 	if err != nil {
@@ -399,7 +398,7 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	importRequest.ValidTo = helper.Uint64(validTo)
 	importRequest.ImportToken = paramResponse.Response.ImportToken
 	importRequest.EncryptedKeyMaterial = helper.String(encryptedKeyMaterial)
-	ratelimit.Check(importRequest.GetAction())
+	me.client.RateLimiter.Check(importRequest.GetAction())
 
 	importResponse, err := me.client.UseKmsClient().ImportKeyMaterial(importRequest)
 	if err != nil {
@@ -407,7 +406,7 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, importRequest.GetAction(), importRequest.ToJsonString(), importResponse.ToJsonString())
+		logId, importRequest.GetAction(), connectivity.RedactJSON(importRequest.ToJsonString()), connectivity.RedactJSON(importResponse.ToJsonString()))
 
 	return nil
 }
@@ -416,7 +415,7 @@ func (me *KmsService) DeleteImportKeyMaterial(ctx context.Context, keyId string)
 	logId := tccommon.GetLogId(ctx)
 	request := kms.NewDeleteImportedKeyMaterialRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DeleteImportedKeyMaterial(request)
 	if err != nil {
@@ -424,7 +423,7 @@ func (me *KmsService) DeleteImportKeyMaterial(ctx context.Context, keyId string)
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return nil
 }
@@ -437,7 +436,7 @@ func (me *KmsService) DescribeKmsPublicKeyByFilter(ctx context.Context, param ma
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -447,7 +446,7 @@ func (me *KmsService) DescribeKmsPublicKeyByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().GetPublicKey(request)
 	if err != nil {
@@ -455,7 +454,7 @@ func (me *KmsService) DescribeKmsPublicKeyByFilter(ctx context.Context, param ma
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -473,7 +472,7 @@ func (me *KmsService) DescribeKmsGetParametersForImportByFilter(ctx context.Cont
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -491,7 +490,7 @@ func (me *KmsService) DescribeKmsGetParametersForImportByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().GetParametersForImport(request)
 	if err != nil {
@@ -499,7 +498,7 @@ func (me *KmsService) DescribeKmsGetParametersForImportByFilter(ctx context.Cont
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -517,7 +516,7 @@ func (me *KmsService) DescribeKmsKeyListsByFilter(ctx context.Context, param map
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -527,7 +526,7 @@ func (me *KmsService) DescribeKmsKeyListsByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeKeys(request)
 	if err != nil {
@@ -535,7 +534,7 @@ func (me *KmsService) DescribeKmsKeyListsByFilter(ctx context.Context, param map
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || len(response.Response.KeyMetadatas) < 1 {
 		return
@@ -554,7 +553,7 @@ func (me *KmsService) DescribeKmsWhiteBoxKeyDetailsByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -564,7 +563,7 @@ func (me *KmsService) DescribeKmsWhiteBoxKeyDetailsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -579,7 +578,7 @@ func (me *KmsService) DescribeKmsWhiteBoxKeyDetailsByFilter(ctx context.Context,
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.KeyInfos) < 1 {
 			break
@@ -604,7 +603,7 @@ func (me *KmsService) DescribeKmsListKeysByFilter(ctx context.Context, param map
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -618,7 +617,7 @@ func (me *KmsService) DescribeKmsListKeysByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	var (
 		offset uint64 = 0
@@ -633,7 +632,7 @@ func (me *KmsService) DescribeKmsListKeysByFilter(ctx context.Context, param map
 			return
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.Keys) < 1 {
 			break
@@ -658,11 +657,11 @@ func (me *KmsService) DescribeKmsWhiteBoxKeyById(ctx context.Context, keyId stri
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeWhiteBoxKey(request)
 	if err != nil {
@@ -670,7 +669,7 @@ func (me *KmsService) DescribeKmsWhiteBoxKeyById(ctx context.Context, keyId stri
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response.Response.KeyInfo == nil {
 		return
@@ -688,11 +687,11 @@ func (me *KmsService) DeleteKmsWhiteBoxKeyById(ctx context.Context, keyId string
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DeleteWhiteBoxKey(request)
 	if err != nil {
@@ -700,7 +699,7 @@ func (me *KmsService) DeleteKmsWhiteBoxKeyById(ctx context.Context, keyId string
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -713,11 +712,11 @@ func (me *KmsService) DescribeKmsCloudResourceAttachmentById(ctx context.Context
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeKey(request)
 	if err != nil {
@@ -725,7 +724,7 @@ func (me *KmsService) DescribeKmsCloudResourceAttachmentById(ctx context.Context
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -745,11 +744,11 @@ func (me *KmsService) DeleteKmsCloudResourceAttachmentById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().UnbindCloudResource(request)
 	if err != nil {
@@ -757,7 +756,7 @@ func (me *KmsService) DeleteKmsCloudResourceAttachmentById(ctx context.Context,
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -770,7 +769,7 @@ func (me *KmsService) DescribeKmsWhiteBoxDecryptKeyByFilter(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -780,14 +779,14 @@ func (me *KmsService) DescribeKmsWhiteBoxDecryptKeyByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeWhiteBoxDecryptKey(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -805,7 +804,7 @@ func (me *KmsService) DescribeKmsWhiteBoxDeviceFingerprintsByFilter(ctx context.
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -815,7 +814,7 @@ func (me *KmsService) DescribeKmsWhiteBoxDeviceFingerprintsByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().DescribeWhiteBoxDeviceFingerprints(request)
 	if err != nil {
@@ -823,7 +822,7 @@ func (me *KmsService) DescribeKmsWhiteBoxDeviceFingerprintsByFilter(ctx context.
 		return
 	}
 
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -841,18 +840,18 @@ func (me *KmsService) DescribeKmsListAlgorithmsByFilter(ctx context.Context) (li
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseKmsClient().ListAlgorithms(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil {
 		return
@@ -870,17 +869,17 @@ func (me *KmsService) DescribeKmsServiceStatusByFilter(ctx context.Context, para
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseKmsClient().GetServiceStatus(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if response == nil || response.Response == nil {
 		return
@@ -10,8 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudMongodbDbInstanceNodeProperty() *schema.Resource {
@@ -300,18 +300,18 @@ func dataSourceTencentCloudMongodbDbInstanceNodePropertyRead(d *schema.ResourceD
 
 	var response *mongodb.DescribeDBInstanceNodePropertyResponse
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := client.UseMongodbClient().DescribeDBInstanceNodeProperty(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("db_instance_node_property: DescribeDBInstanceNodeProperty returned empty response"))
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
-			logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
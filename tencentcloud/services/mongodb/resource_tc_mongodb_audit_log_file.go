@@ -11,8 +11,8 @@ import (
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMongodbAuditLogFile() *schema.Resource {
@@ -240,13 +240,13 @@ func resourceTencentCloudMongodbAuditLogFileCreate(d *schema.ResourceData, meta
 	}
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMongodbClient().CreateAuditLogFile(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("Create mongodb audit log file failed, Response is nil"))
 		}
@@ -275,13 +275,13 @@ func resourceTencentCloudMongodbAuditLogFileCreate(d *schema.ResourceData, meta
 	describeRequest.Offset = helper.Uint64(uint64(0))
 
 	waitErr := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(describeRequest.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMongodbClient().DescribeAuditLogFiles(describeRequest)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, describeRequest.GetAction(), describeRequest.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil || len(result.Response.Items) == 0 {
 			return resource.RetryableError(fmt.Errorf("mongodb audit log file [%s] is still creating, items is empty", fileName))
 		}
@@ -340,13 +340,13 @@ func resourceTencentCloudMongodbAuditLogFileRead(d *schema.ResourceData, meta in
 	request.Offset = helper.Uint64(uint64(0))
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMongodbClient().DescribeAuditLogFiles(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("Describe mongodb audit log files failed, Response is nil"))
 		}
@@ -441,13 +441,13 @@ func resourceTencentCloudMongodbAuditLogFileDelete(d *schema.ResourceData, meta
 	request.FileName = helper.String(fileName)
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMongodbClient().DeleteAuditLogFile(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -464,13 +464,13 @@ func resourceTencentCloudMongodbAuditLogFileDelete(d *schema.ResourceData, meta
 	describeRequest.Offset = helper.Uint64(uint64(0))
 
 	waitErr := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(describeRequest.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMongodbClient().DescribeAuditLogFiles(describeRequest)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, describeRequest.GetAction(), describeRequest.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		if result == nil || result.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("Describe mongodb audit log file filed, Response is nil"))
 		}
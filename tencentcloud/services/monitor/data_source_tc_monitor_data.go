@@ -11,7 +11,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudMonitorData() *schema.Resource {
@@ -120,7 +119,7 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().GetMonitorData(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
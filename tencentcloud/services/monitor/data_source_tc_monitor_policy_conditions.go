@@ -10,7 +10,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudMonitorPolicyConditions() *schema.Resource {
@@ -249,7 +248,7 @@ func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta in
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().DescribePolicyConditionList(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -11,7 +11,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudMonitorPolicyGroups() *schema.Resource {
@@ -331,7 +330,7 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 			break
 		}
 		if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupList(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -13,7 +13,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudMonitorProductEvent() *schema.Resource {
@@ -389,7 +388,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 		}
 
 		if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if response, err = monitorService.client.UseMonitorClient().DescribeProductEventList(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -10,7 +10,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMonitorAlarmNotice() *schema.Resource {
@@ -363,7 +362,7 @@ func resourceTencentMonitorAlarmNoticeCreate(d *schema.ResourceData, meta interf
 
 	var noticeId *string
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreateAlarmNotice(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -628,7 +627,7 @@ func resourceTencentMonitorAlarmNoticeUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		_, err := monitorService.client.UseMonitorClient().ModifyAlarmNotice(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
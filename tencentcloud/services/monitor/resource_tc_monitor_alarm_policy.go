@@ -14,8 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func AlarmPolicyRule() map[string]*schema.Schema {
@@ -550,15 +550,15 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 	var groupId *string
 	var policyId *string
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreateAlarmPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		policyId = response.Response.PolicyId
 		groupId = response.Response.OriginId
@@ -588,15 +588,15 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		request.Tag = tagSet[0]
 
 		if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			response, err := monitorService.client.UseMonitorClient().BindingPolicyTag(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), err.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			return nil
 		}); err != nil {
 			return err
@@ -624,7 +624,7 @@ func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interfac
 	request.Module = helper.String("monitor")
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().DescribeAlarmPolicy(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -845,7 +845,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -864,7 +864,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -883,7 +883,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Enable = helper.IntInt64(enable)
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyStatus(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -1035,7 +1035,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyCondition(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -1089,7 +1089,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyNotice(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -1115,7 +1115,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			request.TriggerTasks = tasks
 		}
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyTasks(request); err != nil {
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
@@ -1139,7 +1139,7 @@ func resourceTencentMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interf
 	request.PolicyIds = policyIds
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err := monitorService.client.UseMonitorClient().DeleteAlarmPolicy(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
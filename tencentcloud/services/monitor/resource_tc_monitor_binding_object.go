@@ -13,7 +13,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMonitorBindingObject() *schema.Resource {
@@ -107,7 +106,7 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -259,7 +258,7 @@ func resourceTencentMonitorBindingObjectDelete(d *schema.ResourceData, meta inte
 	request.UniqueId = uniqueIds
 
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObject(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
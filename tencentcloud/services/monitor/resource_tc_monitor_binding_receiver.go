@@ -12,7 +12,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
@@ -144,7 +143,7 @@ func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -250,7 +249,7 @@ func resourceTencentMonitorBindingAlarmReceiverUpdate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -286,7 +285,7 @@ func resourceTencentMonitorBindingAlarmReceiverDelete(d *schema.ResourceData, me
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
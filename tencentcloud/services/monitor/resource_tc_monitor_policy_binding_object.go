@@ -14,7 +14,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMonitorPolicyBindingObject() *schema.Resource {
@@ -122,7 +121,7 @@ func resourceTencentMonitorPolicyBindingObjectCreate(d *schema.ResourceData, met
 
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -238,7 +237,7 @@ func resourceTencentMonitorPolicyBindingObjectDelete(d *schema.ResourceData, met
 
 		region := MonitorRegionMapName[*regionInfo.Region]
 		if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			if _, e := monitorService.client.UseMonitorClientRegion(region).UnBindingAllPolicyObject(request); e != nil {
 				return tccommon.RetryError(e, tccommon.InternalError)
 			}
@@ -12,7 +12,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudMonitorPolicyGroup() *schema.Resource {
@@ -330,7 +329,7 @@ func resourceTencentMonitorPolicyGroupCreate(d *schema.ResourceData, meta interf
 
 	var groupId *int64
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreatePolicyGroup(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -376,7 +375,7 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupInfo(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -586,7 +585,7 @@ func resourceTencentMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		_, err := monitorService.client.UseMonitorClient().ModifyPolicyGroup(request)
 		if err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -615,7 +614,7 @@ func resourceTencentMonitorPolicyGroupDelete(d *schema.ResourceData, meta interf
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().DeletePolicyGroup(request); err != nil {
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
@@ -55,3 +55,12 @@ var SYNC_MODE = []string{
 	SYNC_MODE_SEMI,
 	SYNC_MODE_ASYNC,
 }
+
+const (
+	POSTGRESQL_PASSWORD_DEFAULT_LENGTH = 16
+	POSTGRESQL_PASSWORD_MIN_LENGTH     = 8
+	POSTGRESQL_PASSWORD_MAX_LENGTH     = 32
+	// POSTGRESQL_PASSWORD_SYMBOLS are the symbols of the generated passwords, the symbols of
+	// TencentDB for PostgreSQL passwords except the quotes and the slash a password can't start with
+	POSTGRESQL_PASSWORD_SYMBOLS = "()~!@#$%^&*-+=_|{}[]:;<>,.?"
+)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -36,10 +37,25 @@ func ResourceTencentCloudPostgresqlAccount() *schema.Resource {
 				Description: "Instance username, which can contain 1-16 letters, digits, and underscore (_); can&amp;amp;#39;t be postgres; can&amp;amp;#39;t start with numbers, pg_, and tencentdb_.",
 			},
 			"password": {
-				Required:    true,
-				Type:        schema.TypeString,
-				Sensitive:   true,
-				Description: "Password, which can contain 8-32 letters, digits, and symbols (()`~!@#$%^&amp;amp;amp;*-+=_|{}[]:;&amp;amp;#39;&amp;amp;lt;&amp;amp;gt;,.?/); can&amp;amp;#39;t start with slash /.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Password, which can contain 8-32 letters, digits, and symbols (()`~!@#$%^&amp;amp;amp;*-+=_|{}[]:;&amp;amp;#39;&amp;amp;lt;&amp;amp;gt;,.?/); can&amp;amp;#39;t start with slash /. Exactly one of `password` and `password_wo` must be set.",
+			},
+			"password_wo": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Write-only password, which is never persisted in state. It follows the same rules as `password` and can be generated by the `tencentcloud_postgresql_password` ephemeral resource.",
+			},
+			"password_wo_version": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. As write-only values are not stored, change this value to reset the password to the current `password_wo`.",
 			},
 			"type": {
 				Required:     true,
@@ -84,8 +100,8 @@ func resourceTencentCloudPostgresqlAccountCreate(d *schema.ResourceData, meta in
 		request.UserName = helper.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		request.Password = helper.String(v.(string))
+	if v := helper.GetStringWithWriteOnly(d, "password", "password_wo"); v != "" {
+		request.Password = helper.String(v)
 	}
 
 	if v, ok := d.GetOk("type"); ok {
@@ -101,7 +117,7 @@ func resourceTencentCloudPostgresqlAccountCreate(d *schema.ResourceData, meta in
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -126,7 +142,7 @@ func resourceTencentCloudPostgresqlAccountCreate(d *schema.ResourceData, meta in
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, lockRequest.GetAction(), lockRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, lockRequest.GetAction(), connectivity.RedactJSON(lockRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -216,16 +232,16 @@ func resourceTencentCloudPostgresqlAccountUpdate(d *schema.ResourceData, meta in
 	dBInstanceId := idSplit[0]
 	userName := idSplit[1]
 
-	if d.HasChange("password") {
+	if d.HasChanges("password", "password_wo_version") {
 		pwdRequest.DBInstanceId = &dBInstanceId
 		pwdRequest.UserName = &userName
-		pwdRequest.Password = helper.String(d.Get("password").(string))
+		pwdRequest.Password = helper.String(helper.GetStringWithWriteOnly(d, "password", "password_wo"))
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlClient().ResetAccountPassword(pwdRequest)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pwdRequest.GetAction(), pwdRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pwdRequest.GetAction(), connectivity.RedactJSON(pwdRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -246,7 +262,7 @@ func resourceTencentCloudPostgresqlAccountUpdate(d *schema.ResourceData, meta in
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, remarkRequest.GetAction(), remarkRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, remarkRequest.GetAction(), connectivity.RedactJSON(remarkRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -270,7 +286,7 @@ func resourceTencentCloudPostgresqlAccountUpdate(d *schema.ResourceData, meta in
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, lockRequest.GetAction(), lockRequest.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, lockRequest.GetAction(), connectivity.RedactJSON(lockRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -289,7 +305,7 @@ func resourceTencentCloudPostgresqlAccountUpdate(d *schema.ResourceData, meta in
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, unlockRequest.GetAction(), unlockRequest.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, unlockRequest.GetAction(), connectivity.RedactJSON(unlockRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -970,7 +970,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 	// id format is [namespace]+[function name], so that we can support import with enough info
 	d.SetId(fmt.Sprintf("%s+%s", *functionInfo.namespace, functionInfo.name))
 
-	err := waitScfFunctionReady(ctx, functionInfo.name, *functionInfo.namespace, client.UseScfClient())
+	err := scfService.waitScfFunctionReady(ctx, functionInfo.name, *functionInfo.namespace, client.UseScfClient())
 	if err != nil {
 		return err
 	}
@@ -1750,7 +1750,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		resp, err := scfService.DescribeFunction(ctx, functionInfo.name, *functionInfo.namespace)
 		if err != nil {
 			log.Printf("[CRITAL]%s get function id failed: %+v", logId, err)
@@ -1760,7 +1760,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		fnNamespace := *resp.Response.Namespace
 		functionId := fmt.Sprintf("%s/function/%s", fnNamespace, fnName)
 
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := tccommon.BuildTagResourceName(SCF_SERVICE, SCF_FUNCTION_RESOURCE_PREFIX, region, functionId)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -86,7 +87,7 @@ func resourceTencentCloudScfFunctionVersionCreate(d *schema.ResourceData, meta i
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
@@ -102,7 +103,8 @@ func resourceTencentCloudScfFunctionVersionCreate(d *schema.ResourceData, meta i
 	// wait ready
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
-	err = waitScfFunctionReady(ctx, functionName, namespace, client.UseScfClient())
+	scfService := ScfService{client: client}
+	err = scfService.waitScfFunctionReady(ctx, functionName, namespace, client.UseScfClient())
 	if err != nil {
 		return err
 	}
@@ -16,8 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func LayerContent() map[string]*schema.Schema {
@@ -192,15 +192,15 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := scfService.client.UseScfClient().PublishLayerVersion(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		layerVersion = response.Response.LayerVersion
 		return nil
@@ -240,16 +240,16 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 	layerRequest.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(layerRequest.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(layerRequest.GetAction())
 		response, err := scfService.client.UseScfClient().GetLayerVersion(layerRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, layerRequest.GetAction(), layerRequest.ToJsonString(), err.Error())
+				logId, layerRequest.GetAction(), connectivity.RedactJSON(layerRequest.ToJsonString()), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, layerRequest.GetAction(), layerRequest.ToJsonString(), response.ToJsonString())
+			logId, layerRequest.GetAction(), connectivity.RedactJSON(layerRequest.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		layerResponse = *response
 		return nil
 	}); err != nil {
@@ -303,10 +303,10 @@ func resourceTencentCloudScfLayerDelete(d *schema.ResourceData, meta interface{}
 	request.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		if _, err := scfService.client.UseScfClient().DeleteLayerVersion(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 		return nil
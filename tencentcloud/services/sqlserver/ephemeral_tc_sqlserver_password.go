@@ -0,0 +1,86 @@
+package sqlserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+var _ ephemeral.EphemeralResource = &SqlserverPasswordEphemeralResource{}
+
+// NewSqlserverPasswordEphemeralResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this ephemeral resource.
+func NewSqlserverPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &SqlserverPasswordEphemeralResource{}
+}
+
+// SqlserverPasswordEphemeralResource implements ephemeral.EphemeralResource for
+// tencentcloud_sqlserver_password. It generates a password locally, so it needs
+// no API client.
+type SqlserverPasswordEphemeralResource struct{}
+
+// SqlserverPasswordEphemeralResourceModel maps the schema attributes.
+type SqlserverPasswordEphemeralResourceModel struct {
+	Length   types.Int64  `tfsdk:"length"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (e *SqlserverPasswordEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "tencentcloud_sqlserver_password"
+}
+
+func (e *SqlserverPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a random password that satisfies the password rules of TencentDB for SQL Server accounts. " +
+			"The password is never persisted in state and is meant to be passed to the `password_wo` argument of `tencentcloud_sqlserver_account`.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Length of the password, which must be between %d and %d. Default is %d.", SQLSERVER_PASSWORD_MIN_LENGTH, SQLSERVER_PASSWORD_MAX_LENGTH, SQLSERVER_PASSWORD_DEFAULT_LENGTH),
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password. It contains uppercase and lowercase letters, numbers and symbols(" + SQLSERVER_PASSWORD_SYMBOLS + ").",
+			},
+		},
+	}
+}
+
+// Open is invoked once per plan/apply and generates a new password each time.
+func (e *SqlserverPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SqlserverPasswordEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(SQLSERVER_PASSWORD_DEFAULT_LENGTH)
+	if !data.Length.IsNull() {
+		length = data.Length.ValueInt64()
+	}
+	if length < SQLSERVER_PASSWORD_MIN_LENGTH || length > SQLSERVER_PASSWORD_MAX_LENGTH {
+		resp.Diagnostics.AddError(
+			"Invalid password length",
+			fmt.Sprintf("length must be between %d and %d, got %d", SQLSERVER_PASSWORD_MIN_LENGTH, SQLSERVER_PASSWORD_MAX_LENGTH, length),
+		)
+		return
+	}
+
+	password, err := helper.GeneratePassword(int(length), SQLSERVER_PASSWORD_SYMBOLS)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating password", err.Error())
+		return
+	}
+
+	data.Length = types.Int64Value(length)
+	data.Password = types.StringValue(password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package sqlserver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	svcsqlserver "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sqlserver"
+)
+
+func TestSqlserverPasswordEphemeralResource_Metadata(t *testing.T) {
+	e := svcsqlserver.NewSqlserverPasswordEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+
+	e.Metadata(t.Context(), ephemeral.MetadataRequest{}, resp)
+
+	if resp.TypeName != "tencentcloud_sqlserver_password" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_sqlserver_password")
+	}
+}
+
+func TestSqlserverPasswordEphemeralResource_Schema(t *testing.T) {
+	e := svcsqlserver.NewSqlserverPasswordEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+
+	e.Schema(t.Context(), ephemeral.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", resp.Diagnostics)
+	}
+	if attr, ok := resp.Schema.Attributes["length"].(schema.Int64Attribute); !ok || !attr.Optional {
+		t.Errorf("attribute length should be an Optional Int64Attribute")
+	}
+	if attr, ok := resp.Schema.Attributes["password"].(schema.StringAttribute); !ok || !attr.Computed || !attr.Sensitive {
+		t.Errorf("attribute password should be a Computed and Sensitive StringAttribute")
+	}
+}
+
+// openSqlserverPassword opens the ephemeral resource with length, a nil length
+// leaving it unset.
+func openSqlserverPassword(t *testing.T, length interface{}) (string, *ephemeral.OpenResponse) {
+	ctx := t.Context()
+	e := svcsqlserver.NewSqlserverPasswordEphemeralResource()
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"length":   tftypes.NewValue(tftypes.Number, length),
+				"password": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	e.Open(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return "", resp
+	}
+
+	var password string
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("password"), &password)...)
+	return password, resp
+}
+
+// TestSqlserverPasswordEphemeralResource_Open checks that every generated password
+// follows the password rules of TencentDB for SQL Server accounts.
+func TestSqlserverPasswordEphemeralResource_Open(t *testing.T) {
+	for _, length := range []interface{}{nil, svcsqlserver.SQLSERVER_PASSWORD_MIN_LENGTH, 16, svcsqlserver.SQLSERVER_PASSWORD_MAX_LENGTH} {
+		for i := 0; i < 20; i++ {
+			password, resp := openSqlserverPassword(t, length)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Open returned diagnostics: %v", resp.Diagnostics)
+			}
+
+			want := svcsqlserver.SQLSERVER_PASSWORD_DEFAULT_LENGTH
+			if length != nil {
+				want = length.(int)
+			}
+			if len(password) != want {
+				t.Errorf("len(password) = %d, want %d", len(password), want)
+			}
+
+			var lower, upper, number, symbol bool
+			for _, c := range password {
+				switch {
+				case c >= 'a' && c <= 'z':
+					lower = true
+				case c >= 'A' && c <= 'Z':
+					upper = true
+				case c >= '0' && c <= '9':
+					number = true
+				case strings.ContainsRune(svcsqlserver.SQLSERVER_PASSWORD_SYMBOLS, c):
+					symbol = true
+				default:
+					t.Errorf("generated password %q contains %q, which is not allowed", password, c)
+				}
+			}
+			if !lower || !upper || !number || !symbol {
+				t.Errorf("generated password %q should contain lowercase and uppercase letters, numbers and symbols", password)
+			}
+		}
+	}
+}
+
+func TestSqlserverPasswordEphemeralResource_OpenInvalidLength(t *testing.T) {
+	for _, length := range []int{svcsqlserver.SQLSERVER_PASSWORD_MIN_LENGTH - 1, svcsqlserver.SQLSERVER_PASSWORD_MAX_LENGTH + 1} {
+		if _, resp := openSqlserverPassword(t, length); !resp.Diagnostics.HasError() {
+			t.Errorf("expected an error for a password length of %d", length)
+		}
+	}
+}
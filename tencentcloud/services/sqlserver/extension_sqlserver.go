@@ -222,3 +222,11 @@ var SSL_TYPE = []string{
 	SSL_TYPE_DISABLE,
 	SSL_TYPE_RENEW,
 }
+
+const (
+	SQLSERVER_PASSWORD_DEFAULT_LENGTH = 16
+	SQLSERVER_PASSWORD_MIN_LENGTH     = 8
+	SQLSERVER_PASSWORD_MAX_LENGTH     = 32
+	// SQLSERVER_PASSWORD_SYMBOLS are the symbols of TencentDB for SQL Server passwords
+	SQLSERVER_PASSWORD_SYMBOLS = "_+-,&=!@#$%^*()|"
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,10 +34,25 @@ func ResourceTencentCloudSqlserverAccount() *schema.Resource {
 				Description: "Name of the SQL Server account.",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Password of the SQL Server account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Password of the SQL Server account. Exactly one of `password` and `password_wo` must be set.",
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Write-only password of the SQL Server account, which is never persisted in state. It can be generated by the `tencentcloud_sqlserver_password` ephemeral resource.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. As write-only values are not stored, change this value to reset the password to the current `password_wo`.",
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -86,7 +102,7 @@ func resourceTencentCloudSqlserverAccountCreate(d *schema.ResourceData, meta int
 
 	var (
 		name       = d.Get("name").(string)
-		password   = d.Get("password").(string)
+		password   = helper.GetStringWithWriteOnly(d, "password", "password_wo")
 		remark     = d.Get("remark").(string)
 		isAdmin    = d.Get("is_admin").(bool)
 		instanceId = d.Get("instance_id").(string)
@@ -155,8 +171,8 @@ func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta int
 	}
 
 	//update password
-	if d.HasChange("password") {
-		password := d.Get("password").(string)
+	if d.HasChanges("password", "password_wo_version") {
+		password := helper.GetStringWithWriteOnly(d, "password", "password_wo")
 		outErr = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			inErr = sqlserverService.ResetSqlserverAccountPassword(ctx, instanceId, name, password)
 			if inErr != nil {
@@ -352,8 +352,8 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldInterface, newInterface := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagClient := m.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tagClient)
@@ -14,7 +14,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SslService struct {
@@ -34,12 +33,12 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	createRequest.Key = key
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+		me.client.RateLimiter.Check(createRequest.GetAction())
 
 		response, err := client.UploadCert(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
+				logId, createRequest.GetAction(), connectivity.RedactJSON(createRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -61,12 +60,12 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	describeRequest.Id = &id
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
@@ -130,14 +129,14 @@ func (me *SslService) DescribeCertificates(ctx context.Context, id, name, certTy
 	count := 20
 	for count == 20 {
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 
 			response, err := me.client.UseSslClient().DescribeCertList(request)
 			if err != nil {
 				count = 0
 
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-					logId, request.GetAction(), request.ToJsonString(), err)
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err)
 				return tccommon.RetryError(err)
 			}
 
@@ -165,11 +164,11 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	deleteRequest.Id = &id
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+		me.client.RateLimiter.Check(deleteRequest.GetAction())
 
 		if _, err := client.DeleteCert(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
+				logId, deleteRequest.GetAction(), connectivity.RedactJSON(deleteRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 		return nil
@@ -183,12 +182,12 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	describeRequest.Id = &id
 
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+		me.client.RateLimiter.Check(describeRequest.GetAction())
 
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
+				logId, describeRequest.GetAction(), connectivity.RedactJSON(describeRequest.ToJsonString()), err)
 			return tccommon.RetryError(err)
 		}
 
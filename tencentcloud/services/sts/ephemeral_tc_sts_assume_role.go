@@ -0,0 +1,213 @@
+package sts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var _ ephemeral.EphemeralResource = &StsAssumeRoleEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &StsAssumeRoleEphemeralResource{}
+
+// NewStsAssumeRoleEphemeralResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this ephemeral resource.
+func NewStsAssumeRoleEphemeralResource() ephemeral.EphemeralResource {
+	return &StsAssumeRoleEphemeralResource{}
+}
+
+// StsAssumeRoleEphemeralResource implements ephemeral.EphemeralResource for
+// tencentcloud_sts_assume_role. Unlike tencentcloud_sts_assume_role_operation,
+// the temporary credentials are never written to state.
+type StsAssumeRoleEphemeralResource struct {
+	client StsService
+}
+
+// StsAssumeRoleEphemeralResourceModel maps the schema attributes.
+type StsAssumeRoleEphemeralResourceModel struct {
+	RoleArn         types.String `tfsdk:"role_arn"`
+	RoleSessionName types.String `tfsdk:"role_session_name"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	Policy          types.String `tfsdk:"policy"`
+	ExternalId      types.String `tfsdk:"external_id"`
+	Tags            types.Map    `tfsdk:"tags"`
+	SourceIdentity  types.String `tfsdk:"source_identity"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	TokenCode       types.String `tfsdk:"token_code"`
+	TmpSecretId     types.String `tfsdk:"tmp_secret_id"`
+	TmpSecretKey    types.String `tfsdk:"tmp_secret_key"`
+	Token           types.String `tfsdk:"token"`
+	ExpiredTime     types.Int64  `tfsdk:"expired_time"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func (e *StsAssumeRoleEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "tencentcloud_sts_assume_role"
+}
+
+func (e *StsAssumeRoleEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"role_arn": schema.StringAttribute{
+			Required:    true,
+			Description: "Resource description of the role, which can be obtained by clicking the role name in [Access Management](https://console.cloud.tencent.com/cam/role).",
+		},
+		"role_session_name": schema.StringAttribute{
+			Required:    true,
+			Description: "User-defined temporary session name. Length is between 2 and 128, can contain uppercase and lowercase characters, numbers, and special characters: =,.@_-.",
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Specifies the validity period of the temporary access credential in seconds. Default is 7200 seconds, maximum is 43200 seconds.",
+		},
+		"policy": schema.StringAttribute{
+			Optional:    true,
+			Description: "Policy description. The policy syntax refers to [CAM Policy Syntax](https://cloud.tencent.com/document/product/598/10603). The policy cannot contain the principal element.",
+		},
+		"external_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Role external ID, which can be obtained by clicking the role name in [Access Management](https://console.cloud.tencent.com/cam/role). Length is between 2 and 128.",
+		},
+		"tags": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Session tags. A maximum of 50 session tags can be passed.",
+		},
+		"source_identity": schema.StringAttribute{
+			Optional:    true,
+			Description: "Caller identity uin.",
+		},
+		"serial_number": schema.StringAttribute{
+			Optional:    true,
+			Description: "MFA serial number associated with the CAM user making the call. Format: qcs::cam:uin/${ownerUin}::mfa/${mfaType}. mfaType supports softToken.",
+		},
+		"token_code": schema.StringAttribute{
+			Optional:    true,
+			Description: "MFA authentication code.",
+		},
+	}
+	for k, v := range stsCredentialsAttributes() {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Assumes a CAM role and returns its temporary credentials. " +
+			"The credentials are requested at plan/apply time and are never persisted in state.",
+		Attributes: attributes,
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (e *StsAssumeRoleEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = NewStsService(meta.Client)
+}
+
+// Open is invoked once per plan/apply. It calls AssumeRole the same way the
+// provider assume_role block does and writes the credentials to resp.Result.
+func (e *StsAssumeRoleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StsAssumeRoleEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := sts.NewAssumeRoleRequest()
+	request.RoleArn = helper.String(data.RoleArn.ValueString())
+	request.RoleSessionName = helper.String(data.RoleSessionName.ValueString())
+	if !data.DurationSeconds.IsNull() {
+		request.DurationSeconds = helper.Int64Uint64(data.DurationSeconds.ValueInt64())
+	}
+	if v := data.Policy.ValueString(); v != "" {
+		request.Policy = helper.String(url.QueryEscape(v))
+	}
+	if v := data.ExternalId.ValueString(); v != "" {
+		request.ExternalId = helper.String(v)
+	}
+	if v := data.SourceIdentity.ValueString(); v != "" {
+		request.SourceIdentity = helper.String(v)
+	}
+	if v := data.SerialNumber.ValueString(); v != "" {
+		request.SerialNumber = helper.String(v)
+	}
+	if v := data.TokenCode.ValueString(); v != "" {
+		request.TokenCode = helper.String(v)
+	}
+
+	tags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for k, v := range tags {
+		request.Tags = append(request.Tags, &sts.Tag{Key: helper.String(k), Value: helper.String(v)})
+	}
+
+	response, err := e.client.AssumeRole(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error assuming CAM role",
+			fmt.Sprintf("Could not assume role %q: %v", data.RoleArn.ValueString(), err),
+		)
+		return
+	}
+
+	data.TmpSecretId = types.StringPointerValue(response.Credentials.TmpSecretId)
+	data.TmpSecretKey = types.StringPointerValue(response.Credentials.TmpSecretKey)
+	data.Token = types.StringPointerValue(response.Credentials.Token)
+	data.ExpiredTime = types.Int64PointerValue(response.ExpiredTime)
+	data.Expiration = types.StringPointerValue(response.Expiration)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// stsCredentialsAttributes returns the computed attributes shared by the STS
+// ephemeral resources.
+func stsCredentialsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tmp_secret_id": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Temporary certificate secret ID. Maximum length is 1024 bytes.",
+		},
+		"tmp_secret_key": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Temporary certificate secret key. Maximum length is 1024 bytes.",
+		},
+		"token": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Token. The token length is up to 4096 bytes.",
+		},
+		"expired_time": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Expiration time of the temporary access credential, returned as a Unix timestamp accurate to seconds.",
+		},
+		"expiration": schema.StringAttribute{
+			Computed:    true,
+			Description: "Expiration time of the temporary access credential in ISO8601 format UTC time.",
+		},
+	}
+}
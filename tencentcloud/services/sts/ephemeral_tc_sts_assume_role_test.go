@@ -0,0 +1,57 @@
+package sts_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	svcsts "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sts"
+)
+
+// TestStsAssumeRoleEphemeralResource_Metadata validates that
+// Metadata.TypeName is set to "tencentcloud_sts_assume_role".
+func TestStsAssumeRoleEphemeralResource_Metadata(t *testing.T) {
+	e := svcsts.NewStsAssumeRoleEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+
+	e.Metadata(t.Context(), ephemeral.MetadataRequest{}, resp)
+
+	if resp.TypeName != "tencentcloud_sts_assume_role" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_sts_assume_role")
+	}
+}
+
+// TestStsAssumeRoleEphemeralResource_Schema validates the required arguments and that the
+// credentials are computed and sensitive.
+func TestStsAssumeRoleEphemeralResource_Schema(t *testing.T) {
+	e := svcsts.NewStsAssumeRoleEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+
+	e.Schema(t.Context(), ephemeral.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"role_arn", "role_session_name"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing required attribute: %s", name)
+			continue
+		}
+		if !attr.IsRequired() {
+			t.Errorf("attribute %s should be Required", name)
+		}
+	}
+
+	for _, name := range []string{"tmp_secret_id", "tmp_secret_key", "token"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing credential attribute: %s", name)
+			continue
+		}
+		if !attr.IsComputed() || !attr.IsSensitive() {
+			t.Errorf("attribute %s should be Computed and Sensitive", name)
+		}
+	}
+}
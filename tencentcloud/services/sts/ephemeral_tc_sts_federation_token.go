@@ -0,0 +1,131 @@
+package sts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var _ ephemeral.EphemeralResource = &StsFederationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &StsFederationTokenEphemeralResource{}
+
+// NewStsFederationTokenEphemeralResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this ephemeral resource.
+func NewStsFederationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &StsFederationTokenEphemeralResource{}
+}
+
+// StsFederationTokenEphemeralResource implements ephemeral.EphemeralResource
+// for tencentcloud_sts_federation_token.
+type StsFederationTokenEphemeralResource struct {
+	client StsService
+}
+
+// StsFederationTokenEphemeralResourceModel maps the schema attributes.
+type StsFederationTokenEphemeralResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Policy          types.String `tfsdk:"policy"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	TmpSecretId     types.String `tfsdk:"tmp_secret_id"`
+	TmpSecretKey    types.String `tfsdk:"tmp_secret_key"`
+	Token           types.String `tfsdk:"token"`
+	ExpiredTime     types.Int64  `tfsdk:"expired_time"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func (e *StsFederationTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "tencentcloud_sts_federation_token"
+}
+
+func (e *StsFederationTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The customizable name of the caller, consisting of letters.",
+		},
+		"policy": schema.StringAttribute{
+			Required: true,
+			Description: "Policy description. The policy syntax refers to [CAM Policy Syntax](https://cloud.tencent.com/document/product/598/10603). " +
+				"The permissions of the credentials are the intersection of this policy and the permissions of the caller.",
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Specifies the validity period of the temporary access credential in seconds. Default is 1800 seconds, maximum is 7200 seconds for the root account and 129600 seconds for sub-accounts.",
+		},
+	}
+	for k, v := range stsCredentialsAttributes() {
+		attributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Returns temporary credentials of a federated user. " +
+			"The credentials are requested at plan/apply time and are never persisted in state.",
+		Attributes: attributes,
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (e *StsFederationTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = NewStsService(meta.Client)
+}
+
+// Open is invoked once per plan/apply. It calls GetFederationToken and writes
+// the credentials to resp.Result.
+func (e *StsFederationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StsFederationTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := sts.NewGetFederationTokenRequest()
+	request.Name = helper.String(data.Name.ValueString())
+	request.Policy = helper.String(url.QueryEscape(data.Policy.ValueString()))
+	if !data.DurationSeconds.IsNull() {
+		request.DurationSeconds = helper.Int64Uint64(data.DurationSeconds.ValueInt64())
+	}
+
+	response, err := e.client.GetFederationToken(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting federation token",
+			fmt.Sprintf("Could not get a federation token for %q: %v", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.TmpSecretId = types.StringPointerValue(response.Credentials.TmpSecretId)
+	data.TmpSecretKey = types.StringPointerValue(response.Credentials.TmpSecretKey)
+	data.Token = types.StringPointerValue(response.Credentials.Token)
+	data.ExpiredTime = types.Int64Null()
+	if response.ExpiredTime != nil {
+		data.ExpiredTime = types.Int64Value(int64(*response.ExpiredTime))
+	}
+	data.Expiration = types.StringPointerValue(response.Expiration)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
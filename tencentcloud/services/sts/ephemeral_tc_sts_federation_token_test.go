@@ -0,0 +1,57 @@
+package sts_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	svcsts "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sts"
+)
+
+// TestStsFederationTokenEphemeralResource_Metadata validates that
+// Metadata.TypeName is set to "tencentcloud_sts_federation_token".
+func TestStsFederationTokenEphemeralResource_Metadata(t *testing.T) {
+	e := svcsts.NewStsFederationTokenEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+
+	e.Metadata(t.Context(), ephemeral.MetadataRequest{}, resp)
+
+	if resp.TypeName != "tencentcloud_sts_federation_token" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_sts_federation_token")
+	}
+}
+
+// TestStsFederationTokenEphemeralResource_Schema validates the required arguments and that the
+// credentials are computed and sensitive.
+func TestStsFederationTokenEphemeralResource_Schema(t *testing.T) {
+	e := svcsts.NewStsFederationTokenEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+
+	e.Schema(t.Context(), ephemeral.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"name", "policy"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing required attribute: %s", name)
+			continue
+		}
+		if !attr.IsRequired() {
+			t.Errorf("attribute %s should be Required", name)
+		}
+	}
+
+	for _, name := range []string{"tmp_secret_id", "tmp_secret_key", "token"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing credential attribute: %s", name)
+			continue
+		}
+		if !attr.IsComputed() || !attr.IsSensitive() {
+			t.Errorf("attribute %s should be Computed and Sensitive", name)
+		}
+	}
+}
@@ -2,14 +2,16 @@ package sts
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
 	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type StsService struct {
@@ -25,20 +27,90 @@ func (me *StsService) DescribeStsCallerIdentityByFilter(ctx context.Context) (ca
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseStsClient().GetCallerIdentity(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 		errRet = err
 		return
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 	callerIdentity = response.Response
 	return
 }
+
+func NewStsService(client *connectivity.TencentCloudClient) StsService {
+	return StsService{client: client}
+}
+
+// AssumeRole requests the temporary credentials of a role. It is shared by the
+// provider assume_role block and the tencentcloud_sts_assume_role ephemeral resource.
+func (me *StsService) AssumeRole(ctx context.Context, request *sts.AssumeRoleRequest) (response *sts.AssumeRoleResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		me.client.RateLimiter.Check(request.GetAction())
+		result, e := me.client.UseStsClient().AssumeRoleWithContext(ctx, request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), e.Error())
+			return tccommon.RetryError(e)
+		}
+
+		if result == nil || result.Response == nil || result.Response.Credentials == nil {
+			return resource.NonRetryableError(fmt.Errorf("Get Assume Role failed, Response is nil."))
+		}
+
+		response = result.Response
+		return nil
+	})
+	if errRet != nil {
+		return nil, errRet
+	}
+
+	if !validCredentials(response.Credentials) {
+		return nil, fmt.Errorf("Get Assume Role failed, Credentials is nil.")
+	}
+
+	return
+}
+
+// GetFederationToken requests temporary credentials of a federated user. It backs
+// the tencentcloud_sts_federation_token ephemeral resource.
+func (me *StsService) GetFederationToken(ctx context.Context, request *sts.GetFederationTokenRequest) (response *sts.GetFederationTokenResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		me.client.RateLimiter.Check(request.GetAction())
+		result, e := me.client.UseStsClient().GetFederationTokenWithContext(ctx, request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), e.Error())
+			return tccommon.RetryError(e)
+		}
+
+		if result == nil || result.Response == nil || result.Response.Credentials == nil {
+			return resource.NonRetryableError(fmt.Errorf("Get Federation Token failed, Response is nil."))
+		}
+
+		response = result.Response
+		return nil
+	})
+	if errRet != nil {
+		return nil, errRet
+	}
+
+	if !validCredentials(response.Credentials) {
+		return nil, fmt.Errorf("Get Federation Token failed, Credentials is nil.")
+	}
+
+	return
+}
+
+func validCredentials(credentials *sts.Credentials) bool {
+	return credentials.TmpSecretId != nil && credentials.TmpSecretKey != nil && credentials.Token != nil
+}
@@ -0,0 +1,294 @@
+package tag
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+const (
+	TagsKey    = "tags"
+	TagsAllKey = "tags_all"
+)
+
+// immutableTagsResources are the taggable resources whose Update never modifies the tags of
+// the cloud resource, they only get `tags_all` planned on creation, like the resources
+// without an Update function.
+var immutableTagsResources = map[string]bool{
+	"tencentcloud_tcmq_subscribe":          true,
+	"tencentcloud_vpc_private_nat_gateway": true,
+}
+
+// ApplyDefaultTags adds a computed `tags_all` attribute to every resource that exposes a
+// configurable top-level `tags` map, and wraps its CustomizeDiff and CRUD functions so that
+// the provider `default_tags` are merged into the tags sent to the cloud API.
+//
+// The merge itself happens at plan time: `tags_all` is planned as the provider default tags
+// overlaid by the resource tags, and helper.GetTags / helper.GetTagsChange read the planned
+// `tags_all` back so that the existing Create/Update code paths (DiffTags + ModifyTags) pick
+// up the default tags without any per-resource change. Create also sees the merged tags in
+// `tags`, for the resources which build their request from d.Get("tags"). Read stores the
+// tags of the cloud resource in `tags_all` as they are, so that default tags missing on the
+// cloud show up in the plan.
+func ApplyDefaultTags(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		tagsSchema, ok := r.Schema[TagsKey]
+		if !ok || tagsSchema.Type != schema.TypeMap || !(tagsSchema.Optional || tagsSchema.Required) {
+			continue
+		}
+
+		if _, ok := r.Schema[TagsAllKey]; ok {
+			continue
+		}
+
+		r.Schema[TagsAllKey] = &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of tags assigned to the resource, including those inherited from the provider `default_tags` block.",
+		}
+
+		updatable := (r.Update != nil || r.UpdateContext != nil || r.UpdateWithoutTimeout != nil) && !immutableTagsResources[name]
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, customizeDiffTagsAll(updatable))
+		} else {
+			r.CustomizeDiff = customizeDiffTagsAll(updatable)
+		}
+
+		wrapTagsAllFuncs(r)
+	}
+}
+
+// customizeDiffTagsAll plans `tags_all` as the merge of provider default tags and resource
+// tags. Resources without an Update function, or whose Update never modifies the tags, see
+// immutableTagsResources, only get `tags_all` planned on creation, so that changing the
+// provider defaults never produces a diff they cannot apply. The Update of the other
+// resources applies the `tags_all` diff, see helper.HasTagsChange and helper.GetTagsChange.
+func customizeDiffTagsAll(updatable bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !updatable && d.Id() != "" {
+			return nil
+		}
+
+		if !d.NewValueKnown(TagsKey) {
+			return d.SetNewComputed(TagsAllKey)
+		}
+
+		tagsAll := MergeDefaultTags(RemoveIgnoredDefaultTags(getIgnoreTags(meta), getDefaultTags(meta)), d.Get(TagsKey).(map[string]interface{}))
+		if reflect.DeepEqual(d.Get(TagsAllKey), tagsAll) {
+			return nil
+		}
+
+		return d.SetNew(TagsAllKey, tagsAll)
+	}
+}
+
+// MergeDefaultTags returns the provider default tags overlaid by the resource tags; keys set
+// on the resource always win over the provider-level ones.
+func MergeDefaultTags(defaultTags map[string]string, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// RemoveDefaultTags strips the keys inherited from the provider default tags out of the tags
+// read back from the cloud, unless the key was also configured on the resource itself.
+func RemoveDefaultTags(defaultTags map[string]string, tags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v.(string) {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+func getDefaultTags(meta interface{}) map[string]string {
+	if client := getClient(meta); client != nil {
+		return client.DefaultTags
+	}
+
+	return nil
+}
+
+func getIgnoreTags(meta interface{}) *connectivity.IgnoreTagsConfig {
+	if client := getClient(meta); client != nil {
+		return client.IgnoreTags
+	}
+
+	return nil
+}
+
+func getClient(meta interface{}) *connectivity.TencentCloudClient {
+	if meta == nil {
+		return nil
+	}
+
+	providerMeta, ok := meta.(tccommon.ProviderMeta)
+	if !ok {
+		return nil
+	}
+
+	return providerMeta.GetAPIV3Conn()
+}
+
+// RemoveIgnoredTags drops the keys matched by the provider `ignore_tags` block.
+func RemoveIgnoredTags(ignoreTags *connectivity.IgnoreTagsConfig, tags map[string]interface{}) map[string]interface{} {
+	if ignoreTags == nil {
+		return tags
+	}
+
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if !ignoreTags.Ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// RemoveIgnoredDefaultTags drops the provider default tags matched by the provider
+// `ignore_tags` block, as they are never read back into `tags_all`.
+func RemoveIgnoredDefaultTags(ignoreTags *connectivity.IgnoreTagsConfig, defaultTags map[string]string) map[string]string {
+	if ignoreTags == nil {
+		return defaultTags
+	}
+
+	result := make(map[string]string, len(defaultTags))
+	for k, v := range defaultTags {
+		if !ignoreTags.Ignored(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// setTagsAll splits the tags read by the resource into the user-facing `tags` and the
+// computed `tags_all`, after the wrapped Create/Read/Update has returned successfully. `tags_all`
+// holds the tags read from the cloud, and keys matched by the provider `ignore_tags` are
+// dropped from both. The tags inherited from default tags which have changed since, see
+// getInheritedTags, are not moved to `tags` either.
+func setTagsAll(d *schema.ResourceData, meta interface{}, configured map[string]interface{}, inherited map[string]string) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tags := RemoveIgnoredTags(getIgnoreTags(meta), d.Get(TagsKey).(map[string]interface{}))
+	userTags := RemoveDefaultTags(inherited, RemoveDefaultTags(getDefaultTags(meta), tags, configured), configured)
+	if err := d.Set(TagsKey, userTags); err != nil {
+		return err
+	}
+
+	return d.Set(TagsAllKey, tags)
+}
+
+// setCreateTags makes the resource tags merged with the provider default tags visible through
+// `tags` while the resource is created.
+func setCreateTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	defaultTags := RemoveIgnoredDefaultTags(getIgnoreTags(meta), getDefaultTags(meta))
+	if len(defaultTags) == 0 {
+		return nil
+	}
+
+	return d.Set(TagsKey, MergeDefaultTags(defaultTags, configured))
+}
+
+// getInheritedTags returns the tags of `tags_all` which are not in `tags`, i.e. inherited from
+// the default tags when they were last read or planned. They may differ from the current
+// default tags, e.g. on the resources which do not update `tags_all`.
+func getInheritedTags(d *schema.ResourceData, configured map[string]interface{}) map[string]string {
+	tagsAll, _ := d.Get(TagsAllKey).(map[string]interface{})
+	result := make(map[string]string, len(tagsAll))
+	for k, v := range tagsAll {
+		if _, ok := configured[k]; !ok {
+			result[k] = v.(string)
+		}
+	}
+
+	return result
+}
+
+func getConfiguredTags(d *schema.ResourceData) map[string]interface{} {
+	if v, ok := d.Get(TagsKey).(map[string]interface{}); ok {
+		return v
+	}
+
+	return map[string]interface{}{}
+}
+
+func wrapTagsAllFuncs(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error, create bool) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			configured := getConfiguredTags(d)
+			inherited := getInheritedTags(d, configured)
+			if create {
+				if err := setCreateTags(d, meta, configured); err != nil {
+					return err
+				}
+			}
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setTagsAll(d, meta, configured, inherited)
+		}
+	}
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, create bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := getConfiguredTags(d)
+			inherited := getInheritedTags(d, configured)
+			if create {
+				if err := setCreateTags(d, meta, configured); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			diags := f(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+
+			return append(diags, diag.FromErr(setTagsAll(d, meta, configured, inherited))...)
+		}
+	}
+
+	r.Create = wrap(r.Create, true)
+	r.Read = wrap(r.Read, false)
+	r.Update = wrap(r.Update, false)
+	r.CreateContext = wrapContext(r.CreateContext, true)
+	r.ReadContext = wrapContext(r.ReadContext, false)
+	r.UpdateContext = wrapContext(r.UpdateContext, false)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout, true)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout, false)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout, false)
+}
@@ -0,0 +1,209 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+	tags := map[string]interface{}{"env": "test", "app": "web"}
+
+	assert.Equal(t, map[string]interface{}{
+		"team": "infra",
+		"env":  "test",
+		"app":  "web",
+	}, MergeDefaultTags(defaultTags, tags))
+	assert.Equal(t, map[string]interface{}{}, MergeDefaultTags(nil, nil))
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+	tags := map[string]interface{}{"team": "infra", "env": "prod", "app": "web"}
+
+	// keys inherited from the provider are dropped
+	assert.Equal(t, map[string]interface{}{"app": "web"}, RemoveDefaultTags(defaultTags, tags, map[string]interface{}{}))
+
+	// keys also configured on the resource are kept
+	assert.Equal(t, map[string]interface{}{"env": "prod", "app": "web"}, RemoveDefaultTags(defaultTags, tags, map[string]interface{}{"env": "prod"}))
+
+	// keys whose value differs from the provider default are kept
+	tags["team"] = "dba"
+	assert.Equal(t, map[string]interface{}{"team": "dba", "app": "web"}, RemoveDefaultTags(defaultTags, tags, nil))
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	tags := map[string]interface{}{"tencentcloud:createdBy": "billing", "scanner:level": "low", "app": "web"}
+
+	assert.Equal(t, tags, RemoveIgnoredTags(nil, tags))
+	assert.Nil(t, connectivity.NewIgnoreTagsConfig(nil, nil))
+
+	ignoreTags := connectivity.NewIgnoreTagsConfig([]string{"tencentcloud:createdBy"}, []string{"scanner:"})
+	assert.True(t, ignoreTags.Ignored("scanner:owner"))
+	assert.False(t, ignoreTags.Ignored("app"))
+	assert.Equal(t, map[string]interface{}{"app": "web"}, RemoveIgnoredTags(ignoreTags, tags))
+}
+
+func TestApplyDefaultTags(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"taggable": {
+			Schema: map[string]*schema.Schema{
+				"tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+		"computed_tags": {
+			Schema: map[string]*schema.Schema{
+				"tags": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+		"list_tags": {
+			Schema: map[string]*schema.Schema{
+				"tags": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+	}
+
+	ApplyDefaultTags(resources)
+
+	assert.Contains(t, resources["taggable"].Schema, TagsAllKey)
+	assert.True(t, resources["taggable"].Schema[TagsAllKey].Computed)
+	assert.NotNil(t, resources["taggable"].CustomizeDiff)
+	assert.NotContains(t, resources["computed_tags"].Schema, TagsAllKey)
+	assert.NotContains(t, resources["list_tags"].Schema, TagsAllKey)
+}
+
+type testProviderMeta struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (m *testProviderMeta) GetAPIV3Conn() *connectivity.TencentCloudClient {
+	return m.client
+}
+
+func TestDefaultTagsMissingOnCloud(t *testing.T) {
+	// the tags of the cloud resource
+	cloudTags := map[string]interface{}{"app": "web"}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", cloudTags)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	ApplyDefaultTags(map[string]*schema.Resource{"taggable": r})
+
+	meta := &testProviderMeta{client: &connectivity.TencentCloudClient{DefaultTags: map[string]string{"team": "infra"}}}
+	raw := map[string]interface{}{"tags": map[string]interface{}{"app": "web"}}
+	plan := func() *terraform.InstanceDiff {
+		d := schema.TestResourceDataRaw(t, r.Schema, raw)
+		d.SetId("res-1")
+		assert.NoError(t, r.Read(d, meta))
+		assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("tags"))
+		assert.Equal(t, cloudTags, d.Get(TagsAllKey))
+
+		diff, err := r.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(raw), meta)
+		assert.NoError(t, err)
+		return diff
+	}
+
+	// the default tag missing on the cloud is planned
+	diff := plan()
+	if assert.NotNil(t, diff) && assert.Contains(t, diff.Attributes, "tags_all.team") {
+		assert.Equal(t, "infra", diff.Attributes["tags_all.team"].New)
+	}
+
+	// once applied, there is nothing to change
+	cloudTags = map[string]interface{}{"app": "web", "team": "infra"}
+	diff = plan()
+	assert.True(t, diff == nil || len(diff.Attributes) == 0)
+}
+
+func TestDefaultTagsChangedOnExistingResource(t *testing.T) {
+	// the tags of the cloud resources
+	cloudTags := map[string]interface{}{"app": "web", "team": "infra"}
+	newResource := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return d.Set("tags", cloudTags)
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				if !helper.HasTagsChange(d) {
+					return nil
+				}
+
+				oldTags, newTags := helper.GetTagsChange(d)
+				replaceTags, deleteTags := DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+				for k, v := range replaceTags {
+					cloudTags[k] = v
+				}
+				for _, k := range deleteTags {
+					delete(cloudTags, k)
+				}
+				return nil
+			},
+		}
+	}
+	resources := map[string]*schema.Resource{
+		"taggable":                             newResource(),
+		"tencentcloud_vpc_private_nat_gateway": newResource(),
+	}
+	ApplyDefaultTags(resources)
+
+	raw := map[string]interface{}{"tags": map[string]interface{}{"app": "web"}}
+	// the resources were created with the default tags {"team": "infra"}
+	created := func(r *schema.Resource) *terraform.InstanceState {
+		d := schema.TestResourceDataRaw(t, r.Schema, raw)
+		d.SetId("res-1")
+		assert.NoError(t, r.Read(d, &testProviderMeta{client: &connectivity.TencentCloudClient{DefaultTags: map[string]string{"team": "infra"}}}))
+		assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("tags"))
+		return d.State()
+	}
+	plan := func(r *schema.Resource, state *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, *terraform.InstanceDiff) {
+		d := r.Data(state)
+		assert.NoError(t, r.Read(d, meta))
+
+		state = d.State()
+		diff, err := r.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(raw), meta)
+		assert.NoError(t, err)
+		return state, diff
+	}
+
+	meta := &testProviderMeta{client: &connectivity.TencentCloudClient{DefaultTags: map[string]string{"team": "dba", "env": "prod"}}}
+
+	// the changed default tags are planned and applied by Update
+	r := resources["taggable"]
+	state, diff := plan(r, created(r), meta)
+	if assert.NotNil(t, diff) {
+		assert.NotContains(t, diff.Attributes, "tags.team")
+		assert.Equal(t, "dba", diff.Attributes["tags_all.team"].New)
+		assert.Equal(t, "prod", diff.Attributes["tags_all.env"].New)
+
+		state, diags := r.Apply(context.TODO(), state, diff, meta)
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, map[string]interface{}{"app": "web", "team": "dba", "env": "prod"}, cloudTags)
+
+		// once applied, there is nothing to change
+		_, diff = plan(r, state, meta)
+		assert.True(t, diff == nil || len(diff.Attributes) == 0, diff)
+	}
+
+	// the resources whose Update never modifies the tags plan no change they cannot apply
+	cloudTags = map[string]interface{}{"app": "web", "team": "infra"}
+	r = resources["tencentcloud_vpc_private_nat_gateway"]
+	_, diff = plan(r, created(r), meta)
+	assert.True(t, diff == nil || len(diff.Attributes) == 0, diff)
+}
@@ -18,7 +18,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewTcaplusService(client *connectivity.TencentCloudClient) TcaplusService {
@@ -54,7 +53,7 @@ func (me *TcaplusService) CreateCluster(ctx context.Context, idlType, clusterNam
 	if clusterType > 0 {
 		request.ClusterType = &clusterType
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().CreateCluster(request)
 	if err != nil {
 		errRet = err
@@ -101,7 +100,7 @@ func (me *TcaplusService) DescribeClusters(ctx context.Context, clusterId string
 	request.Limit = &limit
 	request.Offset = &offset
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeClusters(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -137,7 +136,7 @@ func (me *TcaplusService) DescribeCluster(ctx context.Context, id string) (clust
 
 	var response *tcaplusdb.DescribeClustersResponse
 	retryErr := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseTcaplusClient().DescribeClustersWithContext(ctx, request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkError.TencentCloudSDKError); ok {
@@ -147,7 +146,7 @@ func (me *TcaplusService) DescribeCluster(ctx context.Context, id string) (clust
 			}
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -185,7 +184,7 @@ func (me *TcaplusService) DeleteCluster(ctx context.Context, id string) (taskId
 	}()
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteCluster(request)
 	if err != nil {
 		errRet = err
@@ -215,7 +214,7 @@ func (me *TcaplusService) ModifyClusterName(ctx context.Context, id string, clus
 	request.ClusterId = &id
 	request.ClusterName = helper.String(url.QueryEscape(clusterName))
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyClusterName(request)
 	if err != nil {
 		errRet = err
@@ -251,7 +250,7 @@ func (me *TcaplusService) ModifyClusterPassword(ctx context.Context, id string,
 		ex := expireTime.In(loc).Format("2006-01-02 15:04:05")
 		request.OldPasswordExpireTime = &ex
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyClusterPassword(request)
 	if err != nil {
 		errRet = err
@@ -273,7 +272,7 @@ func (me *TcaplusService) DescribeTask(ctx context.Context, clusterId string, ta
 	}()
 	request.ClusterIds = []*string{&clusterId}
 	request.TaskIds = []*string{&taskId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseTcaplusClient().DescribeTasks(request)
 	if err != nil {
@@ -316,7 +315,7 @@ func (me *TcaplusService) CreateGroup(ctx context.Context, id string, groupName
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().CreateTableGroup(request)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -343,7 +342,7 @@ func (me *TcaplusService) DescribeTableGroupTags(ctx context.Context, clusterId
 	}()
 	request.ClusterId = &clusterId
 	request.TableGroupIds = []*string{&tableGroupId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeTableGroupTagsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
@@ -376,7 +375,7 @@ func (me *TcaplusService) ModifyTableGroupTags(ctx context.Context, clusterId st
 	if len(deleteTags) > 0 {
 		request.DeleteTags = deleteTags
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyTableGroupTagsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
@@ -431,7 +430,7 @@ func (me *TcaplusService) DescribeGroups(ctx context.Context, clusterId string,
 	request.Limit = &limit
 
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeTableGroups(request)
 
 		if err != nil {
@@ -468,7 +467,7 @@ func (me *TcaplusService) DescribeGroup(ctx context.Context, id string, groupId
 
 	request.ClusterId = &id
 	request.TableGroupIds = []*string{&groupId}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeTableGroups(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -510,7 +509,7 @@ func (me *TcaplusService) DeleteGroup(ctx context.Context, clusterId string, gro
 
 	request.ClusterId = &clusterId
 	request.TableGroupId = &groupId
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteTableGroup(request)
 	if err != nil {
 		errRet = err
@@ -535,7 +534,7 @@ func (me *TcaplusService) ModifyGroupName(ctx context.Context, id string, groupI
 	request.ClusterId = &id
 	request.TableGroupId = &groupId
 	request.TableGroupName = &groupName
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyTableGroupName(request)
 	if err != nil {
 		errRet = err
@@ -568,7 +567,7 @@ func (me *TcaplusService) DeleteIdlFiles(ctx context.Context, tid TcaplusIdlId)
 			FileId:      &tid.FileId,
 		},
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteIdlFiles(request)
 	if err != nil {
 		errRet = err
@@ -601,7 +600,7 @@ func (me *TcaplusService) DesOldIdlFiles(ctx context.Context, tid TcaplusIdlId)
 			FileId:      &tid.FileId,
 		},
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().VerifyIdlFiles(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -644,7 +643,7 @@ func (me *TcaplusService) VerifyIdlFiles(ctx context.Context, tid TcaplusIdlId,
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().VerifyIdlFiles(request)
 	if err != nil {
 		errRet = err
@@ -722,7 +721,7 @@ func (me *TcaplusService) CreateTables(ctx context.Context, tid TcaplusIdlId,
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().CreateTables(request)
 	if err != nil {
 		errRet = err
@@ -804,7 +803,7 @@ func (me *TcaplusService) DescribeTables(ctx context.Context, clusterId string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeTables(request)
 
 		if err != nil {
@@ -847,7 +846,7 @@ func (me *TcaplusService) DescribeTable(ctx context.Context, clusterId, tableIns
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeTables(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -907,7 +906,7 @@ func (me *TcaplusService) DeleteTable(ctx context.Context, clusterId, groupId, t
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteTables(request)
 	if err != nil {
 		errRet = err
@@ -954,7 +953,7 @@ func (me *TcaplusService) ModifyTableMemo(ctx context.Context, clusterId, groupI
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyTableMemos(request)
 	if err != nil {
 		errRet = err
@@ -1017,7 +1016,7 @@ func (me *TcaplusService) ModifyTables(ctx context.Context, tid TcaplusIdlId,
 			TableIdlType:    &tableIdType,
 		},
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseTcaplusClient().ModifyTables(request)
 	if err != nil {
@@ -1059,7 +1058,7 @@ func (me *TcaplusService) DescribeIdlFileInfos(ctx context.Context, clusterId st
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeIdlFileInfos(request)
 
 		if err != nil {
@@ -1092,12 +1091,12 @@ func (me *TcaplusService) DescribeClusterTags(ctx context.Context, clusterId str
 
 	var response *tcaplusdb.DescribeClusterTagsResponse
 	retryErr := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		result, e := me.client.UseTcaplusClient().DescribeClusterTagsWithContext(ctx, request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		response = result
 		return nil
 	})
@@ -2,6 +2,7 @@ package tcmg
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svcmonitor "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/monitor"
 
 	"context"
@@ -84,7 +85,7 @@ func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		//response = result
 		return nil
@@ -101,7 +102,7 @@ func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMonitorClient().DescribeInstalledPlugins(descResquest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 		if len(response.Response.PluginSet) < 1 {
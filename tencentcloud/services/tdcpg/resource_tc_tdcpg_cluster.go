@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -181,7 +182,7 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
@@ -200,7 +201,7 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s call api[%s] success, request body [%s], resources [%v]\n",
-				logId, "DescribeTdcpgResourceByDealName", request.ToJsonString(), resources)
+				logId, "DescribeTdcpgResourceByDealName", connectivity.RedactJSON(request.ToJsonString()), resources)
 		}
 		clusterId = *resources[0].ClusterId
 		return nil
@@ -401,7 +402,7 @@ func resourceTencentCloudTdcpgClusterUpdate(d *schema.ResourceData, meta interfa
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
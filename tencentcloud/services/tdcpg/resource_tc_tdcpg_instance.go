@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -96,7 +97,7 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		response = result
 		return nil
@@ -115,7 +116,7 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s call api[%s] success, request body [%s], resources [%v]\n",
-				logId, "DescribeTdcpgResourceByDealName", request.ToJsonString(), resources)
+				logId, "DescribeTdcpgResourceByDealName", connectivity.RedactJSON(request.ToJsonString()), resources)
 		}
 		clusterId = *resources[0].ClusterId
 		instanceId = *resources[0].InstanceIdSet[0]
@@ -230,7 +231,7 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 			return tccommon.RetryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 		return nil
 	})
@@ -12,7 +12,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const (
@@ -73,18 +72,18 @@ func (me *TdcpgService) IsolateTdcpgInstanceById(ctx context.Context, clusterId,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "isolate tdcpg instance object", request.ToJsonString(), errRet.Error())
+				logId, "isolate tdcpg instance object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().IsolateClusterInstances(request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -128,11 +127,11 @@ func (me *TdcpgService) DeleteTdcpgClusterById(ctx context.Context, clusterId *s
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete tdcpg cluster object", request.ToJsonString(), errRet.Error())
+				logId, "delete tdcpg cluster object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().DeleteCluster(request)
 	if err != nil {
 		errRet = err
@@ -159,7 +158,7 @@ func (me *TdcpgService) DeleteTdcpgClusterById(ctx context.Context, clusterId *s
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -198,7 +197,7 @@ func (me *TdcpgService) DescribeTdcpgResourceByDealName(ctx context.Context, dea
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -210,12 +209,12 @@ func (me *TdcpgService) DescribeTdcpgResourceByDealName(ctx context.Context, dea
 		response, err := me.client.UseTdcpgClient().DescribeResourcesByDealName(request)
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		resourceInfo = response.Response.ResourceIdInfoSet
 	}
@@ -231,18 +230,18 @@ func (me *TdcpgService) IsolateTdcpgClusterById(ctx context.Context, clusterId *
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "isolate tdcpg cluster object", request.ToJsonString(), errRet.Error())
+				logId, "isolate tdcpg cluster object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().IsolateCluster(request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -289,11 +288,11 @@ func (me *TdcpgService) DeleteTdcpgInstanceById(ctx context.Context, clusterId,
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "delete tdcpg instance object", request.ToJsonString(), errRet.Error())
+				logId, "delete tdcpg instance object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().DeleteClusterInstances(request)
 	if err != nil {
 		errRet = err
@@ -320,7 +319,7 @@ func (me *TdcpgService) DeleteTdcpgInstanceById(ctx context.Context, clusterId,
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -340,7 +339,7 @@ func (me *TdcpgService) DescribeTdcpgClustersByFilter(ctx context.Context, param
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -393,7 +392,7 @@ func (me *TdcpgService) DescribeTdcpgClustersByFilter(ctx context.Context, param
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = tmpId
 
@@ -408,12 +407,12 @@ func (me *TdcpgService) DescribeTdcpgClustersByFilter(ctx context.Context, param
 
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.ClusterSet) < 1 {
 			break
@@ -442,7 +441,7 @@ func (me *TdcpgService) DescribeTdcpgInstancesByFilter(ctx context.Context, clus
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, "query object", request.ToJsonString(), errRet.Error())
+				logId, "query object", connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -486,7 +485,7 @@ func (me *TdcpgService) DescribeTdcpgInstancesByFilter(ctx context.Context, clus
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = tmpId
 
@@ -501,12 +500,12 @@ func (me *TdcpgService) DescribeTdcpgInstancesByFilter(ctx context.Context, clus
 		}
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			errRet = err
 			return
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || len(response.Response.InstanceSet) < 1 {
 			break
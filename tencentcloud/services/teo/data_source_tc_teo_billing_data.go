@@ -10,8 +10,8 @@ import (
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudTeoBillingData() *schema.Resource {
@@ -176,7 +176,7 @@ func dataSourceTencentCloudTeoBillingDataRead(d *schema.ResourceData, meta inter
 		request.GroupBy = helper.InterfacesStringsPoint(groupBySet)
 	}
 
-	ratelimit.Check(request.GetAction())
+	meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 	var response *teo.DescribeBillingDataResponse
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -195,7 +195,7 @@ func dataSourceTencentCloudTeoBillingDataRead(d *schema.ResourceData, meta inter
 		log.Printf("[CRITAL]%s read tencentcloud_teo_billing_data failed, reason:%s\n", logId, err.Error())
 		return err
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	dataList := make([]map[string]interface{}, 0)
 	if response.Response.Data != nil {
@@ -8,8 +8,8 @@ import (
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudTeoIPGroupReferences() *schema.Resource {
@@ -106,7 +106,7 @@ func dataSourceTencentCloudTeoIPGroupReferencesRead(d *schema.ResourceData, meta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 		var response *teo.DescribeIPGroupReferencesResponse
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -127,7 +127,7 @@ func dataSourceTencentCloudTeoIPGroupReferencesRead(d *schema.ResourceData, meta
 			log.Printf("[DATASOURCE] read empty, skip SetId")
 			return err
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response.Response.References != nil {
 			references = append(references, response.Response.References...)
@@ -8,8 +8,8 @@ import (
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func DataSourceTencentCloudTeoSecurityIPGroupContent() *schema.Resource {
@@ -75,7 +75,7 @@ func dataSourceTencentCloudTeoSecurityIPGroupContentRead(d *schema.ResourceData,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 		var response *teo.DescribeSecurityIPGroupContentResponse
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -89,7 +89,7 @@ func dataSourceTencentCloudTeoSecurityIPGroupContentRead(d *schema.ResourceData,
 		if err != nil {
 			return err
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil {
 			break
@@ -12,6 +12,7 @@ import (
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -137,7 +138,7 @@ func resourceTencentCloudTeoAliasDomainCreate(d *schema.ResourceData, meta inter
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -186,7 +187,7 @@ func resourceTencentCloudTeoAliasDomainCreate(d *schema.ResourceData, meta inter
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pauseRequest.GetAction(), pauseRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pauseRequest.GetAction(), connectivity.RedactJSON(pauseRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 
@@ -299,7 +300,7 @@ func resourceTencentCloudTeoAliasDomainUpdate(d *schema.ResourceData, meta inter
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 
@@ -322,7 +323,7 @@ func resourceTencentCloudTeoAliasDomainUpdate(d *schema.ResourceData, meta inter
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, statusRequest.GetAction(), statusRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, statusRequest.GetAction(), connectivity.RedactJSON(statusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 
@@ -403,7 +404,7 @@ func resourceTencentCloudTeoAliasDomainDelete(d *schema.ResourceData, meta inter
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pauseRequest.GetAction(), pauseRequest.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, pauseRequest.GetAction(), connectivity.RedactJSON(pauseRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 
@@ -422,7 +423,7 @@ func resourceTencentCloudTeoAliasDomainDelete(d *schema.ResourceData, meta inter
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
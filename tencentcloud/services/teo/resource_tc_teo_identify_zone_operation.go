@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teov20220901 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -102,7 +103,7 @@ func resourceTencentCloudTeoIdentifyZoneCreate(d *schema.ResourceData, meta inte
 	service := NewTeoService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 
 	// Call IdentifyZone API
-	log.Printf("[DEBUG]%s api[%s] request body [%s]\n", logId, request.GetAction(), request.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] request body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()))
 
 	var ascription *teov20220901.AscriptionInfo
 	var fileAscription *teov20220901.FileAscriptionInfo
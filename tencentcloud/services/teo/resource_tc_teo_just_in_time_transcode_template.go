@@ -10,6 +10,7 @@ import (
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -202,7 +203,7 @@ func resourceTencentCloudTeoJustInTimeTranscodeTemplateCreate(d *schema.Resource
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if result == nil || result.Response == nil || result.Response.TemplateId == nil {
 			return resource.NonRetryableError(fmt.Errorf("create teo just-in-time transcode template failed, response is nil"))
@@ -251,7 +252,7 @@ func resourceTencentCloudTeoJustInTimeTranscodeTemplateRead(d *schema.ResourceDa
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response.Response.TotalCount == nil || *response.Response.TotalCount == 0 {
 			return resource.NonRetryableError(fmt.Errorf("template not found"))
@@ -354,7 +355,7 @@ func resourceTencentCloudTeoJustInTimeTranscodeTemplateDelete(d *schema.Resource
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n", logId, request.GetAction(), request.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()))
 		return nil
 	})
 
@@ -11,6 +11,7 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -102,7 +103,7 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusterInstances(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		response = result
@@ -138,7 +139,7 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().DescribeInstances(describeInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
+					logId, describeInstancesreq.GetAction(), connectivity.RedactJSON(describeInstancesreq.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			describeInstancesResponse = result
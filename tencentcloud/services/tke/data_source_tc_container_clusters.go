@@ -11,6 +11,7 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -127,7 +128,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusters(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		response = result
@@ -161,7 +162,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusterInstances(describeClusterInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, describeClusterInstancesreq.GetAction(), describeClusterInstancesreq.ToJsonString(), e.Error())
+					logId, describeClusterInstancesreq.GetAction(), connectivity.RedactJSON(describeClusterInstancesreq.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			describeClusterInstancesResponse = result
@@ -184,7 +185,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 				result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().DescribeInstances(describeInstancesreq)
 				if e != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-						logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
+						logId, describeInstancesreq.GetAction(), connectivity.RedactJSON(describeInstancesreq.ToJsonString()), e.Error())
 					return tccommon.RetryError(e)
 				}
 				describeInstancesResponse = result
@@ -208,7 +209,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusterSecurity(describeClusterSecurityreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, describeClusterSecurityreq.GetAction(), describeClusterSecurityreq.ToJsonString(), e.Error())
+					logId, describeClusterSecurityreq.GetAction(), connectivity.RedactJSON(describeClusterSecurityreq.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			securityResponse = result
@@ -0,0 +1,263 @@
+package tke
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/yaml.v2"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sharedmeta"
+)
+
+var _ ephemeral.EphemeralResource = &KubernetesClusterKubeconfigEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &KubernetesClusterKubeconfigEphemeralResource{}
+
+// NewKubernetesClusterKubeconfigEphemeralResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this ephemeral resource.
+func NewKubernetesClusterKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterKubeconfigEphemeralResource{}
+}
+
+// KubernetesClusterKubeconfigEphemeralResource implements
+// ephemeral.EphemeralResource for tencentcloud_kubernetes_cluster_kubeconfig.
+// It returns the same kubeconfig as the `kube_config` attributes of
+// tencentcloud_kubernetes_cluster without writing it to state.
+type KubernetesClusterKubeconfigEphemeralResource struct {
+	client TkeService
+}
+
+// KubernetesClusterKubeconfigEphemeralResourceModel maps the schema attributes.
+type KubernetesClusterKubeconfigEphemeralResourceModel struct {
+	ClusterId            types.String `tfsdk:"cluster_id"`
+	IsExtranet           types.Bool   `tfsdk:"is_extranet"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCaCertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Token                types.String `tfsdk:"token"`
+}
+
+func (e *KubernetesClusterKubeconfigEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "tencentcloud_kubernetes_cluster_kubeconfig"
+}
+
+func (e *KubernetesClusterKubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the kubeconfig of a TKE cluster and the connection fields it contains, " +
+			"e.g. to configure the kubernetes and helm providers. The credentials are not persisted in state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the cluster.",
+			},
+			"is_extranet": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to return the kubeconfig of the internet endpoint. Default is false, which returns the kubeconfig of the intranet endpoint. The corresponding endpoint must be enabled.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Kubeconfig of the cluster.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address of the cluster API server.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM-encoded CA certificate of the cluster.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client certificate, if the kubeconfig authenticates with a certificate.",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client key, if the kubeconfig authenticates with a certificate.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token, if the kubeconfig authenticates with a token.",
+			},
+		},
+	}
+}
+
+// Configure receives the *sharedmeta.ProviderMeta populated by
+// framework.Provider.Configure.
+func (e *KubernetesClusterKubeconfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*sharedmeta.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData type",
+			fmt.Sprintf("Expected *sharedmeta.ProviderMeta, got: %T. "+
+				"This is a bug in the provider; please report it.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = NewTkeService(meta.Client)
+}
+
+// Open is invoked once per plan/apply. It reads the kubeconfig with
+// DescribeClusterKubeconfig and decodes the connection fields from it.
+func (e *KubernetesClusterKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KubernetesClusterKubeconfigEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterId.ValueString()
+	isExtranet := data.IsExtranet.ValueBool()
+
+	var config string
+	err := resource.RetryContext(ctx, tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		config, errRet = e.client.DescribeClusterConfig(ctx, clusterId, isExtranet)
+		if errRet != nil {
+			return tccommon.RetryError(errRet)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading TKE cluster kubeconfig",
+			fmt.Sprintf("Could not read the kubeconfig of cluster %q: %v", clusterId, err),
+		)
+		return
+	}
+	if config == "" {
+		endpoint := "intranet"
+		if isExtranet {
+			endpoint = "internet"
+		}
+		resp.Diagnostics.AddError(
+			"TKE cluster kubeconfig not found",
+			fmt.Sprintf("Cluster %q returned an empty kubeconfig. Please check that its %s endpoint is enabled.", clusterId, endpoint),
+		)
+		return
+	}
+
+	credential, err := parseKubeconfig(config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing TKE cluster kubeconfig", err.Error())
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(config)
+	data.Host = types.StringValue(credential.host)
+	data.ClusterCaCertificate = types.StringValue(credential.clusterCaCertificate)
+	data.ClientCertificate = types.StringValue(credential.clientCertificate)
+	data.ClientKey = types.StringValue(credential.clientKey)
+	data.Token = types.StringValue(credential.token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+type kubeconfigCredential struct {
+	host                 string
+	clusterCaCertificate string
+	clientCertificate    string
+	clientKey            string
+	token                string
+}
+
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// parseKubeconfig extracts the cluster and user of the current context of a
+// kubeconfig, falling back to the first cluster and user when the kubeconfig
+// has no current context.
+func parseKubeconfig(config string) (*kubeconfigCredential, error) {
+	var file kubeconfigFile
+	if err := yaml.Unmarshal([]byte(config), &file); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %v", err)
+	}
+	if len(file.Clusters) == 0 || len(file.Users) == 0 {
+		return nil, fmt.Errorf("invalid kubeconfig: no cluster or user found")
+	}
+
+	clusterIdx, userIdx := 0, 0
+	for _, c := range file.Contexts {
+		if c.Name != file.CurrentContext {
+			continue
+		}
+		for i := range file.Clusters {
+			if file.Clusters[i].Name == c.Context.Cluster {
+				clusterIdx = i
+			}
+		}
+		for i := range file.Users {
+			if file.Users[i].Name == c.Context.User {
+				userIdx = i
+			}
+		}
+	}
+
+	cluster := file.Clusters[clusterIdx].Cluster
+	user := file.Users[userIdx].User
+	credential := &kubeconfigCredential{
+		host:  cluster.Server,
+		token: user.Token,
+	}
+
+	var err error
+	if credential.clusterCaCertificate, err = decodeKubeconfigData(cluster.CertificateAuthorityData); err != nil {
+		return nil, fmt.Errorf("invalid certificate-authority-data: %v", err)
+	}
+	if credential.clientCertificate, err = decodeKubeconfigData(user.ClientCertificateData); err != nil {
+		return nil, fmt.Errorf("invalid client-certificate-data: %v", err)
+	}
+	if credential.clientKey, err = decodeKubeconfigData(user.ClientKeyData); err != nil {
+		return nil, fmt.Errorf("invalid client-key-data: %v", err)
+	}
+
+	return credential, nil
+}
+
+func decodeKubeconfigData(data string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
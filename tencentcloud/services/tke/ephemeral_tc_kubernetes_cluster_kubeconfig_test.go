@@ -0,0 +1,52 @@
+package tke_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	svctke "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tke"
+)
+
+// TestKubernetesClusterKubeconfigEphemeralResource_Metadata validates that
+// Metadata.TypeName is set to "tencentcloud_kubernetes_cluster_kubeconfig".
+func TestKubernetesClusterKubeconfigEphemeralResource_Metadata(t *testing.T) {
+	e := svctke.NewKubernetesClusterKubeconfigEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+
+	e.Metadata(t.Context(), ephemeral.MetadataRequest{}, resp)
+
+	if resp.TypeName != "tencentcloud_kubernetes_cluster_kubeconfig" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_kubernetes_cluster_kubeconfig")
+	}
+}
+
+// TestKubernetesClusterKubeconfigEphemeralResource_Schema validates that
+// cluster_id is required and that the credentials are sensitive.
+func TestKubernetesClusterKubeconfigEphemeralResource_Schema(t *testing.T) {
+	e := svctke.NewKubernetesClusterKubeconfigEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+
+	e.Schema(t.Context(), ephemeral.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned diagnostics: %v", resp.Diagnostics)
+	}
+	if attr, ok := resp.Schema.Attributes["cluster_id"]; !ok || !attr.IsRequired() {
+		t.Errorf("attribute cluster_id should be Required")
+	}
+	if attr, ok := resp.Schema.Attributes["is_extranet"]; !ok || !attr.IsOptional() {
+		t.Errorf("attribute is_extranet should be Optional")
+	}
+
+	for _, name := range []string{"kubeconfig", "client_certificate", "client_key", "token"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing attribute: %s", name)
+			continue
+		}
+		if !attr.IsComputed() || !attr.IsSensitive() {
+			t.Errorf("attribute %s should be Computed and Sensitive", name)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package tke
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKubeconfig(t *testing.T) {
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com
+- name: cls-xxxxxx
+  cluster:
+    server: https://cls-xxxxxx.ccs.tencent-cloud.com
+    certificate-authority-data: %s
+contexts:
+- name: cls-xxxxxx-context-default
+  context:
+    cluster: cls-xxxxxx
+    user: "100000000001"
+current-context: cls-xxxxxx-context-default
+users:
+- name: "100000000001"
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`, b64("ca"), b64("cert"), b64("key"))
+
+	credential, err := parseKubeconfig(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cls-xxxxxx.ccs.tencent-cloud.com", credential.host)
+	assert.Equal(t, "ca", credential.clusterCaCertificate)
+	assert.Equal(t, "cert", credential.clientCertificate)
+	assert.Equal(t, "key", credential.clientKey)
+	assert.Equal(t, "", credential.token)
+}
+
+func TestParseKubeconfigToken(t *testing.T) {
+	config := `clusters:
+- name: cls-xxxxxx
+  cluster:
+    server: https://10.0.0.1
+users:
+- name: admin
+  user:
+    token: secret-token
+`
+
+	credential, err := parseKubeconfig(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1", credential.host)
+	assert.Equal(t, "secret-token", credential.token)
+}
+
+func TestParseKubeconfigInvalid(t *testing.T) {
+	_, err := parseKubeconfig("clusters: []")
+	assert.Error(t, err)
+
+	_, err = parseKubeconfig(`clusters:
+- name: c
+  cluster:
+    certificate-authority-data: "!!"
+users:
+- name: u
+`)
+	assert.Error(t, err)
+}
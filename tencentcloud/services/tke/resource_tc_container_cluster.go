@@ -2,6 +2,7 @@ package tke
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
 
 	"context"
@@ -515,7 +516,7 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().DescribeInstances(describeInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
+					logId, describeInstancesreq.GetAction(), connectivity.RedactJSON(describeInstancesreq.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			describeInstancesResponse = result
@@ -2,6 +2,7 @@ package tke
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
 
 	"context"
@@ -233,7 +234,7 @@ func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, m
 				result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().DescribeInstances(describeInstancesreq)
 				if e != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-						logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
+						logId, describeInstancesreq.GetAction(), connectivity.RedactJSON(describeInstancesreq.ToJsonString()), e.Error())
 					return tccommon.RetryError(e)
 				}
 				describeInstancesResponse = result
@@ -1241,7 +1241,7 @@ func resourceTencentCloudKubernetesClusterUpdateOnStart(ctx context.Context) err
 		return fmt.Errorf("argument cluster_subnet_id cannot be changed")
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		if err := modifyClusterTags(ctx); err != nil {
 			return err
 		}
@@ -11,8 +11,8 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudKubernetesClusterExtraArgsConfig() *schema.Resource {
@@ -167,7 +167,7 @@ func resourceTencentCloudKubernetesClusterExtraArgsConfigUpdate(d *schema.Resour
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -211,7 +211,7 @@ func waitForClusterExtraArgsTaskDone(ctx context.Context, meta interface{}, clus
 	}
 
 	return resource.Retry(timeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeV20180525Client().DescribeTasksWithContext(ctx, request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -221,7 +221,7 @@ func waitForClusterExtraArgsTaskDone(ctx context.Context, meta interface{}, clus
 			return resource.RetryableError(fmt.Errorf("waiting for cluster extra args task, response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if len(result.Response.Tasks) == 0 {
 			return resource.RetryableError(fmt.Errorf("waiting for cluster extra args task, no tasks found"))
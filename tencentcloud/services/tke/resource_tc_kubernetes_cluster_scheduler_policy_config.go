@@ -12,8 +12,8 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudKubernetesClusterSchedulerPolicyConfig() *schema.Resource {
@@ -375,7 +375,7 @@ func resourceTencentCloudKubernetesClusterSchedulerPolicyConfigUpdate(d *schema.
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -416,7 +416,7 @@ func waitForClusterSchedulerPolicyTaskDone(ctx context.Context, meta interface{}
 	}
 
 	return resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeV20180525Client().DescribeTasksWithContext(ctx, request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -426,7 +426,7 @@ func waitForClusterSchedulerPolicyTaskDone(ctx context.Context, meta interface{}
 			return resource.RetryableError(fmt.Errorf("waiting for cluster scheduler policy task, response is nil"))
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 		if len(result.Response.Tasks) == 0 {
 			return resource.RetryableError(fmt.Errorf("waiting for cluster scheduler policy task, no tasks found"))
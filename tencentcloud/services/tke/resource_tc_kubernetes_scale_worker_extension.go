@@ -7,8 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
-
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -17,6 +15,7 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -455,12 +454,12 @@ func resourceTencentCloudKubernetesScaleWorkerCreateOnStart(ctx context.Context)
 		for {
 			waitRequest.Limit = &limit
 			waitRequest.Offset = &offset
-			ratelimit.Check(waitRequest.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(waitRequest.GetAction())
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusterInstances(waitRequest)
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG] api[%s] success, request body [%s], response body [%s]\n", waitRequest.GetAction(), waitRequest.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG] api[%s] success, request body [%s], response body [%s]\n", waitRequest.GetAction(), connectivity.RedactJSON(waitRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			if result == nil || len(result.Response.InstanceSet) == 0 {
@@ -667,13 +666,13 @@ func resourceTencentCloudKubernetesScaleWorkerReadPostFillRequest1(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTkeClient().DescribeClusterInstances(request)
 		if err != nil {
 			return err
 		}
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 		if response == nil || len(response.Response.InstanceSet) < 1 {
 			break
 		}
@@ -736,7 +735,7 @@ func resourceTencentCloudKubernetesScaleWorkerReadPreRequest1(ctx context.Contex
 	if len(instanceIds) <= 100 {
 		req.InstanceIds = instanceIds
 		resp, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmV20170312Client().DescribeInstances(req)
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, req.GetAction(), req.ToJsonString(), resp.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(resp.ToJsonString()))
 		if err != nil {
 			return nil, err
 		}
@@ -750,7 +749,7 @@ func resourceTencentCloudKubernetesScaleWorkerReadPreRequest1(ctx context.Contex
 		for _, subsubSlice := range subSlices {
 			req.InstanceIds = subsubSlice
 			response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmV20170312Client().DescribeInstances(req)
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, req.GetAction(), req.ToJsonString(), response.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, req.GetAction(), connectivity.RedactJSON(req.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 			if err != nil {
 				return nil, err
 			}
@@ -820,13 +819,13 @@ func modifyKubernetesScaleWorkerInstancesChargeType(ctx context.Context, meta in
 	for _, batch := range spliteInstanceIds(helper.Strings(instanceIds), 20) {
 		request.InstanceIds = batch
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmV20170312Client().ModifyInstancesChargeTypeWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil {
 				return resource.NonRetryableError(fmt.Errorf("modify instances charge type failed, Response is nil."))
 			}
@@ -858,13 +857,13 @@ func waitKubernetesScaleWorkerChargeTypeOperationSuccess(ctx context.Context, me
 			request.Limit = helper.Int64(100)
 			request.Offset = helper.Int64(0)
 
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmV20170312Client().DescribeInstancesWithContext(ctx, request)
 			if e != nil {
 				return tccommon.RetryError(e)
 			}
 
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			if result == nil || result.Response == nil {
 				return resource.NonRetryableError(fmt.Errorf("describe instances failed, Response is nil."))
 			}
@@ -9,6 +9,7 @@ import (
 	tkev20180525 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -129,7 +130,7 @@ func resourceTencentCloudKubernetesUserPermissionsCreate(d *schema.ResourceData,
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
@@ -264,7 +265,7 @@ func resourceTencentCloudKubernetesUserPermissionsUpdate(d *schema.ResourceData,
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -321,7 +322,7 @@ func resourceTencentCloudKubernetesUserPermissionsUpdate(d *schema.ResourceData,
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, request.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -376,7 +377,7 @@ func resourceTencentCloudKubernetesUserPermissionsDelete(d *schema.ResourceData,
 			if e != nil {
 				return tccommon.RetryError(e)
 			} else {
-				log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, request.ToJsonString(), result.ToJsonString())
+				log.Printf("[DEBUG]%s api[%s] success for batch %d-%d, request body [%s], response body [%s]\n", logId, request.GetAction(), i+1, end, connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			}
 
 			return nil
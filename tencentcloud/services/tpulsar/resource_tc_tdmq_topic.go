@@ -2,6 +2,8 @@ package tpulsar
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
@@ -279,9 +281,9 @@ func resourceTencentCloudTdmqTopicUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
-		oldRaw, newRaw := d.GetChange("tags")
+		oldRaw, newRaw := helper.GetTagsChange(d)
 		oldTagsMap := tagsListToMap(oldRaw.([]interface{}))
 		newTagsMap := tagsListToMap(newRaw.([]interface{}))
 		replaceTags, deleteTags := svctag.DiffTags(oldTagsMap, newTagsMap)
@@ -299,12 +301,12 @@ func resourceTencentCloudTdmqTopicUpdate(d *schema.ResourceData, meta interface{
 			unTagRequest.TagKeys = tagKeys
 
 			err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-				log.Printf("[DEBUG]%s api[UnTagResources] request: %s", logId, unTagRequest.ToJsonString())
+				log.Printf("[DEBUG]%s api[UnTagResources] request: %s", logId, connectivity.RedactJSON(unTagRequest.ToJsonString()))
 				response, e := tcClient.UseTagClient().UnTagResources(unTagRequest)
 				if e != nil {
 					return tccommon.RetryError(e)
 				}
-				log.Printf("[DEBUG]%s api[UnTagResources] response: %s", logId, response.ToJsonString())
+				log.Printf("[DEBUG]%s api[UnTagResources] response: %s", logId, connectivity.RedactJSON(response.ToJsonString()))
 				return nil
 			})
 			if err != nil {
@@ -328,12 +330,12 @@ func resourceTencentCloudTdmqTopicUpdate(d *schema.ResourceData, meta interface{
 			tagRequest.Tags = tags
 
 			err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-				log.Printf("[DEBUG]%s api[TagResources] request: %s", logId, tagRequest.ToJsonString())
+				log.Printf("[DEBUG]%s api[TagResources] request: %s", logId, connectivity.RedactJSON(tagRequest.ToJsonString()))
 				response, e := tcClient.UseTagClient().TagResources(tagRequest)
 				if e != nil {
 					return tccommon.RetryError(e)
 				}
-				log.Printf("[DEBUG]%s api[TagResources] response: %s", logId, response.ToJsonString())
+				log.Printf("[DEBUG]%s api[TagResources] response: %s", logId, connectivity.RedactJSON(response.ToJsonString()))
 				return nil
 			})
 			if err != nil {
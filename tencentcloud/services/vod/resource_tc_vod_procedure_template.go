@@ -14,7 +14,6 @@ import (
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudVodProcedureTemplate() *schema.Resource {
@@ -823,7 +822,7 @@ func resourceTencentCloudVodProcedureTemplateCreate(d *schema.ResourceData, meta
 	}
 	var err error
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		_, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().CreateProcedureTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -1243,7 +1242,7 @@ func resourceTencentCloudVodProcedureTemplateUpdate(d *schema.ResourceData, meta
 	if changeFlag {
 		var err error
 		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			_, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ResetProcedureTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -15,7 +15,6 @@ import (
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func ResourceTencentCloudVodSubApplication() *schema.Resource {
@@ -98,7 +97,7 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 	}
 
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().CreateSubAppId(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -118,7 +117,7 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 		statusResquest.Status = helper.String(v.(string))
 
 		if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusResquest.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(statusResquest.GetAction())
 			_, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ModifySubAppIdStatus(statusResquest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -272,7 +271,7 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 	if changeFlag {
 		var err error
 		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(request.GetAction())
 			_, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ModifySubAppIdInfo(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -292,7 +291,7 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 		statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
 		var err error
 		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusRequest.GetAction())
+			meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(statusRequest.GetAction())
 			_, err = meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ModifySubAppIdStatus(statusRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -308,12 +307,12 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 	}
 
 	// Handle tags update using unified tag service
-	if d.HasChange("tags") {
+	if helper.HasTagsChange(d) {
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
 		region := tcClient.Region
 		resourceName := fmt.Sprintf("qcs::vod:%s:uin/:subAppId/%s", region, subAppId)
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
 			return err
@@ -337,7 +336,7 @@ func resourceTencentCloudVodSubApplicationDelete(d *schema.ResourceData, meta in
 	statusRequest.Status = helper.String("Off")
 	statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(statusRequest.GetAction())
 		if _, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
@@ -349,7 +348,7 @@ func resourceTencentCloudVodSubApplicationDelete(d *schema.ResourceData, meta in
 	// then destroy
 	statusRequest.Status = helper.String("Destroyed")
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
+		meta.(tccommon.ProviderMeta).GetAPIV3Conn().RateLimiter.Check(statusRequest.GetAction())
 		if _, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return tccommon.RetryError(err, tccommon.InternalError)
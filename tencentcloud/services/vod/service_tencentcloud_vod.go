@@ -15,7 +15,6 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func NewVodService(client *connectivity.TencentCloudClient) VodService {
@@ -52,7 +51,7 @@ func (me *VodService) DescribeAdaptiveDynamicStreamingTemplatesByFilter(ctx cont
 		var response *vod.DescribeAdaptiveDynamicStreamingTemplatesResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeAdaptiveDynamicStreamingTemplates(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -61,7 +60,7 @@ func (me *VodService) DescribeAdaptiveDynamicStreamingTemplatesByFilter(ctx cont
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 		if len(response.Response.AdaptiveDynamicStreamingTemplateSet) < VOD_MAX_LIMIT {
@@ -112,7 +111,7 @@ func (me *VodService) DeleteAdaptiveDynamicStreamingTemplate(ctx context.Context
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteAdaptiveDynamicStreamingTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -152,7 +151,7 @@ func (me *VodService) DescribeProcedureTemplatesByFilter(ctx context.Context, fi
 		var response *vod.DescribeProcedureTemplatesResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeProcedureTemplates(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -161,7 +160,7 @@ func (me *VodService) DescribeProcedureTemplatesByFilter(ctx context.Context, fi
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 		if len(response.Response.ProcedureTemplateSet) < VOD_MAX_LIMIT {
@@ -210,7 +209,7 @@ func (me *VodService) DeleteProcedureTemplate(ctx context.Context, templateId st
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteProcedureTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -251,7 +250,7 @@ func (me *VodService) DescribeSnapshotByTimeOffsetTemplatesByFilter(ctx context.
 		var response *vod.DescribeSnapshotByTimeOffsetTemplatesResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeSnapshotByTimeOffsetTemplates(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -260,7 +259,7 @@ func (me *VodService) DescribeSnapshotByTimeOffsetTemplatesByFilter(ctx context.
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 		if len(response.Response.SnapshotByTimeOffsetTemplateSet) < VOD_MAX_LIMIT {
@@ -308,7 +307,7 @@ func (me *VodService) DeleteSnapshotByTimeOffsetTemplate(ctx context.Context, te
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteSnapshotByTimeOffsetTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -349,7 +348,7 @@ func (me *VodService) DescribeImageSpriteTemplatesByFilter(ctx context.Context,
 		var response *vod.DescribeImageSpriteTemplatesResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeImageSpriteTemplates(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -358,7 +357,7 @@ func (me *VodService) DescribeImageSpriteTemplatesByFilter(ctx context.Context,
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 		if len(response.Response.ImageSpriteTemplateSet) < VOD_MAX_LIMIT {
@@ -408,7 +407,7 @@ func (me *VodService) DeleteImageSpriteTemplate(ctx context.Context, templateId
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteImageSpriteTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -448,7 +447,7 @@ func (me *VodService) DescribeSuperPlayerConfigsByFilter(ctx context.Context, fi
 		var response *vod.DescribeSuperPlayerConfigsResponse
 		var err error
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeSuperPlayerConfigs(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -457,7 +456,7 @@ func (me *VodService) DescribeSuperPlayerConfigsByFilter(ctx context.Context, fi
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), err.Error())
 			return
 		}
 		if len(response.Response.PlayerConfigSet) < VOD_MAX_LIMIT {
@@ -503,7 +502,7 @@ func (me *VodService) DeleteSuperPlayerConfig(ctx context.Context, configId stri
 	}
 
 	errRet = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		_, errRet = me.client.UseVodClient().DeleteSuperPlayerConfig(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -529,18 +528,18 @@ func (me *VodService) DescribeVodSampleSnapshotTemplateById(ctx context.Context,
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DescribeSampleSnapshotTemplates(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.SampleSnapshotTemplateSet) < 1 {
 		return
@@ -559,18 +558,18 @@ func (me *VodService) DeleteVodSampleSnapshotTemplateById(ctx context.Context, s
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DeleteSampleSnapshotTemplate(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -584,18 +583,18 @@ func (me *VodService) DescribeVodTranscodeTemplateById(ctx context.Context, subA
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DescribeTranscodeTemplates(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.TranscodeTemplateSet) < 1 {
 		return
@@ -614,18 +613,18 @@ func (me *VodService) DeleteVodTranscodeTemplateById(ctx context.Context, subApp
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DeleteTranscodeTemplate(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -639,18 +638,18 @@ func (me *VodService) DescribeVodWatermarkTemplateById(ctx context.Context, subA
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DescribeWatermarkTemplates(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	if len(response.Response.WatermarkTemplateSet) < 1 {
 		return
@@ -669,18 +668,18 @@ func (me *VodService) DeleteVodWatermarkTemplateById(ctx context.Context, subApp
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DeleteWatermarkTemplate(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	return
 }
@@ -693,18 +692,18 @@ func (me *VodService) DescribeVodEventConfig(ctx context.Context, subAppId uint6
 
 	defer func() {
 		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	me.client.RateLimiter.Check(request.GetAction())
 
 	response, err := me.client.UseVodClient().DescribeEventConfig(request)
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 	eventConfig = response.Response
 	return
@@ -717,7 +716,7 @@ func (me *VodService) DescribeSubApplicationsByFilter(ctx context.Context, filte
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
@@ -751,7 +750,7 @@ func (me *VodService) DescribeSubApplicationsByFilter(ctx context.Context, filte
 		var err error
 
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+			me.client.RateLimiter.Check(request.GetAction())
 			response, err = me.client.UseVodClient().DescribeSubAppIds(request)
 			if err != nil {
 				return tccommon.RetryError(err)
@@ -765,7 +764,7 @@ func (me *VodService) DescribeSubApplicationsByFilter(ctx context.Context, filte
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if len(response.Response.SubAppIdInfoSet) == 0 {
 			break
@@ -795,7 +794,7 @@ func (me *VodService) CreateVodAigcApiToken(ctx context.Context, subAppId uint64
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().CreateAigcApiToken(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -829,7 +828,7 @@ func (me *VodService) DescribeVodAigcApiTokens(ctx context.Context, subAppId uin
 
 	var requestId string
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().DescribeAigcApiTokens(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -885,7 +884,7 @@ func (me *VodService) DeleteVodAigcApiToken(ctx context.Context, subAppId uint64
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().DeleteAigcApiToken(request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -922,7 +921,7 @@ func (me *VodService) CreateVodAigcQuota(ctx context.Context, subAppId uint64, q
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().CreateAigcQuota(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -954,13 +953,13 @@ func (me *VodService) DescribeVodAigcQuotaById(ctx context.Context, subAppId uin
 	request.Offset = helper.IntUint64(VOD_DEFAULT_OFFSET)
 
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().DescribeAigcQuotas(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(response.ToJsonString()))
 
 		if response == nil || response.Response == nil {
 			return resource.NonRetryableError(fmt.Errorf("api[%s] response is nil", request.GetAction()))
@@ -996,7 +995,7 @@ func (me *VodService) ModifyVodAigcQuota(ctx context.Context, subAppId uint64, q
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().ModifyAigcQuota(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -1027,7 +1026,7 @@ func (me *VodService) DeleteVodAigcQuota(ctx context.Context, subAppId uint64, q
 
 	var requestId string
 	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+		me.client.RateLimiter.Check(request.GetAction())
 		response, e := me.client.UseVodClient().DeleteAigcQuota(request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -0,0 +1,119 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sdkv2list"
+)
+
+var (
+	_ list.ListResource                 = &SecurityGroupListResource{}
+	_ list.ListResourceWithConfigure    = &SecurityGroupListResource{}
+	_ list.ListResourceWithRawV5Schemas = &SecurityGroupListResource{}
+)
+
+// NewSecurityGroupListResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this list resource.
+func NewSecurityGroupListResource() list.ListResource {
+	return &SecurityGroupListResource{
+		Base: sdkv2list.Base{
+			TypeName:    "tencentcloud_security_group",
+			NewResource: ResourceTencentCloudSecurityGroup,
+		},
+	}
+}
+
+// SecurityGroupListResource implements list.ListResource for
+// tencentcloud_security_group on top of the SDKv2 managed resource.
+type SecurityGroupListResource struct {
+	sdkv2list.Base
+}
+
+// SecurityGroupListResourceModel maps the list configuration attributes.
+type SecurityGroupListResourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	ProjectId types.Int64  `tfsdk:"project_id"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func (l *SecurityGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists security groups so that they can be imported in bulk.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the security groups with this name.",
+			},
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Lists the security groups of this project.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lists the security groups that have all of these tags.",
+			},
+		},
+	}
+}
+
+// List enumerates the security groups matching the configuration with the
+// paginated DescribeSecurityGroups call used by the security group data
+// sources.
+func (l *SecurityGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.Meta() == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(sdkv2list.NotConfiguredDiagnostics())
+		return
+	}
+
+	var data SecurityGroupListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags := make(map[string]string)
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var name *string
+	if !data.Name.IsNull() {
+		name = data.Name.ValueStringPointer()
+	}
+	var projectId *int
+	if !data.ProjectId.IsNull() {
+		v := int(data.ProjectId.ValueInt64())
+		projectId = &v
+	}
+
+	service := VpcService{client: l.Meta().Client}
+	sgs, err := service.DescribeSecurityGroups(ctx, nil, name, projectId, tags)
+	if err != nil {
+		diags.AddError("Error listing security groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items := make([]sdkv2list.Item, 0, len(sgs))
+	for _, sg := range sgs {
+		if sg.SecurityGroupId == nil {
+			continue
+		}
+
+		item := sdkv2list.Item{Id: *sg.SecurityGroupId, DisplayName: *sg.SecurityGroupId}
+		if sg.SecurityGroupName != nil && *sg.SecurityGroupName != "" {
+			item.DisplayName = *sg.SecurityGroupName
+		}
+		items = append(items, item)
+	}
+
+	stream.Results = l.Results(ctx, req, items)
+}
@@ -0,0 +1,115 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sdkv2list"
+)
+
+var (
+	_ list.ListResource                 = &SubnetListResource{}
+	_ list.ListResourceWithConfigure    = &SubnetListResource{}
+	_ list.ListResourceWithRawV5Schemas = &SubnetListResource{}
+)
+
+// NewSubnetListResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this list resource.
+func NewSubnetListResource() list.ListResource {
+	return &SubnetListResource{
+		Base: sdkv2list.Base{
+			TypeName:    "tencentcloud_subnet",
+			NewResource: ResourceTencentCloudVpcSubnet,
+		},
+	}
+}
+
+// SubnetListResource implements list.ListResource for tencentcloud_subnet on
+// top of the SDKv2 managed resource.
+type SubnetListResource struct {
+	sdkv2list.Base
+}
+
+// SubnetListResourceModel maps the list configuration attributes.
+type SubnetListResourceModel struct {
+	VpcId            types.String `tfsdk:"vpc_id"`
+	Name             types.String `tfsdk:"name"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	CidrBlock        types.String `tfsdk:"cidr_block"`
+	Tags             types.Map    `tfsdk:"tags"`
+}
+
+func (l *SubnetListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists VPC subnets so that they can be imported in bulk.",
+		Attributes: map[string]schema.Attribute{
+			"vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the subnets of this VPC.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the subnets with this name.",
+			},
+			"availability_zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the subnets in this availability zone.",
+			},
+			"cidr_block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the subnets with this CIDR block.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lists the subnets that have all of these tags.",
+			},
+		},
+	}
+}
+
+// List enumerates the subnets matching the configuration with the paginated
+// DescribeSubnets call used by the subnet data sources.
+func (l *SubnetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.Meta() == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(sdkv2list.NotConfiguredDiagnostics())
+		return
+	}
+
+	var data SubnetListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags := make(map[string]string)
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	service := VpcService{client: l.Meta().Client}
+	infos, err := service.DescribeSubnets(ctx, "", data.VpcId.ValueString(), data.Name.ValueString(),
+		data.AvailabilityZone.ValueString(), tags, nil, nil, "", data.CidrBlock.ValueString(), "")
+	if err != nil {
+		diags.AddError("Error listing subnets", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items := make([]sdkv2list.Item, 0, len(infos))
+	for _, info := range infos {
+		item := sdkv2list.Item{Id: info.subnetId, DisplayName: info.subnetId}
+		if info.name != "" {
+			item.DisplayName = info.name
+		}
+		items = append(items, item)
+	}
+
+	stream.Results = l.Results(ctx, req, items)
+}
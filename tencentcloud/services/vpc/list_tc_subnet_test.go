@@ -0,0 +1,61 @@
+package vpc_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
+)
+
+// TestSubnetListResource_Metadata validates that the list resource is
+// registered for the tencentcloud_subnet managed resource.
+func TestSubnetListResource_Metadata(t *testing.T) {
+	l := svcvpc.NewSubnetListResource()
+	resp := &resource.MetadataResponse{}
+
+	l.Metadata(t.Context(), resource.MetadataRequest{ProviderTypeName: "tencentcloud"}, resp)
+
+	if resp.TypeName != "tencentcloud_subnet" {
+		t.Errorf("Metadata.TypeName = %q, want %q", resp.TypeName, "tencentcloud_subnet")
+	}
+}
+
+// TestSubnetListResource_Schema validates that every filter is optional.
+func TestSubnetListResource_Schema(t *testing.T) {
+	l := svcvpc.NewSubnetListResource()
+	resp := &list.ListResourceSchemaResponse{}
+
+	l.ListResourceConfigSchema(t.Context(), list.ListResourceSchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ListResourceConfigSchema returned diagnostics: %v", resp.Diagnostics)
+	}
+	for _, name := range []string{"vpc_id", "name", "availability_zone", "cidr_block", "tags"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("schema missing filter attribute: %s", name)
+			continue
+		}
+		if !attr.IsOptional() {
+			t.Errorf("filter attribute %s should be Optional", name)
+		}
+	}
+}
+
+// TestSubnetListResource_RawV5Schemas validates that the SDKv2 managed
+// resource exposes an identity, which list results require.
+func TestSubnetListResource_RawV5Schemas(t *testing.T) {
+	l := svcvpc.NewSubnetListResource().(list.ListResourceWithRawV5Schemas)
+	resp := &list.RawV5SchemaResponse{}
+
+	l.RawV5Schemas(t.Context(), list.RawV5SchemaRequest{}, resp)
+
+	if resp.ProtoV5Schema == nil {
+		t.Fatalf("RawV5Schemas returned no resource schema")
+	}
+	if resp.ProtoV5IdentitySchema == nil {
+		t.Fatalf("RawV5Schemas returned no identity schema")
+	}
+}
@@ -0,0 +1,114 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/sdkv2list"
+)
+
+var (
+	_ list.ListResource                 = &VpcListResource{}
+	_ list.ListResourceWithConfigure    = &VpcListResource{}
+	_ list.ListResourceWithRawV5Schemas = &VpcListResource{}
+)
+
+// NewVpcListResource is the factory referenced by
+// tencentcloud/framework/registry.go to register this list resource.
+func NewVpcListResource() list.ListResource {
+	return &VpcListResource{
+		Base: sdkv2list.Base{
+			TypeName:    "tencentcloud_vpc",
+			NewResource: ResourceTencentCloudVpcInstance,
+		},
+	}
+}
+
+// VpcListResource implements list.ListResource for tencentcloud_vpc on top of
+// the SDKv2 managed resource.
+type VpcListResource struct {
+	sdkv2list.Base
+}
+
+// VpcListResourceModel maps the list configuration attributes.
+type VpcListResourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	CidrBlock types.String `tfsdk:"cidr_block"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func (l *VpcListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists VPCs so that they can be imported in bulk.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the VPCs with this name.",
+			},
+			"cidr_block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists the VPCs with this CIDR block.",
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Lists only the default VPC when `true`, or only the other VPCs when `false`.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lists the VPCs that have all of these tags.",
+			},
+		},
+	}
+}
+
+// List enumerates the VPCs matching the configuration with the paginated
+// DescribeVpcs call used by the VPC data sources.
+func (l *VpcListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.Meta() == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(sdkv2list.NotConfiguredDiagnostics())
+		return
+	}
+
+	var data VpcListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags := make(map[string]string)
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var isDefault *bool
+	if !data.IsDefault.IsNull() {
+		isDefault = data.IsDefault.ValueBoolPointer()
+	}
+
+	service := VpcService{client: l.Meta().Client}
+	infos, err := service.DescribeVpcs(ctx, "", data.Name.ValueString(), tags, isDefault, "", data.CidrBlock.ValueString())
+	if err != nil {
+		diags.AddError("Error listing VPCs", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items := make([]sdkv2list.Item, 0, len(infos))
+	for _, info := range infos {
+		item := sdkv2list.Item{Id: info.vpcId, DisplayName: info.vpcId}
+		if info.name != "" {
+			item.DisplayName = info.name
+		}
+		items = append(items, item)
+	}
+
+	stream.Results = l.Results(ctx, req, items)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -120,7 +121,7 @@ func resourceTencentCloudDnatCreate(d *schema.ResourceData, meta interface{}) er
 		_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().CreateNatGatewayDestinationIpPortTranslationNatRule(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		return nil
@@ -159,7 +160,7 @@ func resourceTencentCloudDnatRead(d *schema.ResourceData, meta interface{}) erro
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		response = result
@@ -216,7 +217,7 @@ func resourceTencentCloudDnatUpdate(d *schema.ResourceData, meta interface{}) er
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(srequest)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, srequest.GetAction(), srequest.ToJsonString(), e.Error())
+					logId, srequest.GetAction(), connectivity.RedactJSON(srequest.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			sresponse = result
@@ -248,7 +249,7 @@ func resourceTencentCloudDnatUpdate(d *schema.ResourceData, meta interface{}) er
 			_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().ModifyNatGatewayDestinationIpPortTranslationNatRule(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), e.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 			return nil
@@ -284,7 +285,7 @@ func resourceTencentCloudDnatDelete(d *schema.ResourceData, meta interface{}) er
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(srequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, srequest.GetAction(), srequest.ToJsonString(), e.Error())
+				logId, srequest.GetAction(), connectivity.RedactJSON(srequest.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		sresponse = result
@@ -308,7 +309,7 @@ func resourceTencentCloudDnatDelete(d *schema.ResourceData, meta interface{}) er
 		_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DeleteNatGatewayDestinationIpPortTranslationNatRule(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		return nil
@@ -12,6 +12,7 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -232,7 +233,7 @@ func resourceTencentCloudHaVipDelete(d *schema.ResourceData, meta interface{}) e
 			}
 			if ee.Code == VPCNotFound {
 				log.Printf("[CRITAL]%s api[%s] success, request body [%s], reason[%s]\n",
-					logId, statRequest.GetAction(), statRequest.ToJsonString(), e)
+					logId, statRequest.GetAction(), connectivity.RedactJSON(statRequest.ToJsonString()), e)
 				return nil
 			} else {
 				//when associated eip is in deleting process, delete ha vip may return unsupported operation error
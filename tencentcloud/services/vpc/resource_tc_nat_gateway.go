@@ -190,7 +190,7 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().CreateNatGateway(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 
@@ -218,7 +218,7 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DescribeNatGateways(statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, statRequest.GetAction(), statRequest.ToJsonString(), e.Error())
+				logId, statRequest.GetAction(), connectivity.RedactJSON(statRequest.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		} else {
 			//if not, quit
@@ -272,7 +272,7 @@ func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient(iacExtInfo).DescribeNatGateways(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		response = result
@@ -317,7 +317,7 @@ func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient(iacExtInfo).DescribeAddresses(bandwidthRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, bandwidthRequest.GetAction(), bandwidthRequest.ToJsonString(), e.Error())
+				logId, bandwidthRequest.GetAction(), connectivity.RedactJSON(bandwidthRequest.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		bandwidthResponse = result
@@ -390,7 +390,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 			_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().ModifyNatGatewayAttribute(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), e.Error())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 				return tccommon.RetryError(e)
 			}
 
@@ -419,7 +419,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 			_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().ResetNatGatewayConnection(concurrentReq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, concurrentReq.GetAction(), concurrentReq.ToJsonString(), e.Error())
+					logId, concurrentReq.GetAction(), connectivity.RedactJSON(concurrentReq.ToJsonString()), e.Error())
 				return tccommon.RetryError(e, tccommon.InternalError)
 			}
 			return nil
@@ -532,7 +532,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 						result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().AssociateNatGatewayAddress(assignedRequest)
 						if e != nil {
 							log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-								logId, assignedRequest.GetAction(), assignedRequest.ToJsonString(), e.Error())
+								logId, assignedRequest.GetAction(), connectivity.RedactJSON(assignedRequest.ToJsonString()), e.Error())
 							return tccommon.RetryError(e)
 						}
 
@@ -599,7 +599,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 					result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().AssociateNatGatewayAddress(assignedRequest)
 					if e != nil {
 						log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-							logId, assignedRequest.GetAction(), assignedRequest.ToJsonString(), e.Error())
+							logId, assignedRequest.GetAction(), connectivity.RedactJSON(assignedRequest.ToJsonString()), e.Error())
 						return tccommon.RetryError(e)
 					}
 
@@ -625,8 +625,8 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldValue, newValue := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		tcClient := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 		tagService := svctag.NewTagService(tcClient)
@@ -654,7 +654,7 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 		_, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DeleteNatGateway(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		}
 		return nil
@@ -673,7 +673,7 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseVpcClient().DescribeNatGateways(statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), e.Error())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), e.Error())
 			return tccommon.RetryError(e)
 		} else {
 			//if not, quit
@@ -25,8 +25,9 @@ func ResourceTencentCloudSecurityGroup() *schema.Resource {
 		Update: resourceTencentCloudSecurityGroupUpdate,
 		Delete: resourceTencentCloudSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughWithIdentity(helper.IdentityIdKey),
 		},
+		Identity: helper.IdIdentity(),
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(3 * time.Minute),
 		},
@@ -115,6 +116,9 @@ func resourceTencentCloudSecurityGroupRead(d *schema.ResourceData, m interface{}
 	region := client.Region
 
 	id := d.Id()
+	if err := helper.SetIdIdentity(d); err != nil {
+		return err
+	}
 
 	securityGroup, err := vpcService.DescribeSecurityGroup(ctx, id)
 	if err != nil {
@@ -182,8 +186,8 @@ func resourceTencentCloudSecurityGroupUpdate(d *schema.ResourceData, m interface
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := tccommon.BuildTagResourceName("cvm", "sg", region, id)
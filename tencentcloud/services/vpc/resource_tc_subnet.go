@@ -22,10 +22,12 @@ func ResourceTencentCloudVpcSubnet() *schema.Resource {
 		Update: resourceTencentCloudVpcSubnetUpdate,
 		Delete: resourceTencentCloudVpcSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: tccommon.ImportStateWithRegion(schema.ImportStatePassthroughWithIdentity(helper.IdentityIdKey)),
 		},
+		Identity: helper.IdIdentity(),
 
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"vpc_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -98,6 +100,8 @@ func ResourceTencentCloudVpcSubnet() *schema.Resource {
 func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_subnet.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId            = tccommon.GetLogId(tccommon.ContextNil)
 		ctx              = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -201,6 +205,9 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 	defer tccommon.LogElapsed("resource.tencentcloud_subnet.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	var (
 		logId      = tccommon.GetLogId(tccommon.ContextNil)
 		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -213,6 +220,10 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 		e          error
 	)
 
+	if err := helper.SetIdIdentity(d); err != nil {
+		return err
+	}
+
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		info, has, e = vpcService.DescribeSubnet(ctx, id, nil, "", "")
 		if e != nil {
@@ -265,6 +276,8 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_subnet.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId       = tccommon.GetLogId(tccommon.ContextNil)
 		ctx         = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -316,8 +329,8 @@ func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 		region := meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region
@@ -335,6 +348,8 @@ func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{
 func resourceTencentCloudVpcSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_subnet.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	var (
 		logId   = tccommon.GetLogId(tccommon.ContextNil)
 		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
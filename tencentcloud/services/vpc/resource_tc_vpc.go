@@ -25,10 +25,12 @@ func ResourceTencentCloudVpcInstance() *schema.Resource {
 		Update: resourceTencentCloudVpcInstanceUpdate,
 		Delete: resourceTencentCloudVpcInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: tccommon.ImportStateWithRegion(schema.ImportStatePassthroughWithIdentity(helper.IdentityIdKey)),
 		},
+		Identity: helper.IdIdentity(),
 
 		Schema: map[string]*schema.Schema{
+			"region": tccommon.RegionSchema(),
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -116,6 +118,8 @@ func ResourceTencentCloudVpcInstance() *schema.Resource {
 func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_vpc.create")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
@@ -202,12 +206,19 @@ func resourceTencentCloudVpcInstanceRead(d *schema.ResourceData, meta interface{
 	defer tccommon.LogElapsed("resource.tencentcloud_vpc.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
 
+	meta = tccommon.ResourceMeta(d, meta)
+	_ = d.Set("region", meta.(tccommon.ProviderMeta).GetAPIV3Conn().Region)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	service := VpcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
 	id := d.Id()
+	if err := helper.SetIdIdentity(d); err != nil {
+		return err
+	}
+
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeVpc(ctx, id, "", "")
 		if e != nil {
@@ -276,6 +287,8 @@ func resourceTencentCloudVpcInstanceRead(d *schema.ResourceData, meta interface{
 func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_vpc.update")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
@@ -363,8 +376,8 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if helper.HasTagsChange(d) {
+		oldTags, newTags := helper.GetTagsChange(d)
 		replaceTags, deleteTags := svctag.DiffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := svctag.NewTagService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
@@ -386,6 +399,8 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 func resourceTencentCloudVpcInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_vpc.delete")()
 
+	meta = tccommon.ResourceMeta(d, meta)
+
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
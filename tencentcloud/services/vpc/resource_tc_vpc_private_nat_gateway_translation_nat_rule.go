@@ -10,6 +10,7 @@ import (
 	vpcv20170312 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -192,7 +193,7 @@ func resourceTencentCloudVpcPrivateNatGatewayTranslationNatRuleCreate(d *schema.
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result == nil || result.Response == nil || result.Response.NatGatewayId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Create vpc private nat gateway translation nat rule failed, Response is nil."))
@@ -297,7 +298,7 @@ func resourceTencentCloudVpcPrivateNatGatewayTranslationNatRuleUpdate(d *schema.
 					return tccommon.RetryError(e)
 				}
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+					logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				return nil
 			})
 			if reqErr != nil {
@@ -325,7 +326,7 @@ func resourceTencentCloudVpcPrivateNatGatewayTranslationNatRuleUpdate(d *schema.
 						return tccommon.RetryError(e)
 					}
 					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-						logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+						logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					if result == nil || result.Response == nil || result.Response.NatGatewayId == nil {
 						return resource.NonRetryableError(fmt.Errorf("Create vpc private nat gateway translation nat rule failed, Response is nil."))
 					}
@@ -400,7 +401,7 @@ func resourceTencentCloudVpcPrivateNatGatewayTranslationNatRuleDelete(d *schema.
 			return tccommon.RetryError(e)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		return nil
 	})
 
@@ -723,7 +724,7 @@ func applyRulesDiff(
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 		if reqErr != nil {
@@ -744,7 +745,7 @@ func applyRulesDiff(
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 			return nil
 		})
 		if reqErr != nil {
@@ -770,7 +771,7 @@ func applyRulesDiff(
 				return tccommon.RetryError(e)
 			}
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+				logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 
 			if result == nil || result.Response == nil || result.Response.NatGatewayId == nil {
 				return resource.NonRetryableError(fmt.Errorf("Create vpc private nat gateway translation nat rule failed, Response is nil."))
@@ -81,6 +81,59 @@ func testSweepVpcInstance(region string) error {
 	return nil
 }
 
+// TestTencentCloudVpcResource_fakeCloud creates, reads and deletes a VPC on
+// the fake cloud, without a cloud account.
+func TestTencentCloudVpcResource_fakeCloud(t *testing.T) {
+	ctx := context.Background()
+	_, provider := tcacctest.AccFakeCloud(t)
+	vpc := provider.ResourcesMap["tencentcloud_vpc"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       "tf-fake-vpc",
+		"cidr_block": "10.0.0.0/16",
+		"tags":       map[string]interface{}{"env": "test"},
+	})
+
+	diff, err := vpc.Diff(ctx, nil, config, provider.Meta())
+	if err != nil {
+		t.Fatalf("plan vpc failed: %v", err)
+	}
+	state, diags := vpc.Apply(ctx, nil, diff, provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("create vpc failed: %v", diags)
+	}
+	if !strings.HasPrefix(state.ID, "vpc-") {
+		t.Fatalf("unexpected vpc id: %s", state.ID)
+	}
+	if state.Identity["id"] != state.ID {
+		t.Errorf("identity id = %q, want %q", state.Identity["id"], state.ID)
+	}
+
+	state, diags = vpc.RefreshWithoutUpgrade(ctx, state, provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("read vpc failed: %v", diags)
+	}
+	if name := state.Attributes["name"]; name != "tf-fake-vpc" {
+		t.Errorf("name = %q, want %q", name, "tf-fake-vpc")
+	}
+	if cidrBlock := state.Attributes["cidr_block"]; cidrBlock != "10.0.0.0/16" {
+		t.Errorf("cidr_block = %q, want %q", cidrBlock, "10.0.0.0/16")
+	}
+	if env := state.Attributes["tags.env"]; env != "test" {
+		t.Errorf("tags.env = %q, want %q", env, "test")
+	}
+
+	if _, diags = vpc.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, provider.Meta()); diags.HasError() {
+		t.Fatalf("delete vpc failed: %v", diags)
+	}
+	state, diags = vpc.RefreshWithoutUpgrade(ctx, state, provider.Meta())
+	if diags.HasError() {
+		t.Fatalf("read deleted vpc failed: %v", diags)
+	}
+	if state != nil {
+		t.Errorf("deleted vpc %s is still read", state.ID)
+	}
+}
+
 func TestAccTencentCloudVpcV3Basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
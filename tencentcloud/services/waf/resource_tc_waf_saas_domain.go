@@ -14,6 +14,7 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
@@ -319,7 +320,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		if *result.Response.VerifyCode == DescribeDomainVerifyResultSUCCESS {
@@ -568,7 +569,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -634,7 +635,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyBotStatusRequest.GetAction(), modifyBotStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyBotStatusRequest.GetAction(), connectivity.RedactJSON(modifyBotStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -663,7 +664,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyApiAnalyzeStatusRequest.GetAction(), modifyApiAnalyzeStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyApiAnalyzeStatusRequest.GetAction(), connectivity.RedactJSON(modifyApiAnalyzeStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -697,7 +698,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainsCLSStatusRequest.GetAction(), modifyDomainsCLSStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainsCLSStatusRequest.GetAction(), connectivity.RedactJSON(modifyDomainsCLSStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -727,7 +728,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 	//			if e != nil {
 	//				return tccommon.RetryError(e)
 	//			} else {
-	//				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainIpv6StatusRequest.GetAction(), modifyDomainIpv6StatusRequest.ToJsonString(), result.ToJsonString())
+	//				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainIpv6StatusRequest.GetAction(), connectivity.RedactJSON(modifyDomainIpv6StatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 	//			}
 	//
 	//			return nil
@@ -755,7 +756,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), modifyProtectionStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), connectivity.RedactJSON(modifyProtectionStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -1043,7 +1044,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), modifyProtectionStatusRequest.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), connectivity.RedactJSON(modifyProtectionStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil
@@ -1362,7 +1363,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		if e != nil {
 			return tccommon.RetryError(e)
 		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), connectivity.RedactJSON(request.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 		}
 
 		return nil
@@ -1391,7 +1392,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyBotStatusRequest.GetAction(), modifyBotStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyBotStatusRequest.GetAction(), connectivity.RedactJSON(modifyBotStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -1418,7 +1419,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyApiAnalyzeStatusRequest.GetAction(), modifyApiAnalyzeStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyApiAnalyzeStatusRequest.GetAction(), connectivity.RedactJSON(modifyApiAnalyzeStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -1450,7 +1451,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 				if e != nil {
 					return tccommon.RetryError(e)
 				} else {
-					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainsCLSStatusRequest.GetAction(), modifyDomainsCLSStatusRequest.ToJsonString(), result.ToJsonString())
+					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainsCLSStatusRequest.GetAction(), connectivity.RedactJSON(modifyDomainsCLSStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 				}
 
 				return nil
@@ -1483,7 +1484,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 	//			if e != nil {
 	//				return tccommon.RetryError(e)
 	//			} else {
-	//				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainIpv6StatusRequest.GetAction(), modifyDomainIpv6StatusRequest.ToJsonString(), result.ToJsonString())
+	//				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyDomainIpv6StatusRequest.GetAction(), connectivity.RedactJSON(modifyDomainIpv6StatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 	//			}
 	//
 	//			return nil
@@ -1512,7 +1513,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 					if e != nil {
 						return tccommon.RetryError(e)
 					} else {
-						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), modifyProtectionStatusRequest.ToJsonString(), result.ToJsonString())
+						log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, modifyProtectionStatusRequest.GetAction(), connectivity.RedactJSON(modifyProtectionStatusRequest.ToJsonString()), connectivity.RedactJSON(result.ToJsonString()))
 					}
 
 					return nil